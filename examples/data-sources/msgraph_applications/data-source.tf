@@ -0,0 +1 @@
+data "msgraph_applications" "example" {}
@@ -0,0 +1,3 @@
+data "msgraph_group" "example" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
@@ -0,0 +1 @@
+data "msgraph_groups" "example" {}
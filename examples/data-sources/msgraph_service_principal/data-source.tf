@@ -0,0 +1,3 @@
+data "msgraph_service_principal" "example" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
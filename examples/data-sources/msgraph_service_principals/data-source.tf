@@ -0,0 +1 @@
+data "msgraph_service_principals" "example" {}
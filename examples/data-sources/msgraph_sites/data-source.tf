@@ -0,0 +1 @@
+data "msgraph_sites" "example" {}
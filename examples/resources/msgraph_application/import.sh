@@ -0,0 +1,7 @@
+terraform import msgraph_application.example 00000000-0000-0000-0000-000000000000
+
+# Or by its app_id
+terraform import msgraph_application.example appId:example
+
+# Or by its display_name
+terraform import msgraph_application.example displayName:Example
@@ -0,0 +1,3 @@
+resource "msgraph_application" "example" {
+  display_name = "Example"
+}
@@ -0,0 +1 @@
+terraform import msgraph_beta_team.example 00000000-0000-0000-0000-000000000000
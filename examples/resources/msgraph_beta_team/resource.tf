@@ -0,0 +1,3 @@
+resource "msgraph_beta_team" "example" {
+  display_name = "Example"
+}
@@ -0,0 +1,4 @@
+terraform import msgraph_device.example 00000000-0000-0000-0000-000000000000
+
+# Or by its device_id
+terraform import msgraph_device.example deviceId:example
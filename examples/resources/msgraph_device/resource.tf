@@ -0,0 +1,3 @@
+resource "msgraph_device" "example" {
+  display_name = "Example"
+}
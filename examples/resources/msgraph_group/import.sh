@@ -0,0 +1,4 @@
+terraform import msgraph_group.example 00000000-0000-0000-0000-000000000000
+
+# Or by its mail_nickname
+terraform import msgraph_group.example mailNickname:example
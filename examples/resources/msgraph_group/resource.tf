@@ -0,0 +1,6 @@
+resource "msgraph_group" "example" {
+  display_name     = "Example"
+  mail_enabled     = false
+  mail_nickname    = "example"
+  security_enabled = true
+}
@@ -0,0 +1,3 @@
+resource "msgraph_service_principal" "example" {
+  app_id = msgraph_application.example.app_id
+}
@@ -0,0 +1,3 @@
+resource "msgraph_team" "example" {
+  display_name = "Example"
+}
@@ -0,0 +1,4 @@
+terraform import msgraph_user.example 00000000-0000-0000-0000-000000000000
+
+# Or by its user_principal_name
+terraform import msgraph_user.example userPrincipalName:example@contoso.onmicrosoft.com
@@ -0,0 +1,8 @@
+resource "msgraph_user" "example" {
+  display_name  = "Example"
+  mail_nickname = "example"
+  password_profile = {
+    password = var.password
+  }
+  user_principal_name = "example@contoso.onmicrosoft.com"
+}
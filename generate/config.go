@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"terraform-provider-msgraph/generate/extract"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+)
+
+// Represents generate/config.yaml, which lists the Terraform resources and data sources to generate
+type generatorConfig struct {
+	Types []typeConfig `yaml:"types"`
+}
+
+// Represents a single Terraform type, and the resource and data sources generated for it
+type typeConfig struct {
+	Name                  string `yaml:"name"`
+	PluralName            string `yaml:"pluralName"`
+	Tag                   string `yaml:"tag"`
+	ItemOperationId       string `yaml:"itemOperationId"`
+	CollectionOperationId string `yaml:"collectionOperationId"`
+	ItemPath              string `yaml:"itemPath"`
+	CollectionPath        string `yaml:"collectionPath"`
+	Version               string `yaml:"version"`
+	Resource              bool   `yaml:"resource"`
+	DataSource            bool   `yaml:"dataSource"`
+	PluralDataSource      bool   `yaml:"pluralDataSource"`
+	Augment               string `yaml:"augment"`
+	PluralAugment         string `yaml:"pluralAugment"`
+}
+
+func loadConfig(path string) (generatorConfig, error) {
+
+	var config generatorConfig
+
+	configFile, err := os.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+
+	// Reject unknown keys, so typos don't silently change what is generated
+	decoder := yaml.NewDecoder(bytes.NewReader(configFile))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil {
+		return config, fmt.Errorf("%s: %w", path, err)
+	}
+
+	for _, t := range config.Types {
+		if t.Name == "" || (t.ItemPath == "" && t.ItemOperationId == "" && t.Tag == "") {
+			return config, fmt.Errorf("%s: every type requires 'name', and one of 'tag', 'itemOperationId' or 'itemPath'", path)
+		}
+		if t.Version != "" && t.Version != "v1.0" && t.Version != "beta" {
+			return config, fmt.Errorf("%s: type '%s' has version '%s', which must be 'v1.0' or 'beta'", path, t.Name, t.Version)
+		}
+		if t.PluralDataSource && t.PluralName == "" {
+			return config, fmt.Errorf("%s: type '%s' requires 'pluralName' to generate a plural data source", path, t.Name)
+		}
+	}
+
+	return config, nil
+}
+
+// The MS Graph API version the type is generated from. Defaults to v1.0
+func (t typeConfig) version() string {
+	if t.Version == "" {
+		return "v1.0"
+	}
+	return t.Version
+}
+
+// resolvePaths finds the item and collection paths of a type in the OpenAPI spec.
+// Paths given explicitly take precedence over operationIds, which take precedence over the tag.
+// With only a tag, e.g. 'users.user', the paths of that tag with the GET operations 'users.user.GetUser' and 'users.user.ListUser' are used
+func (t typeConfig) resolvePaths(doc *openapi3.T) (extract.OpenAPIPathObject, extract.OpenAPIPathObject, error) {
+
+	var itemPath, collectionPath extract.OpenAPIPathObject
+
+	itemOperationId := t.ItemOperationId
+	collectionOperationId := t.CollectionOperationId
+
+	if t.Tag != "" {
+		tagParts := strings.Split(t.Tag, ".")
+		entityName := strings.ToUpper(tagParts[len(tagParts)-1][0:1]) + tagParts[len(tagParts)-1][1:]
+		for _, p := range extract.GetPathsByTag(doc, t.Tag) {
+			if itemOperationId == "" && p.Get().OperationId() == t.Tag+".Get"+entityName {
+				itemOperationId = p.Get().OperationId()
+			}
+			if collectionOperationId == "" && p.Get().OperationId() == t.Tag+".List"+entityName {
+				collectionOperationId = p.Get().OperationId()
+			}
+		}
+	}
+
+	var found bool
+	if t.ItemPath != "" {
+		itemPath = extract.GetPath(doc, t.ItemPath)
+		found = itemPath.PathItem != nil
+	} else {
+		itemPath, found = extract.GetPathByOperationId(doc, itemOperationId)
+	}
+	if !found {
+		return itemPath, collectionPath, fmt.Errorf("type '%s': item path not found in the OpenAPI spec", t.Name)
+	}
+
+	if !t.PluralDataSource {
+		return itemPath, collectionPath, nil
+	}
+
+	if t.CollectionPath != "" {
+		collectionPath = extract.GetPath(doc, t.CollectionPath)
+		found = collectionPath.PathItem != nil
+	} else {
+		collectionPath, found = extract.GetPathByOperationId(doc, collectionOperationId)
+	}
+	if !found {
+		return itemPath, collectionPath, fmt.Errorf("type '%s': collection path not found in the OpenAPI spec", t.Name)
+	}
+
+	return itemPath, collectionPath, nil
+}
@@ -1,12 +1,22 @@
 package extract
 
 import (
+	"slices"
+	"sort"
+	"strings"
+
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
 type OpenAPIPathObject struct {
 	PathItem *openapi3.PathItem
 	Path     string
+	doc      *openapi3.T
+}
+
+// The OpenAPI document the path was found in
+func (po OpenAPIPathObject) Doc() *openapi3.T {
+	return po.doc
 }
 
 func (po OpenAPIPathObject) Description() string {
@@ -14,19 +24,19 @@ func (po OpenAPIPathObject) Description() string {
 }
 
 func (po OpenAPIPathObject) Get() openAPIPathOperationObject {
-	return openAPIPathOperationObject{Operation: po.PathItem.Get}
+	return openAPIPathOperationObject{Operation: po.PathItem.Get, doc: po.doc}
 }
 
 func (po OpenAPIPathObject) Post() openAPIPathOperationObject {
-	return openAPIPathOperationObject{Operation: po.PathItem.Post}
+	return openAPIPathOperationObject{Operation: po.PathItem.Post, doc: po.doc}
 }
 
 func (po OpenAPIPathObject) Patch() openAPIPathOperationObject {
-	return openAPIPathOperationObject{Operation: po.PathItem.Patch}
+	return openAPIPathOperationObject{Operation: po.PathItem.Patch, doc: po.doc}
 }
 
 func (po OpenAPIPathObject) Delete() openAPIPathOperationObject {
-	return openAPIPathOperationObject{Operation: po.PathItem.Delete}
+	return openAPIPathOperationObject{Operation: po.PathItem.Delete, doc: po.doc}
 }
 
 func (po OpenAPIPathObject) Parameters() []string {
@@ -38,8 +48,39 @@ func (po OpenAPIPathObject) Parameters() []string {
 
 }
 
+// ParentParameters returns a pseudo-property for each path parameter identifying a parent of the object the path returns.
+// e.g. '{team-id}' in '/teams/{team-id}/channels/{channel-id}', which becomes the property 'teamId'.
+// The parameter identifying the object itself, '{channel-id}', is left out, as it's already the 'id' property of the object
+func (po OpenAPIPathObject) ParentParameters() []OpenAPISchemaProperty {
+
+	var parameters []OpenAPISchemaProperty
+
+	segments := strings.Split(po.Path, "/")
+	for _, segment := range segments[:len(segments)-1] {
+		if !strings.HasPrefix(segment, "{") {
+			continue
+		}
+
+		name := strings.Trim(segment, "{}")
+		description := ""
+		if param := po.PathItem.Parameters.GetByInAndName("path", name); param != nil {
+			description = param.Description
+		}
+
+		parameters = append(parameters, OpenAPISchemaProperty{
+			Name:          strings.TrimSuffix(name, "-id") + "Id",
+			Schema:        &openapi3.Schema{Type: &openapi3.Types{"string"}, Description: description},
+			PathParameter: name,
+			doc:           po.doc,
+		})
+	}
+
+	return parameters
+}
+
 type openAPIPathOperationObject struct {
 	Operation *openapi3.Operation
+	doc       *openapi3.T
 }
 
 func (oo openAPIPathOperationObject) Summary() string {
@@ -55,7 +96,7 @@ func (oo openAPIPathOperationObject) Description() string {
 }
 
 func (oo openAPIPathOperationObject) Response() OpenAPISchemaObject {
-	return OpenAPISchemaObject{Schema: oo.Operation.Responses.Status(200).Value.Content.Get("application/json").Schema.Value}
+	return OpenAPISchemaObject{Schema: oo.Operation.Responses.Status(200).Value.Content.Get("application/json").Schema.Value, doc: oo.doc}
 }
 
 func (oo openAPIPathOperationObject) SelectParameters() []string {
@@ -75,6 +116,46 @@ func GetPath(doc *openapi3.T, pathname string) OpenAPIPathObject {
 
 	pathObject.PathItem = path
 	pathObject.Path = pathname
+	pathObject.doc = doc
 
 	return pathObject
 }
+
+// GetPathByOperationId finds the path with an operation that has the given operationId, e.g. 'users.user.GetUser'
+func GetPathByOperationId(doc *openapi3.T, operationId string) (OpenAPIPathObject, bool) {
+
+	for pathname, path := range doc.Paths.Map() {
+		for _, operation := range path.Operations() {
+			if operation.OperationID == operationId {
+				return GetPath(doc, pathname), true
+			}
+		}
+	}
+
+	return OpenAPIPathObject{}, false
+}
+
+// GetPathsByTag finds all paths with a GET operation that has the given tag, e.g. 'users.user'
+func GetPathsByTag(doc *openapi3.T, tag string) []OpenAPIPathObject {
+
+	var paths []OpenAPIPathObject
+
+	for _, pathname := range doc.Paths.InMatchingOrder() {
+		path := doc.Paths.Value(pathname)
+		if path.Get != nil && slices.Contains(path.Get.Tags, tag) {
+			paths = append(paths, GetPath(doc, pathname))
+		}
+	}
+
+	sort.Slice(paths[:], func(i, j int) bool { return paths[i].Path < paths[j].Path })
+
+	return paths
+}
+
+func (oo openAPIPathOperationObject) OperationId() string {
+	if oo.Operation != nil {
+		return oo.Operation.OperationID
+	} else {
+		return ""
+	}
+}
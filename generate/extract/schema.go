@@ -3,6 +3,7 @@ package extract
 // schema.go handles everything related to OpenAPI schema objects
 
 import (
+	"slices"
 	"sort"
 	"strings"
 
@@ -11,30 +12,41 @@ import (
 
 type OpenAPISchemaObject struct {
 	Schema *openapi3.Schema
+	doc    *openapi3.T
+
+	// The objects, and the names of the properties, that lead to this object from the root of the response.
+	// Used to detect recursive schemas, and to limit the nesting depth.
+	ancestors []*openapi3.Schema
+	path      []string
 }
 
-func (so OpenAPISchemaObject) Title() string {
+// inline returns the part of the schema that holds the definition of the object itself.
+// Derived types in the MS Graph OpenAPI spec are described as an 'allOf' of a '$ref' to their base type, and an inline schema.
+// The inline schema is usually, but not always, the last entry.
+func (so OpenAPISchemaObject) inline() *openapi3.Schema {
 	if len(so.Schema.AllOf) == 0 {
-		return so.Schema.Title
-	} else {
-		return so.Schema.AllOf[1].Value.Title
+		return so.Schema
+	}
+
+	for i := len(so.Schema.AllOf) - 1; i >= 0; i-- {
+		if so.Schema.AllOf[i].Ref == "" {
+			return so.Schema.AllOf[i].Value
+		}
 	}
+
+	return so.Schema.AllOf[len(so.Schema.AllOf)-1].Value
+}
+
+func (so OpenAPISchemaObject) Title() string {
+	return so.inline().Title
 }
 
 func (so OpenAPISchemaObject) Description() string {
-	if len(so.Schema.AllOf) == 0 {
-		return so.Schema.Description
-	} else {
-		return so.Schema.AllOf[1].Value.Description
-	}
+	return so.inline().Description
 }
 
 func (so OpenAPISchemaObject) Type() string {
-	if len(so.Schema.AllOf) == 0 {
-		return strings.Join(so.Schema.Type.Slice(), "")
-	} else {
-		return strings.Join(so.Schema.AllOf[1].Value.Type.Slice(), "")
-	}
+	return strings.Join(so.inline().Type.Slice(), "")
 }
 
 func (so OpenAPISchemaObject) Properties() []OpenAPISchemaProperty {
@@ -54,48 +66,190 @@ func (so OpenAPISchemaObject) Properties() []OpenAPISchemaProperty {
 			if strings.Contains(name, "@odata") || property.Value.Extensions["x-ms-navigationProperty"] == true {
 				continue
 			}
-			properties = append(properties, OpenAPISchemaProperty{Name: name, Schema: property.Value})
+			properties = append(properties, OpenAPISchemaProperty{Name: name, Schema: property.Value, doc: so.doc})
 		}
 	} else {
 		for _, schema := range so.Schema.AllOf {
 			newSchema := OpenAPISchemaObject{
 				Schema: schema.Value,
+				doc:    so.doc,
 			}
 			properties = append(properties, newSchema.Properties()...)
 		}
 	}
 
+	// Properties of 'allOf' schemas are collected recursively, so set the ancestry to that of this object
+	for i := range properties {
+		properties[i].ancestors = append(slices.Clip(so.ancestors), so.Schema)
+		properties[i].path = slices.Clip(so.path)
+	}
+
 	sort.Slice(properties[:], func(i, j int) bool { return properties[i].Name < properties[j].Name })
 
 	return properties
 
 }
 
+// DerivedTypes returns a pseudo-property for each concrete type listed in the discriminator mapping of the object.
+// Each pseudo-property is an object holding only the properties the derived type adds to this object.
+func (so OpenAPISchemaObject) DerivedTypes() []OpenAPISchemaProperty {
+
+	var derivedTypes []OpenAPISchemaProperty
+
+	if so.Schema == nil || so.doc == nil || so.inline().Discriminator == nil {
+		return derivedTypes
+	}
+
+	for odataType, ref := range so.inline().Discriminator.Mapping {
+		derivedSchema := so.doc.Components.Schemas[strings.TrimPrefix(ref, "#/components/schemas/")]
+		if derivedSchema == nil || derivedSchema.Value == so.Schema {
+			continue
+		}
+		derived := OpenAPISchemaObject{Schema: derivedSchema.Value, doc: so.doc}
+		derivedType := derivedTypeProperty(so, derived, odataType)
+		derivedType.ancestors = append(slices.Clip(so.ancestors), so.Schema)
+		derivedType.path = slices.Clip(so.path)
+		derivedTypes = append(derivedTypes, derivedType)
+	}
+
+	sort.Slice(derivedTypes[:], func(i, j int) bool { return derivedTypes[i].Name < derivedTypes[j].Name })
+
+	return derivedTypes
+
+}
+
+// derivedTypeProperty creates a pseudo-property representing the derived type of a base object.
+// The properties it inherits from the base object are left out, as they are already described by the base object.
+func derivedTypeProperty(base OpenAPISchemaObject, derived OpenAPISchemaObject, odataType string) OpenAPISchemaProperty {
+
+	var baseProperties []string
+	for _, property := range base.Properties() {
+		baseProperties = append(baseProperties, property.Name)
+	}
+
+	ownProperties := openapi3.Schemas{}
+	for _, property := range derived.Properties() {
+		if !slices.Contains(baseProperties, property.Name) {
+			ownProperties[property.Name] = openapi3.NewSchemaRef("", property.Schema)
+		}
+	}
+
+	objectSchema := &openapi3.Schema{
+		Title:       derived.Title(),
+		Description: derived.Description(),
+		Type:        &openapi3.Types{"object"},
+		Properties:  ownProperties,
+	}
+
+	return OpenAPISchemaProperty{
+		Name:               derived.Title(),
+		Schema:             &openapi3.Schema{Description: derived.Description(), AnyOf: openapi3.SchemaRefs{openapi3.NewSchemaRef("", objectSchema)}},
+		DiscriminatorValue: odataType,
+		doc:                base.doc,
+	}
+}
+
 type OpenAPISchemaProperty struct {
 	Schema *openapi3.Schema
 	Name   string
+
+	// DiscriminatorValue is set when the property is a pseudo-property describing a derived type of its parent object.
+	// It holds the value that '@odata.type' needs to be set to for the derived type, e.g. '#microsoft.graph.emailAuthenticationMethod'
+	DiscriminatorValue string
+
+	// PathParameter is set when the property is a pseudo-property describing a path parameter identifying a parent object, e.g. 'team-id'
+	PathParameter string
+
+	doc       *openapi3.T
+	ancestors []*openapi3.Schema
+	path      []string
 }
 
 func (sp OpenAPISchemaProperty) Description() string {
 	return sp.Schema.Description
 }
 
+// IsReadOnly determines if the API sets the property, rather than clients. The MS Graph spec rarely uses 'readOnly', but says so in descriptions
+func (sp OpenAPISchemaProperty) IsReadOnly() bool {
+	return sp.Schema.ReadOnly || strings.Contains(sp.Description(), "Read-only")
+}
+
+func (sp OpenAPISchemaProperty) IsDerivedType() bool {
+	return sp.DiscriminatorValue != ""
+}
+
+func (sp OpenAPISchemaProperty) IsPathParameter() bool {
+	return sp.PathParameter != ""
+}
+
+// Path returns the dotted path of the property from the root of the response, e.g. 'settings.summary'
+func (sp OpenAPISchemaProperty) Path() string {
+	return strings.Join(append(slices.Clip(sp.path), sp.Name), ".")
+}
+
+// Depth returns how deeply nested the property is. Properties of the root of the response have a depth of 1
+func (sp OpenAPISchemaProperty) Depth() int {
+	return len(sp.path) + 1
+}
+
+// IsRecursive determines if the object the property holds is also one of the objects the property is nested in.
+// kin-openapi resolves every '$ref' to the same schema, so this compares by the identity of the referenced schema
+func (sp OpenAPISchemaProperty) IsRecursive() bool {
+	return sp.IsObject() && slices.Contains(sp.ancestors, sp.ObjectOf().Schema)
+}
+
+// IsObject determines if the property holds an object, or an array of objects, with properties of its own. String enums are not considered objects
+func (sp OpenAPISchemaProperty) IsObject() bool {
+
+	if sp.Type() != "object" && sp.ArrayOf() != "object" {
+		return false
+	}
+
+	objectOf := sp.ObjectOf()
+
+	return objectOf.Schema != nil && objectOf.Type() != "string"
+}
+
 func (sp OpenAPISchemaProperty) Type() string {
 	if sp.Schema.Title != "" { // Inline Object. It appears as a single '$ref' in the openapi doc, but kin-openapi evaluates in into an object directly
 		return "object"
-	} else if sp.Schema.AnyOf != nil { // Object
+	} else if sp.Schema.AnyOf != nil || sp.Schema.OneOf != nil { // Object
 		return "object"
 	} else {
 		return strings.Join(sp.Schema.Type.Slice(), "")
 	}
 }
 
+// alternatives returns the schemas a property can be, ignoring the '{type: object, nullable: true}' entries the MS Graph OpenAPI spec uses to mark nullable objects.
+func (sp OpenAPISchemaProperty) alternatives() openapi3.SchemaRefs {
+
+	schema := sp.Schema
+	if strings.Join(sp.Schema.Type.Slice(), "") == "array" {
+		schema = sp.Schema.Items.Value
+	}
+
+	var alternatives openapi3.SchemaRefs
+	for _, alternative := range append(schema.OneOf, schema.AnyOf...) {
+		if alternative.Ref == "" && alternative.Value.Title == "" && len(alternative.Value.Properties) == 0 && len(alternative.Value.AllOf) == 0 && len(alternative.Value.Enum) == 0 {
+			continue
+		}
+		alternatives = append(alternatives, alternative)
+	}
+
+	return alternatives
+}
+
 func (sp OpenAPISchemaProperty) ObjectOf() OpenAPISchemaObject {
 
 	var schemaObject OpenAPISchemaObject
+	schemaObject.doc = sp.doc
+	schemaObject.ancestors = slices.Clip(sp.ancestors)
+	schemaObject.path = append(slices.Clip(sp.path), sp.Name)
 
 	// Determines what type of data the OpenAPI schema object is
-	if strings.Join(sp.Schema.Type.Slice(), "") == "array" { // Array
+	if alternatives := sp.alternatives(); len(alternatives) > 0 { // Object, or array of objects, with one or more possible types
+		schemaObject.Schema = alternatives[0].Value
+	} else if strings.Join(sp.Schema.Type.Slice(), "") == "array" { // Array
 		schemaObject.Schema = sp.Schema.Items.Value
 	} else if sp.Schema.AnyOf != nil { // Object
 		schemaObject.Schema = sp.Schema.AnyOf[0].Value
@@ -104,12 +258,47 @@ func (sp OpenAPISchemaProperty) ObjectOf() OpenAPISchemaObject {
 	return schemaObject
 }
 
+// DerivedTypes returns a pseudo-property for each type the property can be, other than the type returned by ObjectOf().
+// These come from either the discriminator mapping of the object, or from additional 'oneOf'/'anyOf' alternatives.
+func (sp OpenAPISchemaProperty) DerivedTypes() []OpenAPISchemaProperty {
+
+	base := sp.ObjectOf()
+	if base.Schema == nil || base.Type() == "string" {
+		return nil
+	}
+
+	derivedTypes := base.DerivedTypes()
+
+	alternatives := sp.alternatives()
+	if len(alternatives) < 2 {
+		return derivedTypes
+	}
+
+	for _, alternative := range alternatives[1:] {
+		derived := OpenAPISchemaObject{Schema: alternative.Value, doc: sp.doc, ancestors: base.ancestors, path: base.path}
+		odataType := "#" + strings.TrimPrefix(alternative.Ref, "#/components/schemas/")
+		if sp.Schema.Discriminator != nil {
+			for value, ref := range sp.Schema.Discriminator.Mapping {
+				if ref == alternative.Ref {
+					odataType = value
+				}
+			}
+		}
+		derivedType := derivedTypeProperty(base, derived, odataType)
+		derivedType.ancestors = append(slices.Clip(base.ancestors), base.Schema)
+		derivedType.path = slices.Clip(base.path)
+		derivedTypes = append(derivedTypes, derivedType)
+	}
+
+	return derivedTypes
+}
+
 func (sp OpenAPISchemaProperty) ArrayOf() string {
 
 	if strings.Join(sp.Schema.Type.Slice(), "") == "array" { // Array
 		if strings.Join(sp.Schema.Items.Value.Type.Slice(), "") == "object" || sp.Schema.Items.Ref != "" { // Array of objects
 			return "object"
-		} else if sp.Schema.Items.Value.AnyOf != nil { // Array of objects, but structured differently for some reason
+		} else if sp.Schema.Items.Value.AnyOf != nil || sp.Schema.Items.Value.OneOf != nil { // Array of objects, but structured differently for some reason
 			return "object"
 		} else { // Array of primitive type
 			return strings.Join(sp.Schema.Items.Value.Type.Slice(), "")
@@ -127,3 +316,27 @@ func (sp OpenAPISchemaProperty) Format() string {
 		return sp.Schema.Format
 	}
 }
+
+// NumberType returns the Go type the SDK holds a number in. MS Graph describes its integers, e.g. Edm.Int32, as numbers with an integer format
+func (sp OpenAPISchemaProperty) NumberType() string {
+
+	switch sp.Format() {
+	case "uint8":
+		return "byte"
+	case "int8":
+		return "int8"
+	case "int16", "int32":
+		return "int32"
+	case "int64":
+		return "int64"
+	case "float":
+		return "float32"
+	case "double":
+		return "float64"
+	}
+
+	if sp.Type() == "integer" {
+		return "int32"
+	}
+	return "float64"
+}
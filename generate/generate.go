@@ -1,8 +1,19 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"go/format"
+	"go/scanner"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 
 	"text/template"
@@ -11,104 +22,680 @@ import (
 	"terraform-provider-msgraph/generate/transform"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pmezard/go-difflib/difflib"
 )
 
-func generateDataSource(input transform.TemplateInput) {
+// Directory the templates are read from, relative to the root of the repository
+var templateDirectory = "generate/templates/"
 
-	// Create directory for package
-	os.Mkdir("msgraph/"+input.PackageName()+"/", os.ModePerm)
+// parseTemplates parses the given template files. The first file is the template to execute, the rest define templates it uses
+func parseTemplates(files ...string) (*template.Template, error) {
+	var paths []string
+	for _, file := range files {
+		paths = append(paths, templateDirectory+file)
+	}
+	return template.ParseFiles(paths...)
+}
+
+func renderDataSource(w io.Writer, input transform.TemplateInput) error {
 
 	// Get datasource templates
-	datasourceTmpl, _ := template.ParseFiles("generate/templates/data_source_template.go")
-	datasourceTmpl, _ = datasourceTmpl.ParseFiles("generate/templates/schema_template.go")
-	datasourceTmpl, _ = datasourceTmpl.ParseFiles("generate/templates/read_query_template.go")
-	datasourceTmpl, _ = datasourceTmpl.ParseFiles("generate/templates/read_response_template.go")
+	datasourceTmpl, err := parseTemplates("data_source_template.go", "schema_template.go", "read_query_template.go", "read_response_template.go")
+	if err != nil {
+		return err
+	}
 
-	// Create output file, and execute datasource template
-	outfile, _ := os.Create("msgraph/" + input.PackageName() + "/" + strings.ToLower(input.BlockName().LowerCamel()) + "_data_source.go")
-	datasourceTmpl.ExecuteTemplate(outfile, "data_source_template.go", input)
+	return datasourceTmpl.ExecuteTemplate(w, "data_source_template.go", input)
 
 }
 
-func generateResource(input transform.TemplateInput) {
+func renderResource(w io.Writer, input transform.TemplateInput) error {
 
 	// Get templates
-	resourceTmpl, _ := template.ParseFiles("generate/templates/resource_template.go")
-	resourceTmpl, _ = resourceTmpl.ParseFiles("generate/templates/schema_template.go")
-	resourceTmpl, _ = resourceTmpl.ParseFiles("generate/templates/read_query_template.go")
-	resourceTmpl, _ = resourceTmpl.ParseFiles("generate/templates/read_response_template.go")
-	resourceTmpl, _ = resourceTmpl.ParseFiles("generate/templates/create_template.go")
-	resourceTmpl, _ = resourceTmpl.ParseFiles("generate/templates/update_template.go")
+	resourceTmpl, err := parseTemplates("resource_template.go", "schema_template.go", "read_query_template.go", "read_response_template.go", "create_template.go", "update_template.go")
+	if err != nil {
+		return err
+	}
 
-	outfile, _ := os.Create("msgraph/" + input.PackageName() + "/" + strings.ToLower(input.BlockName().LowerCamel()) + "_resource.go")
-	resourceTmpl.ExecuteTemplate(outfile, "resource_template.go", input)
+	return resourceTmpl.ExecuteTemplate(w, "resource_template.go", input)
 
 }
 
-func generateModel(input transform.TemplateInput) {
+func renderModel(w io.Writer, input transform.TemplateInput) error {
 
-	// Generate model
-	modelTmpl, _ := template.ParseFiles("generate/templates/model_template.go")
-	modelOutfile, _ := os.Create("msgraph/" + input.PackageName() + "/" + strings.ToLower(input.BlockName().LowerCamel()) + "_model.go")
-	modelTmpl.ExecuteTemplate(modelOutfile, "model_template.go", input)
+	modelTmpl, err := parseTemplates("model_template.go")
+	if err != nil {
+		return err
+	}
+
+	return modelTmpl.ExecuteTemplate(w, "model_template.go", input)
 
 }
 
-func main() {
+func renderResourceTest(w io.Writer, input transform.TemplateInput) error {
+
+	testTmpl, err := parseTemplates("resource_test_template.go")
+	if err != nil {
+		return err
+	}
+
+	return testTmpl.ExecuteTemplate(w, "resource_test_template.go", input)
+
+}
+
+func renderDataSourceTest(w io.Writer, input transform.TemplateInput) error {
+
+	testTmpl, err := parseTemplates("data_source_test_template.go")
+	if err != nil {
+		return err
+	}
+
+	return testTmpl.ExecuteTemplate(w, "data_source_test_template.go", input)
+
+}
+
+func renderResourceExample(w io.Writer, input transform.TemplateInput) error {
+
+	exampleTmpl, err := parseTemplates("resource_example_template.tf")
+	if err != nil {
+		return err
+	}
+
+	return exampleTmpl.ExecuteTemplate(w, "resource_example_template.tf", input)
+
+}
+
+func renderImportExample(w io.Writer, input transform.TemplateInput) error {
+
+	exampleTmpl, err := parseTemplates("import_example_template.sh")
+	if err != nil {
+		return err
+	}
+
+	return exampleTmpl.ExecuteTemplate(w, "import_example_template.sh", input)
+
+}
+
+func renderDataSourceExample(w io.Writer, input transform.TemplateInput) error {
+
+	exampleTmpl, err := parseTemplates("data_source_example_template.tf")
+	if err != nil {
+		return err
+	}
+
+	return exampleTmpl.ExecuteTemplate(w, "data_source_example_template.tf", input)
+
+}
+
+// renderSchemaSnapshot renders the snapshot of the schema of a resource as indented JSON
+func renderSchemaSnapshot(w io.Writer, input transform.TemplateInput) error {
+	snapshot, err := json.MarshalIndent(input.SchemaSnapshot(), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(snapshot, '\n'))
+	return err
+}
+
+func renderSharedModels(w io.Writer, sharedModels *transform.SharedModels) error {
+
+	sharedModelTmpl, err := parseTemplates("shared_model_template.go", "model_template.go")
+	if err != nil {
+		return err
+	}
+
+	return sharedModelTmpl.ExecuteTemplate(w, "shared_model_template.go", sharedModels)
+
+}
+
+// formatOutput renders generated code, and formats it with go/format.
+// Formatting fails when the generated code can't be parsed, so broken code is caught before it's written
+func formatOutput(render func(w io.Writer) error) ([]byte, error) {
+
+	var output bytes.Buffer
+	if err := render(&output); err != nil {
+		// The template stopped while generating the last line rendered
+		if attribute := attributeAt(output.Bytes(), bytes.Count(output.Bytes(), []byte("\n"))+1); attribute != "" {
+			return nil, fmt.Errorf("attribute %s: %w", attribute, err)
+		}
+		return nil, err
+	}
+
+	formatted, err := format.Source(output.Bytes())
+	if err != nil {
+		var scanErrors scanner.ErrorList
+		if errors.As(err, &scanErrors) && len(scanErrors) > 0 {
+			if attribute := attributeAt(output.Bytes(), scanErrors[0].Pos.Line); attribute != "" {
+				err = fmt.Errorf("attribute %s: %w", attribute, err)
+			}
+		}
+		// Keep the unformatted code, so the line numbers in the error can be looked up
+		if unformatted, tmpErr := os.CreateTemp("", "msgraph-generate-*.go"); tmpErr == nil {
+			unformatted.Write(output.Bytes())
+			unformatted.Close()
+			return nil, fmt.Errorf("generated code is invalid, see %s: %w", unformatted.Name(), err)
+		}
+		return nil, fmt.Errorf("generated code is invalid: %w", err)
+	}
+
+	return formatted, nil
+}
+
+var (
+	schemaAttributeRegexp = regexp.MustCompile(`"(\w+)":\s*schema\.\w+Attribute\s*{`)
+	tfsdkTagRegexp        = regexp.MustCompile("`tfsdk:\"(\\w+)\"`")
+	stringLiteralRegexp   = regexp.MustCompile(`"(?:[^"\\]|\\.)*"|` + "`[^`]*`")
+)
+
+// attributeAt returns the path of the attribute generated at the given line of generated code, e.g. 'settings.limits.max_users',
+// from the schema attributes whose braces enclose the line, or the tfsdk tag of a model field on the line. It's empty when the line isn't part of an attribute
+func attributeAt(source []byte, line int) string {
+
+	type openAttribute struct {
+		name  string
+		depth int
+	}
+	var open []openAttribute
+	depth := 0
+
+	for i, text := range strings.Split(string(source), "\n") {
+
+		if i+1 == line {
+			if match := tfsdkTagRegexp.FindStringSubmatch(text); match != nil {
+				return match[1]
+			}
+			if match := schemaAttributeRegexp.FindStringSubmatch(text); match != nil {
+				open = append(open, openAttribute{name: match[1]})
+			}
+			var path []string
+			for _, attribute := range open {
+				path = append(path, attribute.name)
+			}
+			return strings.Join(path, ".")
+		}
+
+		if match := schemaAttributeRegexp.FindStringSubmatch(text); match != nil {
+			open = append(open, openAttribute{name: match[1], depth: depth})
+		}
+
+		// Braces in descriptions and struct tags don't open or close anything
+		code := stringLiteralRegexp.ReplaceAllString(text, `""`)
+		depth += strings.Count(code, "{") - strings.Count(code, "}")
+		for len(open) > 0 && depth <= open[len(open)-1].depth {
+			open = open[:len(open)-1]
+		}
+
+	}
+
+	return ""
+}
+
+// renderOutput renders a generated file. Go code is formatted, other files are kept as rendered
+func renderOutput(path string, render func(w io.Writer) error) ([]byte, error) {
+
+	if strings.HasSuffix(path, ".go") {
+		return formatOutput(render)
+	}
+
+	var output bytes.Buffer
+	if err := render(&output); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+// When set, generated code is compared to the files in msgraph/ instead of being written
+var check bool
+
+// Files that differ from the generated code, found when running with -check
+var staleFiles []string
+
+// Files rendered by this run, to find the files that are no longer generated when running with -check
+var generatedFiles = map[string]bool{}
+
+// Patterns of the files the generator writes, relative to the root of the repository
+var generatedFilePatterns = []string{
+	"msgraph/*/*_model.go",
+	"msgraph/*/*_data_source.go",
+	"msgraph/*/*_data_source_test.go",
+	"msgraph/*/*_resource.go",
+	"msgraph/*/*_resource_test.go",
+	"msgraph/*/*_resource_schema.json",
+	"msgraph/sharedmodels/shared_models.go",
+	"msgraph/beta/*/*_model.go",
+	"msgraph/beta/*/*_data_source.go",
+	"msgraph/beta/*/*_data_source_test.go",
+	"msgraph/beta/*/*_resource.go",
+	"msgraph/beta/*/*_resource_test.go",
+	"msgraph/beta/*/*_resource_schema.json",
+	"msgraph/beta/sharedmodels/shared_models.go",
+	"examples/data-sources/msgraph_*/data-source.tf",
+	"examples/resources/msgraph_*/resource.tf",
+	"examples/resources/msgraph_*/import.sh",
+}
+
+// writeOutput renders and formats generated code, and only writes it to the file once it's known to be valid
+func writeOutput(path string, render func(w io.Writer) error) error {
+
+	formatted, err := renderOutput(path, render)
+	if err != nil {
+		return fmt.Errorf("generating %s: %w", path, err)
+	}
+	generatedFiles[filepath.Clean(path)] = true
+
+	if check {
+		return checkOutput(path, formatted)
+	}
+
+	if err := os.WriteFile(path, formatted, 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// checkOutput compares generated code to the file it would be written to, and prints a unified diff when they differ
+func checkOutput(path string, generated []byte) error {
+
+	existing, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	if bytes.Equal(existing, generated) {
+		return nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(existing)),
+		B:        difflib.SplitLines(string(generated)),
+		FromFile: path,
+		ToFile:   path + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("comparing %s: %w", path, err)
+	}
+
+	fmt.Print(diff)
+	staleFiles = append(staleFiles, path)
+
+	return nil
+}
+
+// checkOrphanedFiles reports the files of generated code that would no longer be generated, e.g. those of a type removed from
+// the configuration, or the shared models once no model is shared. It is only meaningful once every type has been generated
+func checkOrphanedFiles() error {
+
+	for _, pattern := range generatedFilePatterns {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			if !generatedFiles[path] {
+				fmt.Printf("%s is no longer generated\n", path)
+				staleFiles = append(staleFiles, path)
+			}
+		}
+	}
+
+	return nil
+}
+
+func generateDataSource(input transform.TemplateInput) error {
+	return writeOutput(input.OutputDirectory()+strings.ToLower(input.BlockName().LowerCamel())+"_data_source.go", func(w io.Writer) error {
+		return renderDataSource(w, input)
+	})
+}
+
+func generateResource(input transform.TemplateInput) error {
+	return writeOutput(input.OutputDirectory()+strings.ToLower(input.BlockName().LowerCamel())+"_resource.go", func(w io.Writer) error {
+		return renderResource(w, input)
+	})
+}
+
+func generateResourceTest(input transform.TemplateInput) error {
+	return writeOutput(input.OutputDirectory()+strings.ToLower(input.BlockName().LowerCamel())+"_resource_test.go", func(w io.Writer) error {
+		return renderResourceTest(w, input)
+	})
+}
+
+func generateDataSourceTest(input transform.TemplateInput) error {
+	return writeOutput(input.OutputDirectory()+strings.ToLower(input.BlockName().LowerCamel())+"_data_source_test.go", func(w io.Writer) error {
+		return renderDataSourceTest(w, input)
+	})
+}
+
+// The path of the snapshot of the schema of a resource, which is compared to its schema when it's generated
+func schemaSnapshotPath(input transform.TemplateInput) string {
+	return input.OutputDirectory() + strings.ToLower(input.BlockName().LowerCamel()) + "_resource_schema.json"
+}
+
+// readSchemaSnapshot reads the snapshot of the schema a resource was last generated with, which is nil when the resource is new
+func readSchemaSnapshot(input transform.TemplateInput) (*transform.SchemaSnapshot, error) {
+
+	file, err := os.ReadFile(schemaSnapshotPath(input))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var snapshot transform.SchemaSnapshot
+	if err := json.Unmarshal(file, &snapshot); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", schemaSnapshotPath(input), err)
+	}
+
+	return &snapshot, nil
+}
+
+func generateSchemaSnapshot(input transform.TemplateInput) error {
+	return writeOutput(schemaSnapshotPath(input), func(w io.Writer) error {
+		return renderSchemaSnapshot(w, input)
+	})
+}
+
+func generateModel(input transform.TemplateInput) error {
+
+	// Create directory for package. Every data source and resource needs a model, so it is always generated first
+	if !check {
+		if err := os.MkdirAll(input.OutputDirectory(), os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	return writeOutput(input.OutputDirectory()+strings.ToLower(input.BlockName().LowerCamel())+"_model.go", func(w io.Writer) error {
+		return renderModel(w, input)
+	})
+}
+
+func generateSharedModels(sharedModels *transform.SharedModels, directory string) error {
+
+	// The package is only generated when types share at least one model, as an empty package has nothing to import
+	if len(sharedModels.Definitions()) == 0 {
+		return nil
+	}
+
+	if !check {
+		if err := os.MkdirAll(directory, os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	return writeOutput(directory+"shared_models.go", func(w io.Writer) error {
+		return renderSharedModels(w, sharedModels)
+	})
+}
+
+// generateExample writes an example tfplugindocs adds to the documentation of the type
+func generateExample(path string, input transform.TemplateInput, render func(w io.Writer, input transform.TemplateInput) error) error {
 
-	fmt.Println("Loading")
-	doc, err := openapi3.NewLoader().LoadFromFile("./msgraph-metadata/openapi/v1.0/openapi.yaml")
+	if !check {
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	return writeOutput(path, func(w io.Writer) error {
+		return render(w, input)
+	})
+}
+
+// validateAugment reports problems with the augment file of a path. Warnings are printed, errors stop generation
+func validateAugment(input transform.TemplateInput) error {
+
+	warnings, err := input.ValidateAugment()
+	for _, warning := range warnings {
+		fmt.Printf("Warning: %s: augment file: %s\n", input.OpenAPIPath.Path, warning)
+	}
 	if err != nil {
-		panic(err)
+		return typeError(input, err)
+	}
+
+	return nil
+}
+
+// generateType generates the model, data source, and optionally the resource, of a path, along with their examples and acceptance tests
+func generateType(input transform.TemplateInput, dataSource bool, resource bool) error {
+
+	input.HasResource = resource
+
+	if err := generateModel(input); err != nil {
+		return typeError(input, err)
+	}
+	if dataSource {
+		if err := generateDataSource(input); err != nil {
+			return typeError(input, err)
+		}
+		if err := generateExample("examples/data-sources/"+input.TerraformTypeName()+"/data-source.tf", input, renderDataSourceExample); err != nil {
+			return typeError(input, err)
+		}
+		if err := generateDataSourceTest(input); err != nil {
+			return typeError(input, err)
+		}
+	}
+	if resource {
+		priorSchema, err := readSchemaSnapshot(input)
+		if err != nil {
+			return typeError(input, err)
+		}
+		input.PriorSchema = priorSchema
+
+		if err := generateResource(input); err != nil {
+			return typeError(input, err)
+		}
+		if err := generateSchemaSnapshot(input); err != nil {
+			return typeError(input, err)
+		}
+		if err := generateExample("examples/resources/"+input.TerraformTypeName()+"/resource.tf", input, renderResourceExample); err != nil {
+			return typeError(input, err)
+		}
+		if err := generateExample("examples/resources/"+input.TerraformTypeName()+"/import.sh", input, renderImportExample); err != nil {
+			return typeError(input, err)
+		}
+		if err := generateResourceTest(input); err != nil {
+			return typeError(input, err)
+		}
 	}
-	fmt.Println("Loaded")
 
-	if len(os.Args) > 1 {
-		pathObject := extract.GetPath(doc, os.Args[1])
+	return nil
+}
+
+// typeError adds the type being generated to an error, e.g. 'msgraph_team_channel (/teams/{team-id}/channels/{channel-id}): ...'
+func typeError(input transform.TemplateInput, err error) error {
+	return fmt.Errorf("%s (%s): %w", input.TerraformTypeName(), input.OpenAPIPath.Path, err)
+}
+
+// fail reports an error, and exits with a non-zero status
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "Error: "+err.Error())
+	os.Exit(1)
+}
+
+// reportCheck reports the result of -check, once everything has been generated
+func reportCheck() {
+
+	if !check {
+		return
+	}
+
+	if len(staleFiles) > 0 {
+		fail(fmt.Errorf("generated code is out of date. Run 'make build' to regenerate it, and delete the files that are no longer generated:\n  %s", strings.Join(staleFiles, "\n  ")))
+	}
+
+	fmt.Println("Generated code is up to date")
+}
+
+func main() {
+
+	var configPath string
+	flag.StringVar(&configPath, "config", "generate/config.yaml", "Configuration listing the resources and data sources to generate")
+	flag.IntVar(&transform.MaxDepth, "max-depth", transform.MaxDepth, "Maximum depth properties can be nested, before they are truncated. 0 for no maximum")
+	beta := flag.Bool("beta", false, "Generate the path given on the command line from the MS Graph beta API")
+	flag.BoolVar(&check, "check", false, "Report the generated files that differ from msgraph/, with a diff, instead of writing them. Exits with a non-zero status when any differ")
+	flag.Parse()
+
+	// A path can be given to generate its data source, and resource if it can be updated, without it being in the configuration
+	if flag.NArg() > 0 && strings.HasPrefix(flag.Arg(0), "/") {
+		version := "v1.0"
+		if *beta {
+			version = "beta"
+		}
+		doc, err := loadSpec(version)
+		if err != nil {
+			fail(err)
+		}
+
+		pathObject := extract.GetPath(doc, flag.Arg(0))
+		if pathObject.PathItem == nil {
+			fail(fmt.Errorf("path '%s' not found in the OpenAPI spec", flag.Arg(0)))
+		}
 
 		input := transform.TemplateInput{
 			OpenAPIPath: pathObject,
+			Beta:        *beta,
+		}
+		if err := validateAugment(input); err != nil {
+			fail(err)
+		}
+
+		if err := generateType(input, true, pathObject.Patch().Summary() != ""); err != nil {
+			fail(err)
+		}
+		reportCheck()
+		return
+	}
+
+	config, err := loadConfig(configPath)
+	if err != nil {
+		fail(err)
+	}
+
+	// Every type is registered with the shared models before generating any code, even when only some types are generated,
+	// so models are shared the same way no matter which types are generated.
+	// Models are only shared between types of the same API version, as the models of msgraph-sdk-go and msgraph-beta-sdk-go differ
+	sharedModels := map[string]*transform.SharedModels{}
+
+	type generatedType struct {
+		config typeConfig
+		item   transform.TemplateInput
+		plural transform.TemplateInput
+	}
+	var generatedTypes []generatedType
+
+	for _, t := range config.Types {
+
+		doc, err := loadSpec(t.version())
+		if err != nil {
+			fail(err)
+		}
+
+		itemPath, collectionPath, err := t.resolvePaths(doc)
+		if err != nil {
+			fail(err)
+		}
+
+		if sharedModels[t.version()] == nil {
+			sharedModels[t.version()] = transform.NewSharedModels()
+		}
+
+		generated := generatedType{config: t}
+
+		if t.Resource || t.DataSource {
+			generated.item = transform.TemplateInput{
+				OpenAPIPath:  itemPath,
+				TypeName:     t.Name,
+				AugmentFile:  t.Augment,
+				SharedModels: sharedModels[t.version()],
+				Beta:         t.version() == "beta",
+			}
+			if err := validateAugment(generated.item); err != nil {
+				fail(fmt.Errorf("type '%s': %w", t.Name, err))
+			}
+			sharedModels[t.version()].Register(generated.item)
+		}
+
+		if t.PluralDataSource {
+			generated.plural = transform.TemplateInput{
+				OpenAPIPath:  collectionPath,
+				TypeName:     t.PluralName,
+				AugmentFile:  t.PluralAugment,
+				SharedModels: sharedModels[t.version()],
+				Beta:         t.version() == "beta",
+			}
+			if err := validateAugment(generated.plural); err != nil {
+				fail(fmt.Errorf("type '%s': %w", t.PluralName, err))
+			}
+			sharedModels[t.version()].Register(generated.plural)
+		}
+
+		generatedTypes = append(generatedTypes, generated)
+
+	}
+
+	for _, generated := range generatedTypes {
+
+		t := generated.config
+
+		// Type names can be given to only generate those types
+		if flag.NArg() > 0 && !slices.Contains(flag.Args(), t.Name) {
+			continue
 		}
 
-		generateDataSource(input)
-		generateModel(input)
-		if pathObject.Patch().Summary() != "" {
-			generateResource(input)
-		}
-	} else {
-
-		// TODO: Change from using paths to using tags and/or operation IDs.
-		// This should help to remove duplicate paths, and duplicate model stuff
-
-		knownGoodPaths := [...]string{
-			"/applications",
-			"/applications/{application-id}",
-			"/devices",
-			"/devices/{device-id}",
-			"/groups",
-			"/groups/{group-id}",
-			"/servicePrincipals",
-			"/servicePrincipals/{servicePrincipal-id}",
-			"/sites",
-			"/sites/{site-id}",
-			"/teams/{team-id}",
-			"/users",
-			"/users/{user-id}",
-		}
-
-		for _, path := range knownGoodPaths {
-			pathObject := extract.GetPath(doc, path)
-			input := transform.TemplateInput{
-				OpenAPIPath: pathObject,
+		if t.Resource || t.DataSource {
+			if err := generateType(generated.item, t.DataSource, t.Resource); err != nil {
+				fail(fmt.Errorf("type '%s': %w", t.Name, err))
 			}
+		}
 
-			generateDataSource(input)
-			generateModel(input)
-			if pathObject.Patch().Summary() != "" && pathObject.Delete().Summary() != "" {
-				generateResource(input)
+		if t.PluralDataSource {
+			if err := generateType(generated.plural, true, false); err != nil {
+				fail(fmt.Errorf("type '%s': %w", t.PluralName, err))
 			}
 		}
 
 	}
 
+	for version, versionSharedModels := range sharedModels {
+		directory := "msgraph/sharedmodels/"
+		if version == "beta" {
+			directory = "msgraph/beta/sharedmodels/"
+		}
+		if err := generateSharedModels(versionSharedModels, directory); err != nil {
+			fail(err)
+		}
+	}
+
+	// Only some files are generated when type names are given, so the others can't be reported
+	if check && flag.NArg() == 0 {
+		if err := checkOrphanedFiles(); err != nil {
+			fail(err)
+		}
+	}
+
+	reportCheck()
+
+}
+
+// Loaded OpenAPI specs, by API version
+var specs = map[string]*openapi3.T{}
+
+// loadSpec loads the MS Graph OpenAPI spec of an API version, either 'v1.0' or 'beta'. Each spec is only loaded once, when first needed
+func loadSpec(version string) (*openapi3.T, error) {
+
+	if doc, ok := specs[version]; ok {
+		return doc, nil
+	}
+
+	fmt.Println("Loading " + version)
+	doc, err := openapi3.NewLoader().LoadFromFile("./msgraph-metadata/openapi/" + version + "/openapi.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("loading the %s OpenAPI spec: %w", version, err)
+	}
+	fmt.Println("Loaded " + version)
+
+	specs[version] = doc
+	return doc, nil
 }
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"terraform-provider-msgraph/generate/extract"
+	"terraform-provider-msgraph/generate/transform"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Run 'go test ./generate -update' to rewrite the golden files with the current output, after checking the differences are intended
+var update = flag.Bool("update", false, "Update the golden files in generate/testdata/golden/")
+
+// Each case generates code from a path of generate/testdata/openapi.yaml, and compares it to the files in generate/testdata/golden/<name>/
+var goldenCases = []struct {
+	name     string
+	path     string
+	augment  string
+	resource bool
+
+	// Named from the path, like types generated from a path given on the command line, rather than from the name of the case
+	untyped bool
+
+	// Generated from the beta API
+	beta bool
+
+	// Snapshot of the schema the resource was last generated with, in generate/testdata/snapshots/
+	priorSchema string
+}{
+	// Enums, arrays, nested objects, UUID/time/base64 formats, and excluded properties, extra optionals, property overrides, defaults, renames and examples from the augment file
+	{name: "widget", path: "/widgets/{widget-id}", augment: "widget.yaml", resource: true},
+	// Collections
+	{name: "widgets", path: "/widgets"},
+	// Maximum depth from the augment file
+	{name: "widget_shallow", path: "/widgets/{widget-id}", augment: "widget_shallow.yaml"},
+	// Attributes removed from the configuration cleared instead of kept in state
+	{name: "widget_authoritative", path: "/widgets/{widget-id}", augment: "widget_authoritative.yaml", resource: true},
+	// Schema version bumped by attributes renamed, retyped and removed since the snapshot it was last generated with, but not by nested attributes added, and the ID kept by every upgrade
+	{name: "widget_upgraded", path: "/widgets/{widget-id}", augment: "widget.yaml", resource: true, priorSchema: "widget_v1.json"},
+	// Derived types of a polymorphic object, from the discriminator mapping of its base type
+	{name: "gadget", path: "/gadgets/{gadget-id}", resource: true},
+	// Path nested under a parent object, with the parent ID as an attribute and in the imported ID
+	{name: "gadget_component", path: "/gadgets/{gadget-id}/components/{component-id}", resource: true, untyped: true},
+	// Types of the beta API, with msgraph-beta-sdk-go and the beta client of the provider
+	{name: "widget_beta", path: "/widgets/{widget-id}", resource: true, untyped: true, beta: true},
+}
+
+func TestGolden(t *testing.T) {
+
+	templateDirectory = "templates/"
+	transform.AugmentDirectory = "testdata/augment/"
+
+	doc, err := openapi3.NewLoader().LoadFromFile("testdata/openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range goldenCases {
+		t.Run(c.name, func(t *testing.T) {
+
+			input := transform.TemplateInput{
+				OpenAPIPath: extract.GetPath(doc, c.path),
+				TypeName:    c.name,
+				AugmentFile: c.augment,
+				HasResource: c.resource,
+				Beta:        c.beta,
+			}
+			if c.untyped {
+				input.TypeName = ""
+			}
+			if c.priorSchema != "" {
+				snapshot, err := os.ReadFile("testdata/snapshots/" + c.priorSchema)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := json.Unmarshal(snapshot, &input.PriorSchema); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			checkGolden(t, c.name, "model.go", func(w io.Writer) error { return renderModel(w, input) })
+			checkGolden(t, c.name, "data_source.go", func(w io.Writer) error { return renderDataSource(w, input) })
+			checkGolden(t, c.name, "data-source.tf", func(w io.Writer) error { return renderDataSourceExample(w, input) })
+			checkGolden(t, c.name, "data_source_test.go", func(w io.Writer) error { return renderDataSourceTest(w, input) })
+			if c.resource {
+				checkGolden(t, c.name, "resource.go", func(w io.Writer) error { return renderResource(w, input) })
+				checkGolden(t, c.name, "resource_schema.json", func(w io.Writer) error { return renderSchemaSnapshot(w, input) })
+				checkGolden(t, c.name, "resource.tf", func(w io.Writer) error { return renderResourceExample(w, input) })
+				checkGolden(t, c.name, "import.sh", func(w io.Writer) error { return renderImportExample(w, input) })
+				checkGolden(t, c.name, "resource_test.go", func(w io.Writer) error { return renderResourceTest(w, input) })
+			}
+
+		})
+	}
+
+}
+
+func TestGoldenSharedModels(t *testing.T) {
+
+	templateDirectory = "templates/"
+	transform.AugmentDirectory = "testdata/augment/"
+
+	doc, err := openapi3.NewLoader().LoadFromFile("testdata/openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A widget and the collection of widgets both generate the models of the objects nested in a widget, so those are shared
+	sharedModels := transform.NewSharedModels()
+	item := transform.TemplateInput{OpenAPIPath: extract.GetPath(doc, "/widgets/{widget-id}"), TypeName: "widget", SharedModels: sharedModels}
+	plural := transform.TemplateInput{OpenAPIPath: extract.GetPath(doc, "/widgets"), TypeName: "widgets", SharedModels: sharedModels}
+	sharedModels.Register(item)
+	sharedModels.Register(plural)
+
+	checkGolden(t, "shared_models", "shared_models.go", func(w io.Writer) error { return renderSharedModels(w, sharedModels) })
+	checkGolden(t, "shared_models", "widget_model.go", func(w io.Writer) error { return renderModel(w, item) })
+	checkGolden(t, "shared_models", "widgets_model.go", func(w io.Writer) error { return renderModel(w, plural) })
+
+	// Models used by a single type aren't shared, and then there are no imports to generate
+	unshared := transform.NewSharedModels()
+	unshared.Register(transform.TemplateInput{OpenAPIPath: extract.GetPath(doc, "/widgets/{widget-id}"), TypeName: "widget", SharedModels: unshared})
+	if definitions := unshared.Definitions(); len(definitions) > 0 {
+		t.Errorf("models of a single type are shared: %v", definitions)
+	}
+	checkGolden(t, "shared_models", "shared_models_empty.go", func(w io.Writer) error { return renderSharedModels(w, unshared) })
+
+}
+
+func TestPropertiesPerDocument(t *testing.T) {
+
+	templateDirectory = "templates/"
+	transform.AugmentDirectory = "testdata/augment/"
+
+	doc, err := openapi3.NewLoader().LoadFromFile("testdata/openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := openapi3.NewLoader().LoadFromFile("testdata/openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The limits of a widget have no properties left in the other document, so they are truncated from it, but not from the first
+	other.Components.Schemas["microsoft.graph.widgetLimits"].Value.Properties = nil
+
+	for _, c := range []struct {
+		doc    *openapi3.T
+		limits bool
+	}{{doc, true}, {other, false}} {
+		var model bytes.Buffer
+		if err := renderModel(&model, transform.TemplateInput{OpenAPIPath: extract.GetPath(c.doc, "/widgets/{widget-id}"), TypeName: "widget"}); err != nil {
+			t.Fatal(err)
+		}
+		if limits := strings.Contains(model.String(), `tfsdk:"limits"`); limits != c.limits {
+			t.Errorf("limits generated: %t, want %t", limits, c.limits)
+		}
+	}
+
+}
+
+func TestAugmentValidation(t *testing.T) {
+
+	transform.AugmentDirectory = "testdata/augment/"
+
+	doc, err := openapi3.NewLoader().LoadFromFile("testdata/openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		augment  string
+		errors   []string
+		warnings int
+	}{
+		{augment: "widget.yaml"},
+		{augment: "unknown_key.yaml", errors: []string{"field excludedProperty not found"}},
+		{augment: "unknown_property.yaml", errors: []string{"'missing_example' is not an attribute", "'missingProperty' is not a property", "'settings.missingProperty' is not a property", "'missing_attribute' is not an attribute", "yes is not a valid default for a bool attribute", "not supported for object attributes", "'enabled' clashes with the attribute of 'enabled'", "'count' is reserved", "renames: 'settings.missingProperty' is not a property", "importIdentifiers: 'missingProperty' is not a property", "'settings.limits.maxUsers' is not a top-level string property", "moveStateFrom: 'example/legacy' needs both a provider and a type", "moveStateFrom: : 'missingProperty' is not a property", "'settings' is not a top-level string, boolean, integer, number or list of strings", "'prevent_deletion' clashes with the attribute added to delete the resource"}, warnings: 1},
+		{augment: "missing.yaml", errors: []string{"no such file"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.augment, func(t *testing.T) {
+
+			input := transform.TemplateInput{
+				OpenAPIPath: extract.GetPath(doc, "/widgets/{widget-id}"),
+				TypeName:    "widget",
+				AugmentFile: c.augment,
+			}
+
+			warnings, err := input.ValidateAugment()
+
+			if len(c.errors) == 0 && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			for _, expected := range c.errors {
+				if err == nil || !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error containing %q, got: %v", expected, err)
+				}
+			}
+			if len(warnings) != c.warnings {
+				t.Errorf("expected %d warnings, got: %v", c.warnings, warnings)
+			}
+
+		})
+	}
+
+}
+
+func TestFormatOutputErrors(t *testing.T) {
+
+	schemaSource := `package widgets
+
+var s = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{
+			Description: "Closed {before} the settings",
+		},
+		"settings": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"theme": schema.StringAttribute{
+					Optional: true true,
+				},
+			},
+		},
+	},
+}
+`
+
+	cases := []struct {
+		name   string
+		render func(w io.Writer) error
+		error  string
+	}{
+		// Code that can't be parsed is reported with the attribute enclosing the line it fails on
+		{name: "invalid", render: func(w io.Writer) error {
+			_, err := io.WriteString(w, schemaSource)
+			return err
+		}, error: "attribute settings.theme:"},
+		// Templates that fail are reported with the attribute they were generating
+		{name: "template", render: func(w io.Writer) error {
+			io.WriteString(w, schemaSource[:strings.Index(schemaSource, "Optional")])
+			return errors.New("template failed")
+		}, error: "attribute settings.theme: template failed"},
+		{name: "model", render: func(w io.Writer) error {
+			_, err := io.WriteString(w, "package widgets\n\ntype widgetModel struct {\n\tTheme types.String `tfsdk:\"theme\"` `tfsdk:\"theme\"`\n}\n")
+			return err
+		}, error: "attribute theme:"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := formatOutput(c.render)
+			if err == nil || !strings.Contains(err.Error(), c.error) {
+				t.Errorf("got error %v, want one containing %q", err, c.error)
+			}
+		})
+	}
+
+}
+
+func TestCheckOrphanedFiles(t *testing.T) {
+
+	directory, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(directory)
+		generatedFiles = map[string]bool{}
+		staleFiles = nil
+	})
+
+	for _, file := range []string{
+		"msgraph/provider.go",
+		"msgraph/users/user_model.go",
+		"msgraph/users/user_resource_delete_test.go",
+		"msgraph/groups/group_model.go",
+		"msgraph/sharedmodels/shared_models.go",
+		"examples/resources/msgraph_group/resource.tf",
+	} {
+		if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only users are generated, and no model is shared. Files that aren't generated code are left alone
+	generatedFiles = map[string]bool{"msgraph/users/user_model.go": true}
+	staleFiles = nil
+
+	if err := checkOrphanedFiles(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"msgraph/groups/group_model.go", "msgraph/sharedmodels/shared_models.go", "examples/resources/msgraph_group/resource.tf"}
+	if !slices.Equal(staleFiles, want) {
+		t.Errorf("got files no longer generated %v, want %v", staleFiles, want)
+	}
+
+}
+
+// checkGolden compares the output of render to the golden file, or replaces the golden file when running with -update
+func checkGolden(t *testing.T, name string, file string, render func(w io.Writer) error) {
+	t.Helper()
+
+	output, err := renderOutput(file, render)
+	if err != nil {
+		t.Fatalf("generating %s: %s", file, err)
+	}
+
+	goldenPath := filepath.Join("testdata", "golden", name, file+".golden")
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(goldenPath), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(goldenPath, output, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	golden, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("reading golden file: %s. Run 'go test ./generate -update' to create it", err)
+	}
+
+	if !bytes.Equal(output, golden) {
+		t.Errorf("%s differs from %s. Run 'go test ./generate -update' to update it, if the change is intended\n\ngot:\n%s", file, goldenPath, output)
+	}
+}
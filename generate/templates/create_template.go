@@ -1,7 +1,7 @@
 // Create creates the resource and sets the initial Terraform state.
 func (r *{{.Template.BlockName.LowerCamel}}Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from Terraform plan
-	var tfPlan{{.Template.BlockName.UpperCamel}} {{.Template.BlockName.LowerCamel}}Model
+	var tfPlan{{.Template.BlockName.UpperCamel}} {{.Template.ResourceModelName}}
 	diags := req.Plan.Get(ctx, &tfPlan{{.Template.BlockName.UpperCamel}})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -9,7 +9,11 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Create(ctx context.Context,
 	}
 
 	// Generate API request body from Terraform plan
-	requestBody{{.Template.BlockName.UpperCamel}} := models.New{{.Template.BlockName.UpperCamel}}()
+	{{- if .HasDerivedTypes}}
+	var requestBody{{.Template.BlockName.UpperCamel}} models.{{.Template.SdkModelName}}able = models.New{{.Template.SdkModelName}}()
+	{{- else}}
+	requestBody{{.Template.BlockName.UpperCamel}} := models.New{{.Template.SdkModelName}}()
+	{{- end}}
 
 	{{- define "CreateStringAttribute" }}
 	if !tfPlan{{.ParentName}}.{{.Name}}.IsUnknown(){
@@ -62,13 +66,22 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Create(ctx context.Context,
 
 	{{- define "CreateInt64Attribute" }}
 	if !tfPlan{{.ParentName}}.{{.Name}}.IsUnknown(){
-	tfPlan{{.Name}} := tfPlan{{.ParentName}}.{{.Name}}.ValueInt64()
-	requestBody{{.ParentName}}.Set{{.Name}}(&tfPlan{{.Name}})
+	tfPlan{{.Name}} := {{.NumberType}}(tfPlan{{.ParentName}}.{{.Name}}.ValueInt64())
+	requestBody{{.ParentName}}.Set{{.SetModelMethod}}(&tfPlan{{.Name}})
 	} else {
 		tfPlan{{.ParentName}}.{{.Name}} = types.Int64Null()
 	}
 	{{- end}}
 
+	{{- define "CreateFloat64Attribute" }}
+	if !tfPlan{{.ParentName}}.{{.Name}}.IsUnknown(){
+	tfPlan{{.Name}} := {{.NumberType}}(tfPlan{{.ParentName}}.{{.Name}}.ValueFloat64())
+	requestBody{{.ParentName}}.Set{{.SetModelMethod}}(&tfPlan{{.Name}})
+	} else {
+		tfPlan{{.ParentName}}.{{.Name}} = types.Float64Null()
+	}
+	{{- end}}
+
 	{{- define "CreateBoolAttribute" }}
 	if !tfPlan{{.ParentName}}.{{.Name}}.IsUnknown(){
 	tfPlan{{.Name}} := tfPlan{{.ParentName}}.{{.Name}}.ValueBool()
@@ -107,8 +120,12 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Create(ctx context.Context,
 	if len(tfPlan{{.ParentName}}.{{.Name}}.Elements()) > 0 {
 		var requestBody{{.Name}} []models.{{.ObjectOf}}able
 		for _, i := range tfPlan{{.ParentName}}.{{.Name}}.Elements() {
+			{{- if .HasDerivedTypes}}
+			var requestBody{{.ObjectOf}} models.{{.ObjectOf}}able = models.New{{.ObjectOf}}()
+			{{- else}}
 			requestBody{{.ObjectOf}} := models.New{{.ObjectOf}}()
-			tfPlan{{.ObjectOf}} := {{.TfModelName}}Model{}
+			{{- end}}
+			tfPlan{{.ObjectOf}} := {{.TfModelName}}{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlan{{.ObjectOf}})
 			{{template "generate_create" .NestedCreate}}
 		}
@@ -120,8 +137,12 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Create(ctx context.Context,
 
 	{{- define "CreateObjectAttribute" }}
 	if !tfPlan{{.ParentName}}.{{.Name}}.IsUnknown(){
+		{{- if .HasDerivedTypes}}
+		var requestBody{{.ObjectOf}} models.{{.ObjectOf}}able = models.New{{.ObjectOf}}()
+		{{- else}}
 		requestBody{{.ObjectOf}} := models.New{{.ObjectOf}}()
-		tfPlan{{.ObjectOf}} := {{.TfModelName}}Model{}
+		{{- end}}
+		tfPlan{{.ObjectOf}} := {{.TfModelName}}{}
 		tfPlan{{.ParentName}}.{{.Name}}.As(ctx, &tfPlan{{.ObjectOf}}, basetypes.ObjectAsOptions{})
 		{{template "generate_create" .NestedCreate}}
 		requestBody{{.ParentName}}.Set{{.Name}}(requestBody{{.ObjectOf}})
@@ -131,6 +152,21 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Create(ctx context.Context,
 	}
 	{{- end}}
 
+	{{- define "CreateDerivedTypeAttribute" }}
+	if !tfPlan{{.ParentName}}.{{.Name}}.IsNull() && !tfPlan{{.ParentName}}.{{.Name}}.IsUnknown() {
+		requestBody{{.ObjectOf}} := models.New{{.ObjectOf}}()
+		tfPlan{{.ObjectOf}} := {{.TfModelName}}{}
+		tfPlan{{.ParentName}}.{{.Name}}.As(ctx, &tfPlan{{.ObjectOf}}, basetypes.ObjectAsOptions{})
+		{{template "generate_create" .NestedCreate}}
+		odataType{{.ObjectOf}} := "{{.DiscriminatorValue}}"
+		requestBody{{.ObjectOf}}.SetOdataType(&odataType{{.ObjectOf}})
+		requestBody{{.ParentName}} = requestBody{{.ObjectOf}}
+		tfPlan{{.ParentName}}.{{.Name}}, _ = types.ObjectValueFrom(ctx, tfPlan{{.ObjectOf}}.AttributeTypes(), tfPlan{{.ObjectOf}})
+	} else {
+		tfPlan{{.ParentName}}.{{.Name}} = types.ObjectNull(tfPlan{{.ParentName}}.{{.Name}}.AttributeTypes(ctx))
+	}
+	{{- end}}
+
 	{{- block "generate_create" .Attributes}}
 	{{- range .}}
 	{{- if eq .Type "CreateStringAttribute"}}
@@ -145,6 +181,8 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Create(ctx context.Context,
 	{{- template "CreateStringBase64UrlAttribute" .}}
 	{{- else if eq .Type "CreateInt64Attribute"}}
 	{{- template "CreateInt64Attribute" .}}
+	{{- else if eq .Type "CreateFloat64Attribute"}}
+	{{- template "CreateFloat64Attribute" .}}
 	{{- else if eq .Type "CreateBoolAttribute"}}
 	{{- template "CreateBoolAttribute" .}}
 	{{- else if eq .Type "CreateArrayStringAttribute"}}
@@ -155,12 +193,17 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Create(ctx context.Context,
 	{{- template "CreateArrayObjectAttribute" . }}
 	{{- else if eq .Type "CreateObjectAttribute"}}
 	{{- template "CreateObjectAttribute" .}}
+	{{- else if eq .Type "CreateDerivedTypeAttribute"}}
+	{{- template "CreateDerivedTypeAttribute" .}}
 	{{- end}}
 	{{end}}
 	{{- end}}
 
 	// Create new {{.Template.BlockName.UpperCamel}}
-	result, err := r.client.{{range .PostMethod}}{{.MethodName}}({{.Parameter}}).{{end}}Post(context.Background(), requestBody{{.Template.BlockName.UpperCamel}}, nil)
+	created := clients.NewCreatedObject()
+	result, err := r.client.{{range .PostMethod}}{{.MethodName}}({{.Parameter}}).{{end}}Post(ctx, requestBody{{.Template.BlockName.UpperCamel}}, &{{.Configuration}}RequestBuilderPostRequestConfiguration{
+		Options: created.Options(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating {{.Template.BlockName.UpperCamel}}",
@@ -171,7 +214,15 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Create(ctx context.Context,
 
 	// Map response body to schema and populate Computed attribute value
 	// TODO: Add support for other Computed values
-	tfPlan{{.Template.BlockName.UpperCamel}}.Id = types.StringValue(*result.GetId())
+	id, err := created.Id(result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating {{.Template.BlockName.UpperCamel}}",
+			err.Error(),
+		)
+		return
+	}
+	tfPlan{{.Template.BlockName.UpperCamel}}.Id = types.StringValue(id)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, tfPlan{{.Template.BlockName.UpperCamel}})
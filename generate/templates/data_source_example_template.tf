@@ -0,0 +1,7 @@
+data "{{.TerraformTypeName}}" "example" {
+{{- with .DataSourceExample.Arguments}}
+{{- range .}}
+  {{.}}
+{{- end}}
+{{end -}}
+}
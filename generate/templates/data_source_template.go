@@ -8,17 +8,28 @@ import (
 	{{- end}}
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	{{- if .SchemaDataSource.IfValidatorsUsed }}
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	{{- end}}
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	{{- if .ReadResponse.IfBasetypesImportNeeded }}
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	{{- end}}
 
-	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
-	{{- if .ReadQuery.MultipleGetMethodParameters }}
-	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	msgraphsdk "{{.SdkModule}}"
+	{{- if or .ReadQuery.MultipleGetMethodParameters .ReadResponse.IfDerivedTypesUsed }}
+	"{{.SdkModule}}/models"
+	{{- end}}
+	"{{.SdkModule}}/{{.PackageName}}"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	{{- if .IfSharedModelsImportNeeded }}
+	"{{.SharedModelsPackage}}"
+	{{- end}}
+	{{- range .SchemaDataSource.OverrideImports }}
+	"{{.}}"
 	{{- end}}
-	"github.com/microsoftgraph/msgraph-sdk-go/{{.PackageName}}"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -39,16 +50,23 @@ type {{.BlockName.LowerCamel}}DataSource struct{
 
 // Metadata returns the data source type name.
 func (d *{{.BlockName.LowerCamel}}DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-    resp.TypeName = req.ProviderTypeName + "_{{.BlockName.Snake}}"
+    resp.TypeName = req.ProviderTypeName + "_{{if .Beta}}beta_{{end}}{{.BlockName.Snake}}"
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *{{.BlockName.LowerCamel}}DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *{{.BlockName.LowerCamel}}DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	{{- if .Beta }}
+
+	// Beta resources and data sources create their client for the beta API from the request adapter the provider configured
+	d.client = msgraphsdk.NewGraphServiceClient(req.ProviderData.(*clients.Clients).Beta)
+	{{- else }}
+
+	d.client = req.ProviderData.(*clients.Clients).V1
+	{{- end}}
 }
 
 // Schema defines the schema for the data source.
@@ -0,0 +1,56 @@
+package {{.PackageName}}_test
+
+import (
+	{{- if and (not .HasResource) .AcceptanceTest.IdEnvironmentVariable }}
+	"fmt"
+	"os"
+	{{- end}}
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+{{- with .AcceptanceTest}}
+
+func TestAcc{{$.BlockName.UpperFirst}}DataSource(t *testing.T) {
+	{{- if $.HasResource }}
+	config := {{$.BlockName.LowerCamel}}ResourceConfig + `
+{{.DataSourceConfig true}}
+`
+	{{- else if .IdEnvironmentVariable }}
+	// There's no resource to create an object to look up, so an existing one is needed
+	id := os.Getenv("{{.IdEnvironmentVariable}}")
+	if id == "" {
+		t.Skip("{{.IdEnvironmentVariable}} must be set to the id of an existing object to look up")
+	}
+
+	config := fmt.Sprintf(`
+{{.DataSourceConfig false}}
+`, id)
+	{{- else}}
+	config := `
+{{.DataSourceConfig false}}
+`
+	{{- end}}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					{{- if $.HasResource }}
+					resource.TestCheckResourceAttrPair("{{.DataSourceAddress}}", "id", "{{.ResourceAddress}}", "id"),
+					{{- else if .IdEnvironmentVariable }}
+					resource.TestCheckResourceAttr("{{.DataSourceAddress}}", "id", id),
+					{{- else}}
+					resource.TestCheckResourceAttrSet("{{.DataSourceAddress}}", "{{.LookupCheckAttribute}}"),
+					{{- end}}
+				),
+			},
+		},
+	})
+}
+{{- end}}
@@ -0,0 +1,9 @@
+{{- if .ParentParameters -}}
+# The ID is made up of {{.ImportIdFormat}}
+{{end -}}
+terraform import {{.TerraformTypeName}}.example {{.ImportIdExample}}
+{{- range .ImportIdentifiers}}
+
+# Or by its {{.Attribute}}
+terraform import {{$.TerraformTypeName}}.example {{.Prefix}}:{{.Example}}
+{{- end}}
@@ -3,9 +3,17 @@ package {{.PackageName}}
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	{{- if .IfSharedModelsImportNeeded }}
+
+	"{{.SharedModelsPackage}}"
+	{{- end}}
 )
 
 {{- range .Model.Definitions}}
+{{template "model_definition" .}}
+{{end}}
+
+{{- define "model_definition" }}
 type {{.ModelName}} struct {
 {{- range .ModelFields}}
 {{.FieldName}} {{.FieldType}} `tfsdk:"{{.AttributeName}}"`
@@ -20,5 +28,4 @@ func (m {{.ModelName}}) AttributeTypes() map[string]attr.Type {
 		{{- end}}
 	}
 }
-
-{{end}}
+{{- end}}
@@ -13,7 +13,7 @@ response{{.BlockName}}, err := d.client.{{range .GetMethod}}{{.MethodName}}({{.P
 {{- end}}
 
 {{ define "NonZeroParameters" }}
-var response{{.BlockName}} models.{{.BlockName}}able
+var response{{.BlockName}} models.{{.SdkModelName}}able
 var err error
 
 if !tfState{{.BlockName}}.Id.IsNull() {
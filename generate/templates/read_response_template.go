@@ -25,12 +25,20 @@ if response{{.ParentName}}.Get{{.GetMethod}}() != nil {
 
 {{- define "ReadInt64Attribute" }}
 if response{{.ParentName}}.Get{{.GetMethod}}() != nil {
-	{{.ParentName}}.{{.Name}} = types.Int64Value(int64(*response{{.ParentName}}.Get{{.GetMethod}}()))
+	tfState{{.ParentName}}.{{.Name}} = types.Int64Value(int64(*response{{.ParentName}}.Get{{.GetMethod}}()))
 } else {
 	tfState{{.ParentName}}.{{.Name}} = types.Int64Null()
 }
 {{- end}}
 
+{{- define "ReadFloat64Attribute" }}
+if response{{.ParentName}}.Get{{.GetMethod}}() != nil {
+	tfState{{.ParentName}}.{{.Name}} = types.Float64Value(float64(*response{{.ParentName}}.Get{{.GetMethod}}()))
+} else {
+	tfState{{.ParentName}}.{{.Name}} = types.Float64Null()
+}
+{{- end}}
+
 {{- define "ReadBoolAttribute" }}
 if response{{.ParentName}}.Get{{.GetMethod}}() != nil {
 	tfState{{ .ParentName}}.{{.Name}} = types.BoolValue(*response{{.ParentName}}.Get{{.GetMethod}}())
@@ -41,7 +49,7 @@ if response{{.ParentName}}.Get{{.GetMethod}}() != nil {
 
 {{- define "ReadSingleNestedAttribute" }}
 if response{{.ParentName}}.Get{{.GetMethod}}() != nil {
-	tfState{{.ObjectOf}} := {{.TfModelName}}Model{}
+	tfState{{.ObjectOf}} := {{.TfModelName}}{}
 	response{{.ObjectOf}} := response{{.ParentName}}.Get{{.GetMethod}}()
 	{{template "generate_read" .NestedRead}}
 
@@ -78,7 +86,7 @@ if len(response{{.ParentName}}.Get{{.GetMethod}}()) > 0 {
 if len(response{{.ParentName}}.Get{{.GetMethod}}()) > 0 {
 	objectValues := []basetypes.ObjectValue{}
 	for _, response{{.ObjectOf}} := range response{{.ParentName}}.Get{{.GetMethod}}() {
-		tfState{{.ObjectOf}} := {{.TfModelName}}Model{}
+		tfState{{.ObjectOf}} := {{.TfModelName}}{}
 			{{template "generate_read" .NestedRead}}
 		objectValue, _ := types.ObjectValueFrom(ctx, tfState{{.ObjectOf}}.AttributeTypes(), tfState{{.ObjectOf}})
 		objectValues = append(objectValues, objectValue)
@@ -87,6 +95,16 @@ tfState{{.ParentName}}.{{.Name}}, _ = types.ListValueFrom(ctx, objectValues[0].T
 }
 {{- end}}
 
+{{- define "ReadDerivedTypeAttribute" }}
+if response{{.ObjectOf}}, ok := response{{.ParentName}}.(models.{{.ObjectOf}}able); ok {
+	tfState{{.ObjectOf}} := {{.TfModelName}}{}
+	{{template "generate_read" .NestedRead}}
+
+	tfState{{.ParentName}}.{{.Name}}, _ = types.ObjectValueFrom(ctx, tfState{{.ObjectOf}}.AttributeTypes(), tfState{{.ObjectOf}})
+} else {
+	tfState{{.ParentName}}.{{.Name}} = types.ObjectNull({{.TfModelName}}{}.AttributeTypes())
+}
+{{- end}}
 
 {{/* Generate statements to map response to state */}}
 {{- block "generate_read" .Attributes}}
@@ -99,6 +117,8 @@ tfState{{.ParentName}}.{{.Name}}, _ = types.ListValueFrom(ctx, objectValues[0].T
 {{- template "ReadStringFormattedAttribute" .}}
 {{- else if eq .Type "ReadInt64Attribute"}}
 {{- template "ReadInt64Attribute" .}}
+{{- else if eq .Type "ReadFloat64Attribute"}}
+{{- template "ReadFloat64Attribute" .}}
 {{- else if eq .Type "ReadBoolAttribute"}}
 {{- template "ReadBoolAttribute" .}}
 {{- else if eq .Type "ReadListStringAttribute"}}
@@ -109,6 +129,8 @@ tfState{{.ParentName}}.{{.Name}}, _ = types.ListValueFrom(ctx, objectValues[0].T
 {{- template "ReadSingleNestedAttribute" .}}
 {{- else if eq .Type "ReadListNestedAttribute"}}
 {{- template "ReadListNestedAttribute" .}}
+{{- else if eq .Type "ReadDerivedTypeAttribute"}}
+{{- template "ReadDerivedTypeAttribute" .}}
 {{- end}}
 {{- end}}
 {{- end}}
@@ -0,0 +1,7 @@
+resource "{{.TerraformTypeName}}" "example" {
+{{- with .ResourceExample.Arguments}}
+{{- range .}}
+  {{.}}
+{{- end}}
+{{end -}}
+}
@@ -2,6 +2,18 @@ package {{.PackageName}}
 
 import (
 	"context"
+	{{- if or .StateMovers .StateUpgraders }}
+	"encoding/json"
+	{{- end}}
+	{{- if .IfSoftDeleted }}
+	"errors"
+	{{- end}}
+	{{- if or .ParentParameters .ImportIdentifiers .IfPreventDeletion .IfSoftDeleted }}
+	"fmt"
+	{{- end}}
+	{{- if or .ParentParameters .ImportIdentifiers .StateMovers }}
+	"strings"
+	{{- end}}
 	{{- if .CreateRequest.IfUuidImportNeeded }}
 	"github.com/google/uuid"
 	{{- end}}
@@ -13,30 +25,81 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	{{- if or (.SchemaResource.IfDefaultUsed "Bool") .IfPreventDeletion .IfSoftDeleted }}
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	{{- end}}
+	{{- if .SchemaResource.IfDefaultUsed "Float64" }}
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	{{- end}}
+	{{- if .SchemaResource.IfDefaultUsed "Int64" }}
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	{{- end}}
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	{{- if .SchemaResource.IfValidatorsUsed }}
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	{{- end}}
+	{{- if .SchemaResource.IfDefaultUsed "String" }}
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	{{- end}}
+	{{- if .SchemaResource.IfRequiresReplaceUsed }}
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	{{- end}}
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	{{- if .StateUpgraders }}
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	{{- end}}
+	{{- if .UpdateRequest.IfNullValuesSent }}
+	absser "github.com/microsoft/kiota-abstractions-go/serialization"
+	{{- end}}
 
-	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
+	msgraphsdk "{{.SdkModule}}"
 	{{- if .ReadQuery.MultipleGetMethodParameters }}
-	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"{{.SdkModule}}/models"
+	{{- end}}
+	{{- if .IfSoftDeleted }}
+	"{{.SdkModule}}/models/odataerrors"
 	{{- end}}
-	"github.com/microsoftgraph/msgraph-sdk-go/{{.PackageName}}"
+	"{{.SdkModule}}/{{.PackageName}}"
 
+	"terraform-provider-msgraph/msgraph/clients"
+	{{- if .IfSharedModelsImportNeeded }}
+	"{{.SharedModelsPackage}}"
+	{{- end}}
+	{{- if .SchemaResource.IfPlanModifiersImportNeeded "Bool" }}
 	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers"
-	{{- if .SchemaResource.IfListPlanModifiersImportNeeded }}
+	{{- end}}
+	{{- if .SchemaResource.IfPlanModifiersImportNeeded "Float64" }}
+	"terraform-provider-msgraph/planmodifiers/float64planmodifiers"
+	{{- end}}
+	{{- if .SchemaResource.IfPlanModifiersImportNeeded "Int64" }}
+	"terraform-provider-msgraph/planmodifiers/int64planmodifiers"
+	{{- end}}
+	{{- if .SchemaResource.IfPlanModifiersImportNeeded "List" }}
 	"terraform-provider-msgraph/planmodifiers/listplanmodifiers"
 	{{- end}}
-	{{- if .SchemaResource.IfSingleNestedAttributeUsed nil }}
+	{{- if .SchemaResource.IfPlanModifiersImportNeeded "Object" }}
 	"terraform-provider-msgraph/planmodifiers/objectplanmodifiers"
 	{{- end}}
+	{{- if .SchemaResource.IfPlanModifiersImportNeeded "String" }}
 	"terraform-provider-msgraph/planmodifiers/stringplanmodifiers"
+	{{- end}}
+	{{- range .SchemaResource.OverrideImports }}
+	"{{.}}"
+	{{- end}}
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
     _ resource.Resource = &{{.BlockName.LowerCamel}}Resource{}
     _ resource.ResourceWithConfigure = &{{.BlockName.LowerCamel}}Resource{}
+    {{- if .StateMovers}}
+    _ resource.ResourceWithMoveState = &{{.BlockName.LowerCamel}}Resource{}
+    {{- end}}
+    {{- if .StateUpgraders}}
+    _ resource.ResourceWithUpgradeState = &{{.BlockName.LowerCamel}}Resource{}
+    {{- end}}
 )
 
 // New{{.BlockName.UpperCamel}}Resource is a helper function to simplify the provider implementation.
@@ -48,27 +111,66 @@ func New{{.BlockName.UpperCamel}}Resource() resource.Resource {
 type {{.BlockName.LowerCamel}}Resource struct{
 	client *msgraphsdk.GraphServiceClient
 }
+{{- if ne .ResourceModelName (print .BlockName.LowerCamel "Model")}}
+
+// {{.ResourceModelName}} is the model of the resource, which has the attributes controlling how it's deleted besides those of the {{.BlockName.Snake}}
+type {{.ResourceModelName}} struct {
+	{{.BlockName.LowerCamel}}Model
+	{{- if .IfPreventDeletion}}
+	PreventDeletion types.Bool `tfsdk:"prevent_deletion"`
+	{{- end}}
+	{{- if .IfSoftDeleted}}
+	PermanentlyDelete types.Bool `tfsdk:"permanently_delete"`
+	{{- end}}
+}
+{{- end}}
 
 // Metadata returns the resource type name.
 func (d *{{.BlockName.LowerCamel}}Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-    resp.TypeName = req.ProviderTypeName + "_{{.BlockName.Snake}}"
+    resp.TypeName = req.ProviderTypeName + "_{{if .Beta}}beta_{{end}}{{.BlockName.Snake}}"
 }
 
 // Configure adds the provider configured client to the resource.
-func (d *{{.BlockName.LowerCamel}}Resource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (d *{{.BlockName.LowerCamel}}Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	{{- if .Beta }}
+
+	// Beta resources and data sources create their client for the beta API from the request adapter the provider configured
+	d.client = msgraphsdk.NewGraphServiceClient(req.ProviderData.(*clients.Clients).Beta)
+	{{- else }}
+
+	d.client = req.ProviderData.(*clients.Clients).V1
+	{{- end}}
 }
 
 // Schema defines the schema for the resource.
 func (d *{{.BlockName.LowerCamel}}Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
     resp.Schema = schema.Schema{
 		Description: "{{- .SchemaDescription }}",
+		{{- if .SchemaVersion}}
+		Version: {{.SchemaVersion}},
+		{{- end}}
 		Attributes: map[string]schema.Attribute{
 			{{- template "schema_template.go" .SchemaResource}}
+			{{- if .IfPreventDeletion}}
+			"prevent_deletion": schema.BoolAttribute{
+				Description: "Whether deleting the resource fails, to protect the object from being deleted by Terraform. Set it to `false`, and apply, before the resource can be deleted. Not sent to the API. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			{{- end}}
+			{{- if .IfSoftDeleted}}
+			"permanently_delete": schema.BoolAttribute{
+				Description: "Whether the object is also purged from the deleted items of the directory when the resource is deleted, instead of being kept there for 30 days to be restored. Not sent to the API. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			{{- end}}
 		},
 	}
 }
@@ -77,7 +179,7 @@ func (d *{{.BlockName.LowerCamel}}Resource) Schema(_ context.Context, _ resource
 
 // Read refreshes the Terraform state with the latest data.
 func (d *{{.BlockName.LowerCamel}}Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var tfState{{.BlockName.UpperCamel}} {{.BlockName.LowerCamel}}Model
+	var tfState{{.BlockName.UpperCamel}} {{.ResourceModelName}}
 	resp.Diagnostics.Append(req.State.Get(ctx, &tfState{{.BlockName.UpperCamel}})...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -86,6 +188,18 @@ func (d *{{.BlockName.LowerCamel}}Resource) Read(ctx context.Context, req resour
 	{{ template "read_query_template.go" .ReadQuery}}
 
 	{{ template "read_response_template.go" .ReadResponse}}
+	{{- if .IfPreventDeletion}}
+
+	// Not set when the resource is imported
+	if tfState{{.BlockName.UpperCamel}}.PreventDeletion.IsNull() {
+		tfState{{.BlockName.UpperCamel}}.PreventDeletion = types.BoolValue(false)
+	}
+	{{- end}}
+	{{- if .IfSoftDeleted}}
+	if tfState{{.BlockName.UpperCamel}}.PermanentlyDelete.IsNull() {
+		tfState{{.BlockName.UpperCamel}}.PermanentlyDelete = types.BoolValue(false)
+	}
+	{{- end}}
 
 
 	// Overwrite items with refreshed state
@@ -103,15 +217,24 @@ func (d *{{.BlockName.LowerCamel}}Resource) Read(ctx context.Context, req resour
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *{{.BlockName.LowerCamel}}Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from Terraform state
-	var tfState{{.BlockName.UpperCamel}} {{.BlockName.LowerCamel}}Model
+	var tfState{{.BlockName.UpperCamel}} {{.ResourceModelName}}
 	diags := req.State.Get(ctx, &tfState{{.BlockName.UpperCamel}})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	{{- if .IfPreventDeletion}}
+
+	if tfState{{.BlockName.UpperCamel}}.PreventDeletion.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion of {{.BlockName.Snake}} prevented",
+			fmt.Sprintf("The {{.BlockName.Snake}} %s can't be deleted while prevent_deletion is true. Set it to false, and apply, before deleting it.", tfState{{.BlockName.UpperCamel}}.Id.ValueString()),
+		)
+		return
+	}
+	{{- end}}
 
-	// TODO: Delete {{.BlockName.LowerCamel}}
-	err := r.client.{{range .UpdateRequest.PostMethod}}{{.MethodName}}({{.Parameter}}).{{end}}Delete(context.Background(), nil)
+	err := r.client.{{range .UpdateRequest.PostMethod}}{{.MethodName}}({{.Parameter}}).{{end}}Delete(ctx, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting {{.BlockName.Snake}}",
@@ -119,10 +242,245 @@ func (r *{{.BlockName.LowerCamel}}Resource) Delete(ctx context.Context, req reso
 		)
 		return
 	}
+	{{- if .IfSoftDeleted}}
+
+	// The object is kept in the deleted items of the directory, from which it can be restored, unless it's purged.
+	// It can take a few seconds to show up there. The object is deleted either way, so failing to purge it is only a warning,
+	// and the resource is still removed from the state
+	if tfState{{.BlockName.UpperCamel}}.PermanentlyDelete.ValueBool() {
+		for attempt := 1; ; attempt++ {
+			err = r.client.Directory().DeletedItems().ByDirectoryObjectId(tfState{{.BlockName.UpperCamel}}.Id.ValueString()).Delete(ctx, nil)
+			var odataErr *odataerrors.ODataError
+			if err == nil || !errors.As(err, &odataErr) || odataErr.ResponseStatusCode != 404 || attempt == 10 {
+				break
+			}
+			// Stop waiting when Terraform is interrupted
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+			case <-time.After(3 * time.Second):
+				continue
+			}
+			break
+		}
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Couldn't permanently delete {{.BlockName.Snake}}",
+				fmt.Sprintf("The {{.BlockName.Snake}} %s was deleted, but couldn't be purged from the deleted items of the directory, where it's kept for 30 days: %s", tfState{{.BlockName.UpperCamel}}.Id.ValueString(), err.Error()),
+			)
+		}
+	}
+	{{- end}}
 
 }
 
 func (r *{{.BlockName.LowerCamel}}Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	{{- if .ParentParameters }}
+	// The IDs of the parents are needed to find the object, so they're imported along with its own ID
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != {{len .ImportIdAttributes}} {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: {{.ImportIdFormat}}. Got: %q", req.ID),
+		)
+		return
+	}
+	{{- range $i, $attribute := .ImportIdAttributes }}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("{{$attribute}}"), idParts[{{$i}}])...)
+	{{- end}}
+	{{- else if .ImportIdentifiers }}
+	// Besides its ID, the object can be imported by {{range $i, $identifier := .ImportIdentifiers}}{{if $i}}, or {{end}}'{{.Prefix}}:<{{.Attribute}}>'{{end}}, which is looked up to find its ID
+	var filter string
+	prefix, value, _ := strings.Cut(req.ID, ":")
+	switch prefix {
+	{{- range .ImportIdentifiers}}
+	case "{{.Prefix}}":
+		filter = fmt.Sprintf("{{.PropertyName}} eq '%s'", strings.ReplaceAll(value, "'", "''"))
+	{{- end}}
+	default:
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	qparams := {{.RootCollectionConfiguration}}RequestBuilderGetRequestConfiguration{
+		QueryParameters: &{{.RootCollectionConfiguration}}RequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Select: []string{"id"},
+		},
+	}
+	result, err := r.client.{{.RootCollectionMethod}}().Get(ctx, &qparams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing {{.BlockName.Snake}}",
+			err.Error(),
+		)
+		return
+	}
+	if len(result.GetValue()) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier %q to match a single {{.BlockName.Snake}}. Matched %d", req.ID, len(result.GetValue())),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *result.GetValue()[0].GetId())...)
+	{{- else }}
     resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	{{- end}}
+}
+{{- if .StateMovers}}
+
+// MoveState moves the state of resources of other providers to the resource, with a 'moved' block.
+// Only some of their attributes are moved. The others are set when the resource is next read,
+// as are empty strings and lists, which other providers store for optional attributes that aren't set
+func (r *{{.BlockName.LowerCamel}}Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{{- range .StateMovers}}
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "{{.Type}}" || !strings.HasSuffix(req.SourceProviderAddress, "/{{.Provider}}") {
+					return
+				}
+
+				var source map[string]any
+				if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving state of {{.Type}}",
+						err.Error(),
+					)
+					return
+				}
+				{{- range .Attributes}}
+				{{- if eq .ValueKind "String"}}
+				if v, ok := source["{{.Source}}"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("{{.Attribute}}"), v)...)
+				}
+				{{- else if eq .ValueKind "Bool"}}
+				if v, ok := source["{{.Source}}"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("{{.Attribute}}"), v)...)
+				}
+				{{- else if eq .ValueKind "Int64"}}
+				if v, ok := source["{{.Source}}"].(float64); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("{{.Attribute}}"), int64(v))...)
+				}
+				{{- else if eq .ValueKind "Float64"}}
+				if v, ok := source["{{.Source}}"].(float64); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("{{.Attribute}}"), v)...)
+				}
+				{{- else if eq .ValueKind "StringList"}}
+				if v, ok := source["{{.Source}}"].([]any); ok && len(v) > 0 {
+					var values []string
+					for _, i := range v {
+						if s, ok := i.(string); ok {
+							values = append(values, s)
+						}
+					}
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("{{.Attribute}}"), values)...)
+				}
+				{{- end}}
+				{{- end}}
+			},
+		},
+		{{- end}}
+	}
+}
+{{- end}}
+{{- if .StateUpgraders}}
+
+// UpgradeState upgrades state saved with earlier versions of the schema, which is versioned by the generator.
+// Attributes that have changed since are dropped, and set again when the resource is next read
+func (r *{{.BlockName.LowerCamel}}Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		{{- range .StateUpgraders}}
+		{{.PriorVersion}}: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				r.upgradeState(ctx, req, resp{{range .Dropped}}, "{{.}}"{{end}})
+			},
+		},
+		{{- end}}
+	}
+}
+
+// upgradeState carries over the attributes of state saved with an earlier version of the schema, except those dropped
+func (r *{{.BlockName.LowerCamel}}Resource) upgradeState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse, dropped ...string) {
+
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Error upgrading state of {{.BlockName.Snake}}",
+			err.Error(),
+		)
+		return
+	}
+	for _, name := range dropped {
+		delete(state, name)
+	}
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error upgrading state of {{.BlockName.Snake}}",
+			err.Error(),
+		)
+		return
+	}
+
+	// Attributes removed from the schema are ignored, and those added to it are null
+	opts := tfprotov6.UnmarshalOpts{ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}}
+	resp.State.Raw, err = (&tfprotov6.RawState{JSON: upgraded}).UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error upgrading state of {{.BlockName.Snake}}",
+			err.Error(),
+		)
+	}
+
+}
+{{- end}}
+{{- if .IfExportable}}
+
+// ListIds returns the IDs of the objects matching filter, an OData $filter expression, or of every object when it's empty.
+// It's used to export the existing objects of a tenant
+func (r *{{.BlockName.LowerCamel}}Resource) ListIds(ctx context.Context, filter string) ([]string, error) {
+
+	qparams := {{.RootCollectionConfiguration}}RequestBuilderGetRequestConfiguration{
+		QueryParameters: &{{.RootCollectionConfiguration}}RequestBuilderGetQueryParameters{
+			Select: []string{"id"},
+		},
+	}
+	if filter != "" {
+		qparams.QueryParameters.Filter = &filter
+	}
+
+	result, err := r.client.{{.RootCollectionMethod}}().Get(ctx, &qparams)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for {
+		for _, object := range result.GetValue() {
+			ids = append(ids, *object.GetId())
+		}
+		nextLink := result.GetOdataNextLink()
+		if nextLink == nil {
+			return ids, nil
+		}
+		result, err = r.client.{{.RootCollectionMethod}}().WithUrl(*nextLink).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+}
+
+// ReadOnlyAttributes returns the paths of the attributes the API sets, which exports leave out of the configuration they write
+func (r *{{.BlockName.LowerCamel}}Resource) ReadOnlyAttributes() []string {
+	return []string{
+		{{- range .ReadOnlyAttributes}}
+		"{{.}}",
+		{{- end}}
+	}
 }
+{{- end}}
 
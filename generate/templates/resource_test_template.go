@@ -0,0 +1,94 @@
+package {{.PackageName}}_test
+
+import (
+	{{- if .ParentParameters }}
+	"fmt"
+	{{- end}}
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	{{- if or .ParentParameters .ImportIdentifiers }}
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	{{- end}}
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+{{- with .AcceptanceTest}}
+
+const {{$.BlockName.LowerCamel}}ResourceConfig = `
+{{- with .Dependencies}}
+{{.}}
+{{end}}
+{{.ResourceConfig}}
+`
+{{- if .UpdateConfig}}
+
+const {{$.BlockName.LowerCamel}}ResourceUpdateConfig = `
+{{- with .Dependencies}}
+{{.}}
+{{end}}
+{{.UpdateConfig}}
+`
+{{- end}}
+
+func TestAcc{{$.BlockName.UpperFirst}}Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: {{$.BlockName.LowerCamel}}ResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("{{.ResourceAddress}}", "id"),
+					{{- range .ResourceChecks}}
+					resource.TestCheckResourceAttr("{{$.AcceptanceTest.ResourceAddress}}", "{{.Attribute}}", {{.Value}}),
+					{{- end}}
+				),
+			},
+			// Import
+			{
+				ResourceName:      "{{.ResourceAddress}}",
+				ImportState:       true,
+				ImportStateVerify: true,
+				{{- if $.ParentParameters }}
+				// The IDs of the parents are needed to find the object, so they're imported along with its own ID
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["{{.ResourceAddress}}"].Primary.Attributes
+					return fmt.Sprintf("{{range $i, $attribute := $.ImportIdAttributes}}{{if $i}}/{{end}}%s{{end}}"{{range $.ImportIdAttributes}}, attributes["{{.}}"]{{end}}), nil
+				},
+				{{- end}}
+				{{- with .ImportIgnore}}
+				ImportStateVerifyIgnore: []string{ {{- range $i, $attribute := .}}{{if $i}}, {{end}}"{{$attribute}}"{{end -}} },
+				{{- end}}
+			},
+			{{- range $.ImportIdentifiers}}
+			// Import by {{.Attribute}}
+			{
+				ResourceName:      "{{$.AcceptanceTest.ResourceAddress}}",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "{{.Prefix}}:" + s.RootModule().Resources["{{$.AcceptanceTest.ResourceAddress}}"].Primary.Attributes["{{.Attribute}}"], nil
+				},
+				{{- with $.AcceptanceTest.ImportIgnore}}
+				ImportStateVerifyIgnore: []string{ {{- range $i, $attribute := .}}{{if $i}}, {{end}}"{{$attribute}}"{{end -}} },
+				{{- end}}
+			},
+			{{- end}}
+			{{- if .UpdateConfig}}
+			// Update and Read
+			{
+				Config: {{$.BlockName.LowerCamel}}ResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					{{- range .UpdateChecks}}
+					resource.TestCheckResourceAttr("{{$.AcceptanceTest.ResourceAddress}}", "{{.Attribute}}", {{.Value}}),
+					{{- end}}
+				),
+			},
+			{{- end}}
+			// Delete is tested once the last step is done
+		},
+	})
+}
+{{- end}}
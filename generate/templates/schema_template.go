@@ -1,3 +1,20 @@
+{{- /* Settings from the 'properties' of the augment file, shared by all Attribute types */}}
+{{- define "attribute_overrides" }}
+	{{- if .Sensitive}}
+	Sensitive: true,
+	{{- end}}
+	{{- if .Validators}}
+	Validators: []validator.{{.ValueKind}}{
+		{{- range .Validators}}
+		{{.}},
+		{{- end}}
+	},
+	{{- end}}
+	{{- if .Default}}
+	Default: {{.Default}},
+	{{- end}}
+{{- end }}
+
 {{- /* Define templates for different Attribute types */}}
 {{- define "StringAttribute" }}
 "{{.Name}}": schema.StringAttribute{
@@ -11,9 +28,24 @@
 	{{- if .Computed}}
 	Computed: true,
 	{{- end}}
-	{{- if .PlanModifiers}}
+	{{- template "attribute_overrides" .}}
+	{{- if .CustomPlanModifiers}}
+	PlanModifiers: []planmodifier.String{
+		{{- range .CustomPlanModifiers}}
+		{{.}},
+		{{- end}}
+	},
+	{{- else if .PlanModifiers}}
 	PlanModifiers: []planmodifier.String{
+		{{- if .ClearUnconfigured}}
+		stringplanmodifiers.NullIfUnconfigured(),
+		{{- else}}
 		stringplanmodifiers.UseStateForUnconfigured(),
+		{{- end}}
+	},
+	{{- else if .RequiresReplace}}
+	PlanModifiers: []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
 	},
 	{{- end}}
 },
@@ -31,6 +63,53 @@
 	{{- if .Computed}}
 	Computed: true,
 	{{- end}}
+	{{- template "attribute_overrides" .}}
+	{{- if .CustomPlanModifiers}}
+	PlanModifiers: []planmodifier.Int64{
+		{{- range .CustomPlanModifiers}}
+		{{.}},
+		{{- end}}
+	},
+	{{- else if .PlanModifiers}}
+	PlanModifiers: []planmodifier.Int64{
+		{{- if .ClearUnconfigured}}
+		int64planmodifiers.NullIfUnconfigured(),
+		{{- else}}
+		int64planmodifiers.UseStateForUnconfigured(),
+		{{- end}}
+	},
+	{{- end}}
+},
+{{- end }}
+
+{{- define "Float64Attribute" }}
+"{{.Name}}": schema.Float64Attribute{
+	Description: "{{.Description}}",
+	{{- if .Required}}
+	Required: true,
+	{{- end}}
+	{{- if .Optional}}
+	Optional: true,
+	{{- end}}
+	{{- if .Computed}}
+	Computed: true,
+	{{- end}}
+	{{- template "attribute_overrides" .}}
+	{{- if .CustomPlanModifiers}}
+	PlanModifiers: []planmodifier.Float64{
+		{{- range .CustomPlanModifiers}}
+		{{.}},
+		{{- end}}
+	},
+	{{- else if .PlanModifiers}}
+	PlanModifiers: []planmodifier.Float64{
+		{{- if .ClearUnconfigured}}
+		float64planmodifiers.NullIfUnconfigured(),
+		{{- else}}
+		float64planmodifiers.UseStateForUnconfigured(),
+		{{- end}}
+	},
+	{{- end}}
 },
 {{- end }}
 
@@ -46,9 +125,20 @@
 	{{- if .Computed}}
 	Computed: true,
 	{{- end}}
-	{{- if .PlanModifiers}}
+	{{- template "attribute_overrides" .}}
+	{{- if .CustomPlanModifiers}}
+	PlanModifiers: []planmodifier.Bool{
+		{{- range .CustomPlanModifiers}}
+		{{.}},
+		{{- end}}
+	},
+	{{- else if .PlanModifiers}}
 	PlanModifiers: []planmodifier.Bool{
+		{{- if .ClearUnconfigured}}
+		boolplanmodifiers.NullIfUnconfigured(),
+		{{- else}}
 		boolplanmodifiers.UseStateForUnconfigured(),
+		{{- end}}
 	},
 	{{- end}}
 },
@@ -66,9 +156,20 @@
 	{{- if .Computed}}
 	Computed: true,
 	{{- end}}
-	{{- if .PlanModifiers}}
+	{{- template "attribute_overrides" .}}
+	{{- if .CustomPlanModifiers}}
+	PlanModifiers: []planmodifier.List{
+		{{- range .CustomPlanModifiers}}
+		{{.}},
+		{{- end}}
+	},
+	{{- else if .PlanModifiers}}
 	PlanModifiers: []planmodifier.List{
+		{{- if .ClearUnconfigured}}
+		listplanmodifiers.NullIfUnconfigured(),
+		{{- else}}
 		listplanmodifiers.UseStateForUnconfigured(),
+		{{- end}}
 	},
 	{{- end}}
 	ElementType: types.StringType,
@@ -87,9 +188,20 @@
 	{{- if .Computed}}
 	Computed: true,
 	{{- end}}
-	{{- if .PlanModifiers}}
+	{{- template "attribute_overrides" .}}
+	{{- if .CustomPlanModifiers}}
 	PlanModifiers: []planmodifier.Object{
+		{{- range .CustomPlanModifiers}}
+		{{.}},
+		{{- end}}
+	},
+	{{- else if .PlanModifiers}}
+	PlanModifiers: []planmodifier.Object{
+		{{- if .ClearUnconfigured}}
+		objectplanmodifiers.NullIfUnconfigured(),
+		{{- else}}
 		objectplanmodifiers.UseStateForUnconfigured(),
+		{{- end}}
 	},
 	{{- end}}
 	Attributes: map[string]schema.Attribute{
@@ -110,9 +222,20 @@
 	{{- if .Computed}}
 	Computed: true,
 	{{- end}}
-	{{- if .PlanModifiers}}
+	{{- template "attribute_overrides" .}}
+	{{- if .CustomPlanModifiers}}
+	PlanModifiers: []planmodifier.List{
+		{{- range .CustomPlanModifiers}}
+		{{.}},
+		{{- end}}
+	},
+	{{- else if .PlanModifiers}}
 	PlanModifiers: []planmodifier.List{
+		{{- if .ClearUnconfigured}}
+		listplanmodifiers.NullIfUnconfigured(),
+		{{- else}}
 		listplanmodifiers.UseStateForUnconfigured(),
+		{{- end}}
 	},
 	{{- end}}
 	NestedObject: schema.NestedAttributeObject{
@@ -130,6 +253,8 @@
 {{- template "StringAttribute" .}}
 {{- else if eq .Type "Int64Attribute" }}
 {{- template "Int64Attribute" .}}
+{{- else if eq .Type "Float64Attribute" }}
+{{- template "Float64Attribute" .}}
 {{- else if eq .Type "BoolAttribute" }}
 {{- template "BoolAttribute" .}}
 {{- else if eq .Type "ListAttribute" }}
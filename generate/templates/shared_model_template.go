@@ -0,0 +1,15 @@
+// Models of nested objects that are used identically by more than one resource or data source.
+package sharedmodels
+{{- if .Definitions}}
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	{{- if .IfTypesImportNeeded}}
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	{{- end}}
+)
+{{- end}}
+
+{{- range .Definitions}}
+{{template "model_definition" .}}
+{{end}}
@@ -1,7 +1,7 @@
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *{{.Template.BlockName.LowerCamel}}Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from Terraform plan
-	var tfPlan{{.Template.BlockName.UpperCamel}} {{.Template.BlockName.LowerCamel}}Model
+	var tfPlan{{.Template.BlockName.UpperCamel}} {{.Template.ResourceModelName}}
 	diags := req.Plan.Get(ctx, &tfPlan{{.Template.BlockName.UpperCamel}})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -9,7 +9,7 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Update(ctx context.Context,
 	}
 
 	// Get current Terraform state
-	var tfState{{.Template.BlockName.UpperCamel}} {{.Template.BlockName.LowerCamel}}Model
+	var tfState{{.Template.BlockName.UpperCamel}} {{.Template.ResourceModelName}}
 	diags = req.State.Get(ctx, &tfState{{.Template.BlockName.UpperCamel}})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -17,114 +17,199 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Update(ctx context.Context,
 	}
 
 	// Generate API request body from plan
-	requestBody{{.Template.BlockName.UpperCamel}} := models.New{{.Template.BlockName.UpperCamel}}()
+	{{- if .HasDerivedTypes}}
+	var requestBody{{.Template.BlockName.UpperCamel}} models.{{.Template.SdkModelName}}able = models.New{{.Template.SdkModelName}}()
+	{{- else}}
+	requestBody{{.Template.BlockName.UpperCamel}} := models.New{{.Template.SdkModelName}}()
+	{{- end}}
+
+	{{- /* Top-level properties are only sent when they change. The API replaces nested objects whole, so their properties, and the elements of collections, are all sent, from the plan, or from the state when they're unknown */}}
+	{{- define "update_begin" }}
+	{{- if .Whole}}
+	if tfPlan{{.ParentName}}.{{.Name}}.IsUnknown() {
+		tfPlan{{.ParentName}}.{{.Name}} = tfState{{.ParentName}}.{{.Name}}
+	}
+	if !tfPlan{{.ParentName}}.{{.Name}}.IsNull() {
+	{{- else}}
+	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}) {
+	{{- end}}
+	{{- template "update_clear_begin" .}}
+	{{- end}}
+
+	{{- /* Attributes removed from the configuration of a resource with 'clearUnconfigured' are planned to be null, and cleared by sending null */}}
+	{{- define "update_clear_begin" }}
+	{{- if .SendsNull}}
+	if tfPlan{{.ParentName}}.{{.Name}}.IsNull() {
+		requestBody{{.ParentName}}.GetAdditionalData()["{{.PropertyName}}"] = absser.NewUntypedNull()
+	} else {
+	{{- end}}
+	{{- end}}
+
+	{{- define "update_clear_end" }}
+	{{- if .SendsNull}}
+	}
+	{{- end}}
+	{{- end}}
 
 	{{- define "UpdateStringAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}){
+	{{- template "update_begin" .}}
 	tfPlan{{.Name}} := tfPlan{{.ParentName}}.{{.Name}}.ValueString()
 	requestBody{{.ParentName}}.Set{{.SetModelMethod}}(&tfPlan{{.Name}})
+	{{- template "update_clear_end" .}}
 	}
 	{{- end}}
 
 	{{- define "UpdateStringEnumAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}){
+	{{- template "update_begin" .}}
 	tfPlan{{.Name}} := tfPlan{{.ParentName}}.{{.Name}}.ValueString()
 	parsed{{.Name}}, _ := models.Parse{{.ObjectOf}}(tfPlan{{.Name}})
 	asserted{{.Name}} := parsed{{.Name}}.(models.{{.ObjectOf}})
 	requestBody{{.ParentName}}.Set{{.Name}}(&asserted{{.Name}})
+	{{- template "update_clear_end" .}}
 	}
 	{{- end}}
 
 	{{- define "UpdateStringTimeAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}){
+	{{- template "update_begin" .}}
 	tfPlan{{.Name}} := tfPlan{{.ParentName}}.{{.Name}}.ValueString()
 	t, _ := time.Parse(time.RFC3339, tfPlan{{.Name}})
 	requestBody{{.ParentName}}.Set{{.Name}}(&t)
+	{{- template "update_clear_end" .}}
 	}
 	{{- end}}
 
 	{{- define "UpdateStringUuidAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}){
+	{{- template "update_begin" .}}
 	tfPlan{{.Name}} := tfPlan{{.ParentName}}.{{.Name}}.ValueString()
 	u, _ := uuid.Parse(tfPlan{{.Name}})
 	requestBody{{.ParentName}}.Set{{.Name}}(&u)
+	{{- template "update_clear_end" .}}
 	}
 	{{- end}}
 
 	{{- define "UpdateStringBase64UrlAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}){
+	{{- template "update_begin" .}}
 	tfPlan{{.Name}} := tfPlan{{.ParentName}}.{{.Name}}.ValueString()
 	requestBody{{.ParentName}}.Set{{.SetModelMethod}}([]byte(tfPlan{{.Name}}))
+	{{- template "update_clear_end" .}}
 	}
 	{{- end}}
 
 	{{- define "UpdateInt64Attribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}){
-	tfPlan{{.Name}} := tfPlan{{.ParentName}}.{{.Name}}.ValueInt64()
-	requestBody{{.ParentName}}.Set{{.Name}}(&tfPlan{{.Name}})
+	{{- template "update_begin" .}}
+	tfPlan{{.Name}} := {{.NumberType}}(tfPlan{{.ParentName}}.{{.Name}}.ValueInt64())
+	requestBody{{.ParentName}}.Set{{.SetModelMethod}}(&tfPlan{{.Name}})
+	{{- template "update_clear_end" .}}
 	}
 	{{- end}}
 
-	{{- define "UpdateInt32Attribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}){
-	tfPlan{{.Name}} := int32(tfPlan{{.ParentName}}.{{.Name}}.ValueInt64())
-	requestBody{{.ParentName}}.Set{{.Name}}(&tfPlan{{.Name}})
+	{{- define "UpdateFloat64Attribute" }}
+	{{- template "update_begin" .}}
+	tfPlan{{.Name}} := {{.NumberType}}(tfPlan{{.ParentName}}.{{.Name}}.ValueFloat64())
+	requestBody{{.ParentName}}.Set{{.SetModelMethod}}(&tfPlan{{.Name}})
+	{{- template "update_clear_end" .}}
 	}
 	{{- end}}
 
 	{{- define "UpdateBoolAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}){
+	{{- template "update_begin" .}}
 	tfPlan{{.Name}} := tfPlan{{.ParentName}}.{{.Name}}.ValueBool()
 	requestBody{{.ParentName}}.Set{{.Name}}(&tfPlan{{.Name}})
+	{{- template "update_clear_end" .}}
 	}
 	{{- end}}
 
 	{{- define "UpdateArrayStringAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}) {
+	{{- template "update_begin" .}}
+		{{- if .ClearUnconfigured}}
+		stringArray{{.Name}} := []string{}
+		{{- else}}
 		var stringArray{{.Name}} []string
+		{{- end}}
 		for _, i := range tfPlan{{.ParentName}}.{{.Name}}.Elements() {
-			stringArray{{.Name}} = append(stringArray{{.Name}}, i.String())
+			stringArray{{.Name}} = append(stringArray{{.Name}}, i.(types.String).ValueString())
 		}
 		requestBody{{.ParentName}}.Set{{.Name}}(stringArray{{.Name}})
 	}
 	{{- end}}
 
 	{{- define "UpdateArrayUuidAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}) {
-		var {{.Name}} []uuid.UUID
+	{{- template "update_begin" .}}
+		{{- if .ClearUnconfigured}}
+		uuidArray{{.Name}} := []uuid.UUID{}
+		{{- else}}
+		var uuidArray{{.Name}} []uuid.UUID
+		{{- end}}
 		for _, i := range tfPlan{{.ParentName}}.{{.Name}}.Elements() {
-			u, _ := uuid.Parse(i.String())
-			{{.Name}} = append({{.Name}}, u)
+			u, _ := uuid.Parse(i.(types.String).ValueString())
+			uuidArray{{.Name}} = append(uuidArray{{.Name}}, u)
 		}
-		requestBody{{.ParentName}}.Set{{.Name}}({{.Name}})
+		requestBody{{.ParentName}}.Set{{.Name}}(uuidArray{{.Name}})
 	}
 	{{- end}}
 
+	{{- /* Every element is sent from the plan. Its properties the plan doesn't know yet are taken from the element at the same position in state, and the element is written back to the plan */}}
 	{{- define "UpdateArrayObjectAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}) {
-		var tfPlan{{.Name}} []models.{{.ObjectOf}}able
-		for k, i := range tfPlan{{.ParentName}}.{{.Name}}.Elements() {
+	{{- template "update_begin" .}}
+		{{- if .ClearUnconfigured}}
+		objectArray{{.Name}} := []models.{{.ObjectOf}}able{}
+		{{- else}}
+		var objectArray{{.Name}} []models.{{.ObjectOf}}able
+		{{- end}}
+		objectValues{{.Name}} := []basetypes.ObjectValue{}
+		tfStateElements{{.Name}} := tfState{{.ParentName}}.{{.Name}}.Elements()
+		for n, i := range tfPlan{{.ParentName}}.{{.Name}}.Elements() {
+			{{- if .HasDerivedTypes}}
+			var requestBody{{.ObjectOf}} models.{{.ObjectOf}}able = models.New{{.ObjectOf}}()
+			{{- else}}
 			requestBody{{.ObjectOf}} := models.New{{.ObjectOf}}()
-			tfPlan{{.ObjectOf}} := {{.TfModelName}}Model{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlan{{.ObjectOf}})
-			tfState{{.ObjectOf}} := {{.TfModelName}}Model{}
-			types.ListValueFrom(ctx, tfState{{.ParentName}}.{{.Name}}.Elements()[k].Type(ctx), &tfPlan{{.ObjectOf}})
+			{{- end}}
+			tfPlan{{.ObjectOf}} := {{.TfModelName}}{}
+			i.(types.Object).As(ctx, &tfPlan{{.ObjectOf}}, basetypes.ObjectAsOptions{})
+			tfState{{.ObjectOf}} := {{.TfModelName}}{}
+			if n < len(tfStateElements{{.Name}}) {
+				tfStateElements{{.Name}}[n].(types.Object).As(ctx, &tfState{{.ObjectOf}}, basetypes.ObjectAsOptions{})
+			}
 			{{template "generate_update" .NestedUpdate}}
+			objectArray{{.Name}} = append(objectArray{{.Name}}, requestBody{{.ObjectOf}})
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlan{{.ObjectOf}}.AttributeTypes(), tfPlan{{.ObjectOf}})
+			objectValues{{.Name}} = append(objectValues{{.Name}}, objectValue)
 		}
-		requestBody{{.ParentName}}.Set{{.Name}}(tfPlan{{.Name}})
+		requestBody{{.ParentName}}.Set{{.Name}}(objectArray{{.Name}})
+		tfPlan{{.ParentName}}.{{.Name}}, _ = types.ListValueFrom(ctx, tfPlan{{.ParentName}}.{{.Name}}.ElementType(ctx), objectValues{{.Name}})
 	}
 	{{- end}}
 
 	{{- define "UpdateObjectAttribute" }}
-	if !tfPlan{{.ParentName}}.{{.Name}}.Equal(tfState{{.ParentName}}.{{.Name}}){
+	{{- template "update_begin" .}}
+		{{- if .HasDerivedTypes}}
+		var requestBody{{.ObjectOf}} models.{{.ObjectOf}}able = models.New{{.ObjectOf}}()
+		{{- else}}
 		requestBody{{.ObjectOf}} := models.New{{.ObjectOf}}()
-		tfPlan{{.ObjectOf}} := {{.TfModelName}}Model{}
+		{{- end}}
+		tfPlan{{.ObjectOf}} := {{.TfModelName}}{}
 		tfPlan{{.ParentName}}.{{.Name}}.As(ctx, &tfPlan{{.ObjectOf}}, basetypes.ObjectAsOptions{})
-		tfState{{.ObjectOf}} := {{.TfModelName}}Model{}
+		tfState{{.ObjectOf}} := {{.TfModelName}}{}
 		tfState{{.ParentName}}.{{.Name}}.As(ctx, &tfState{{.ObjectOf}}, basetypes.ObjectAsOptions{})
 		{{template "generate_update" .NestedUpdate}}
 		requestBody{{.ParentName}}.Set{{.Name}}(requestBody{{.ObjectOf}})
 		tfPlan{{.ParentName}}.{{.Name}}, _ = types.ObjectValueFrom(ctx, tfPlan{{.ObjectOf}}.AttributeTypes(), tfPlan{{.ObjectOf}})
+	{{- template "update_clear_end" .}}
+	}
+	{{- end}}
+
+	{{- define "UpdateDerivedTypeAttribute" }}
+	if !tfPlan{{.ParentName}}.{{.Name}}.IsNull() && !tfPlan{{.ParentName}}.{{.Name}}.IsUnknown() {
+		requestBody{{.ObjectOf}} := models.New{{.ObjectOf}}()
+		tfPlan{{.ObjectOf}} := {{.TfModelName}}{}
+		tfPlan{{.ParentName}}.{{.Name}}.As(ctx, &tfPlan{{.ObjectOf}}, basetypes.ObjectAsOptions{})
+		tfState{{.ObjectOf}} := {{.TfModelName}}{}
+		tfState{{.ParentName}}.{{.Name}}.As(ctx, &tfState{{.ObjectOf}}, basetypes.ObjectAsOptions{})
+		{{template "generate_update" .NestedUpdate}}
+		odataType{{.ObjectOf}} := "{{.DiscriminatorValue}}"
+		requestBody{{.ObjectOf}}.SetOdataType(&odataType{{.ObjectOf}})
+		requestBody{{.ParentName}} = requestBody{{.ObjectOf}}
+		tfPlan{{.ParentName}}.{{.Name}}, _ = types.ObjectValueFrom(ctx, tfPlan{{.ObjectOf}}.AttributeTypes(), tfPlan{{.ObjectOf}})
 	}
 	{{- end}}
 
@@ -142,6 +227,8 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Update(ctx context.Context,
 	{{ template "UpdateStringBase64UrlAttribute" .}}
 	{{- else if eq .Type "UpdateInt64Attribute"}}
 	{{ template "UpdateInt64Attribute" .}}
+	{{- else if eq .Type "UpdateFloat64Attribute"}}
+	{{ template "UpdateFloat64Attribute" .}}
 	{{- else if eq .Type "UpdateInt32Attribute"}}
 	{{ template "UpdateInt32Attribute" .}}
 	{{- else if eq .Type "UpdateBoolAttribute"}}
@@ -154,6 +241,8 @@ func (r *{{.Template.BlockName.LowerCamel}}Resource) Update(ctx context.Context,
 	{{ template "UpdateArrayObjectAttribute" . }}
 	{{- else if eq .Type "UpdateObjectAttribute"}}
 	{{ template "UpdateObjectAttribute" .}}
+	{{- else if eq .Type "UpdateDerivedTypeAttribute"}}
+	{{ template "UpdateDerivedTypeAttribute" .}}
 	{{- end}}
 	{{- end}}
 	{{- end}}
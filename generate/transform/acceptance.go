@@ -0,0 +1,151 @@
+package transform
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// Used by the acceptance test templates to generate tests for every resource and data source.
+// Tests are configured from the examples of the augment file, so they only create what the examples describe
+type acceptanceTest struct {
+	Template *TemplateInput
+}
+
+func (ti TemplateInput) AcceptanceTest() acceptanceTest {
+	return acceptanceTest{Template: &ti}
+}
+
+// An attribute the test checks the value of, once the resource is created or updated
+type acceptanceTestCheck struct {
+	Attribute string
+	Value     string
+}
+
+func (at acceptanceTest) ResourceAddress() string {
+	return at.Template.TerraformTypeName() + ".test"
+}
+
+func (at acceptanceTest) DataSourceAddress() string {
+	return "data." + at.Template.TerraformTypeName() + ".test"
+}
+
+// Configuration that needs to exist before the resource can be created, e.g. its parent
+func (at acceptanceTest) Dependencies() string {
+	return strings.TrimSpace(at.Template.Augment().Example.TestDependencies)
+}
+
+// The arguments of the resource, from its example, with the values from 'test' in the augment file replacing those that can't be used in tests
+func (at acceptanceTest) resourceValues() map[string]any {
+	values := at.Template.ResourceExample().values()
+	maps.Copy(values, at.Template.Augment().Example.Test)
+	return values
+}
+
+// The arguments of the resource after the update step. Nothing is updated when the example has nothing to change
+func (at acceptanceTest) updateValues() map[string]any {
+
+	values := at.resourceValues()
+
+	update := at.Template.Augment().Example.Update
+	if update == nil {
+		if _, ok := values["display_name"]; !ok {
+			return nil
+		}
+		update = map[string]any{"display_name": "Example updated"}
+	}
+	maps.Copy(values, update)
+
+	return values
+
+}
+
+func (at acceptanceTest) ResourceConfig() string {
+	return at.resourceConfig(at.resourceValues())
+}
+
+func (at acceptanceTest) UpdateConfig() string {
+	if values := at.updateValues(); values != nil {
+		return at.resourceConfig(values)
+	}
+	return ""
+}
+
+func (at acceptanceTest) resourceConfig(values map[string]any) string {
+	return hclBlock(`resource "`+at.Template.TerraformTypeName()+`" "test"`, values)
+}
+
+func (at acceptanceTest) ResourceChecks() []acceptanceTestCheck {
+	return acceptanceTestChecks(at.resourceValues())
+}
+
+func (at acceptanceTest) UpdateChecks() []acceptanceTestCheck {
+	return acceptanceTestChecks(at.updateValues())
+}
+
+// Attributes left out when comparing an imported resource to the one that was created, as the API doesn't return them
+func (at acceptanceTest) ImportIgnore() []string {
+	return at.Template.Augment().Example.ImportIgnore
+}
+
+// DataSourceConfig looks up the resource created by the test, when there is one, by its id.
+// Otherwise, the data source is configured from its example
+func (at acceptanceTest) DataSourceConfig(withResource bool) string {
+
+	values := at.Template.DataSourceExample().values()
+	if withResource {
+		values = map[string]any{"id": "${" + at.ResourceAddress() + ".id}"}
+	} else if _, ok := values["id"]; ok {
+		values["id"] = "%s"
+	}
+
+	return hclBlock(`data "`+at.Template.TerraformTypeName()+`" "test"`, values)
+
+}
+
+// Data sources looking up a single object need an existing one when there's no resource to create it, given by this environment variable, e.g. MSGRAPH_TEST_SITE_ID
+func (at acceptanceTest) IdEnvironmentVariable() string {
+	if _, ok := at.Template.DataSourceExample().values()["id"]; !ok {
+		return ""
+	}
+	return "MSGRAPH_TEST_" + strcase.ToScreamingSnake(strings.TrimPrefix(at.Template.TerraformTypeName(), "msgraph_")) + "_ID"
+}
+
+// The attribute the test checks is set after looking up a data source configured from its example. Collections are checked for their list of objects
+func (at acceptanceTest) LookupCheckAttribute() string {
+	if slices.ContainsFunc(at.Template.SchemaDataSource().Attributes(), func(tsa terraformSchemaAttribute) bool { return tsa.Name() == "value" }) {
+		return "value.#"
+	}
+	return "id"
+}
+
+// acceptanceTestChecks returns checks of the arguments with single values, other than expressions, as their values in state are easy to predict
+func acceptanceTestChecks(values map[string]any) []acceptanceTestCheck {
+
+	var checks []acceptanceTestCheck
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		switch v := values[name].(type) {
+		case string:
+			if !strings.HasPrefix(v, "${") {
+				checks = append(checks, acceptanceTestCheck{Attribute: name, Value: strconv.Quote(v)})
+			}
+		case bool, int:
+			checks = append(checks, acceptanceTestCheck{Attribute: name, Value: strconv.Quote(hclValue(v))})
+		}
+	}
+
+	return checks
+
+}
+
+func hclBlock(header string, values map[string]any) string {
+	arguments := hclArguments(values)
+	if len(arguments) == 0 {
+		return header + " {}"
+	}
+	return header + " {\n" + strings.Join(indent(arguments), "\n") + "\n}"
+}
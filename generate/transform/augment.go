@@ -0,0 +1,506 @@
+package transform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/iancoleman/strcase"
+	"gopkg.in/yaml.v3"
+
+	"terraform-provider-msgraph/generate/extract"
+)
+
+// Represents an 'augment' YAML file, used to describe manual changes from the MS Graph OpenAPI spec.
+// Properties are referred to by their path from the root of the response, e.g. 'summary' or 'settings.summary'
+type templateAugment struct {
+	ExcludedProperties       []string                    `yaml:"excludedProperties"`
+	AltReadMethods           []map[string]string         `yaml:"altReadMethods"`
+	DataSourceExtraOptionals []string                    `yaml:"dataSourceExtraOptionals"`
+	ResourceExtraComputed    []string                    `yaml:"resourceExtraComputed"`
+	MaxDepth                 int                         `yaml:"maxDepth"`
+	Properties               map[string]propertyOverride `yaml:"properties"`
+	Imports                  []string                    `yaml:"imports"`
+
+	// Default values of resource attributes, used when they're not configured. Strings, booleans, integers and numbers are supported
+	Defaults map[string]any `yaml:"defaults"`
+
+	// Terraform attribute names, used instead of the property names in snake case
+	Renames map[string]string `yaml:"renames"`
+
+	// Makes the resource clear attributes removed from its configuration when it's updated, instead of keeping their value in state.
+	// Unconfigured attributes are planned to be null, so those the API sets when they're not configured show a difference on every plan,
+	// unless they're given a default, or a 'planModifiers' override, e.g. stringplanmodifiers.UseStateForUnconfigured()
+	ClearUnconfigured bool `yaml:"clearUnconfigured"`
+
+	// Properties the resource can also be imported by, with '<prefix>:<value>' as the import ID, by prefix.
+	// The prefix is the name of the property, e.g. 'userPrincipalName: userPrincipalName'
+	ImportIdentifiers map[string]string `yaml:"importIdentifiers"`
+
+	// Adds 'prevent_deletion' to the resource, which makes deleting it fail while it's true
+	PreventDeletion bool `yaml:"preventDeletion"`
+
+	// The objects are kept in /directory/deletedItems when they're deleted, so they can be restored.
+	// Adds 'permanently_delete' to the resource, which purges them from it too
+	SoftDeleted bool `yaml:"softDeleted"`
+
+	// Resources of other providers whose state can be moved to the resource with a 'moved' block
+	MoveStateFrom []moveStateAugment `yaml:"moveStateFrom"`
+
+	Example exampleAugment `yaml:"example"`
+}
+
+// A resource of another provider, whose state is moved by mapping its attributes to properties. The other attributes are set by the next read
+type moveStateAugment struct {
+	Provider string `yaml:"provider"` // Namespace and type of the provider, e.g. 'hashicorp/azuread'. Its hostname is ignored
+	Type     string `yaml:"type"`     // Type of the resource, e.g. 'azuread_group'
+
+	// Properties set from the attributes of the resource, by attribute name, e.g. 'object_id: id'.
+	// Only top-level strings, booleans, integers, numbers and lists of strings are supported
+	Attributes map[string]string `yaml:"attributes"`
+}
+
+// Arguments added to the generated examples, by the Terraform attribute name. The acceptance tests are configured from the same examples
+type exampleAugment struct {
+	Resource   map[string]any `yaml:"resource"`
+	DataSource map[string]any `yaml:"dataSource"`
+
+	// Resource arguments replaced in acceptance tests, e.g. those set from variables in the example
+	Test map[string]any `yaml:"test"`
+
+	// Resource arguments changed by the update step of acceptance tests. display_name is changed when not given
+	Update map[string]any `yaml:"update"`
+
+	// Attributes the API doesn't return, which can't be compared after importing the resource in acceptance tests
+	ImportIgnore []string `yaml:"importIgnore"`
+
+	// Configuration the resource depends on in acceptance tests, in HCL
+	TestDependencies string `yaml:"testDependencies"`
+}
+
+// Names Terraform reserves for meta-arguments, which can't be used by attributes at the root of a resource
+var reservedAttributeNames = []string{"connection", "count", "depends_on", "for_each", "lifecycle", "provider", "provisioner"}
+
+var attributeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Overrides the generated schema attribute of a single property
+type propertyOverride struct {
+	Description string `yaml:"description"`
+	Sensitive   bool   `yaml:"sensitive"`
+
+	// Makes the attribute required in the resource. Data sources are unaffected
+	Required bool `yaml:"required"`
+
+	// Go expressions, e.g. 'stringvalidator.LengthAtMost(256)'. Packages other than those of terraform-plugin-framework and this provider need to be listed in 'imports'
+	Validators []string `yaml:"validators"`
+
+	// Go expressions replacing the plan modifiers of the attribute in the resource, e.g. 'stringplanmodifier.RequiresReplace()'
+	PlanModifiers []string `yaml:"planModifiers"`
+}
+
+// Packages that can be used by the validators and plan modifiers in augment files, without listing them in 'imports'
+var knownImports = []string{
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator",
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier",
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier",
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier",
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier",
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier",
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier",
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier",
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers",
+	"terraform-provider-msgraph/planmodifiers/float64planmodifiers",
+	"terraform-provider-msgraph/planmodifiers/int64planmodifiers",
+	"terraform-provider-msgraph/planmodifiers/listplanmodifiers",
+	"terraform-provider-msgraph/planmodifiers/mapplanmodifiers",
+	"terraform-provider-msgraph/planmodifiers/objectplanmodifiers",
+	"terraform-provider-msgraph/planmodifiers/setplanmodifiers",
+	"terraform-provider-msgraph/planmodifiers/stringplanmodifiers",
+}
+
+// clearsUnconfigured determines if the resource clears the attribute of a property when it's removed from the configuration.
+// Read-only properties can't be cleared, and path parameters are always configured
+func (ti TemplateInput) clearsUnconfigured(property extract.OpenAPISchemaProperty) bool {
+	return ti.Augment().ClearUnconfigured && !property.IsReadOnly() && !property.IsPathParameter()
+}
+
+// attributeName returns the name of the Terraform attribute of a property, which is the property name in snake case unless the augment file renames it
+func (ti TemplateInput) attributeName(property extract.OpenAPISchemaProperty) string {
+	if name, ok := ti.Augment().Renames[property.Path()]; ok {
+		return name
+	}
+	return strcase.ToSnake(property.Name)
+}
+
+// propertyOverride returns the overrides of a property from the augment file, if any
+func (ti TemplateInput) propertyOverride(property extract.OpenAPISchemaProperty) propertyOverride {
+	return ti.Augment().Properties[property.Path()]
+}
+
+// Directory augment files are read from, relative to the root of the repository
+var AugmentDirectory = "generate/augment/"
+
+// Augment files that have already been read, and the errors reading them, by their path
+var augments = map[string]templateAugment{}
+var augmentErrors = map[string]error{}
+
+// augmentPath returns the path of the augment file of the type, relative to AugmentDirectory
+func (ti TemplateInput) augmentPath() string {
+	if ti.TypeName != "" {
+		return ti.AugmentFile
+	}
+	return ti.PackageName() + "/" + ti.BlockName().LowerCamel() + ".yaml"
+}
+
+// Augment returns the augment file of the type. An augment file that can't be read is treated as empty, and reported by ValidateAugment()
+func (ti TemplateInput) Augment() templateAugment {
+
+	augmentPath := ti.augmentPath()
+	if augmentPath == "" {
+		return templateAugment{}
+	}
+
+	if cached, ok := augments[augmentPath]; ok {
+		return cached
+	}
+
+	augment, err := readAugment(augmentPath)
+	augments[augmentPath] = augment
+	augmentErrors[augmentPath] = err
+
+	return augment
+}
+
+func readAugment(augmentPath string) (templateAugment, error) {
+
+	var augment templateAugment
+
+	augmentFile, err := os.ReadFile(AugmentDirectory + augmentPath)
+	if err != nil {
+		return augment, err
+	}
+
+	// Reject unknown keys, so typos don't silently change what is generated
+	decoder := yaml.NewDecoder(bytes.NewReader(augmentFile))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&augment); err != nil && !errors.Is(err, io.EOF) {
+		return templateAugment{}, err
+	}
+
+	return augment, nil
+}
+
+// ValidateAugment checks the augment file of the type can be read, and that every property it refers to exists in the response of the path.
+// Entries that are valid, but have no effect, are returned as warnings
+func (ti TemplateInput) ValidateAugment() ([]string, error) {
+
+	augmentPath := ti.augmentPath()
+	if augmentPath == "" {
+		return nil, nil
+	}
+
+	augment := ti.Augment()
+	if err := augmentErrors[augmentPath]; err != nil {
+		// Augment files derived from the path are optional. Those given in the configuration are not
+		if ti.TypeName == "" && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("augment file %s: %w", augmentPath, err)
+	}
+
+	var errs []error
+	var warnings []string
+
+	properties := allPropertyNames(ti.OpenAPIPath.Get().Response())
+	paths := ti.allProperties()
+
+	for _, excluded := range augment.ExcludedProperties {
+		if _, ok := paths[excluded]; !ok {
+			errs = append(errs, fmt.Errorf("excludedProperties: '%s' is not a property of %s", excluded, ti.OpenAPIPath.Path))
+		}
+	}
+
+	for path, override := range augment.Properties {
+		if _, ok := paths[path]; !ok {
+			errs = append(errs, fmt.Errorf("properties: '%s' is not a property of %s", path, ti.OpenAPIPath.Path))
+		} else if augment.isExcluded(path) {
+			warnings = append(warnings, fmt.Sprintf("properties: '%s' is excluded", path))
+		} else if override.Required && ti.OpenAPIPath.Patch().Summary() == "" {
+			warnings = append(warnings, fmt.Sprintf("properties: '%s' is required, but there is no resource", path))
+		}
+	}
+
+	for path, value := range augment.Defaults {
+		property, ok := paths[path]
+		if !ok {
+			errs = append(errs, fmt.Errorf("defaults: '%s' is not a property of %s", path, ti.OpenAPIPath.Path))
+			continue
+		}
+
+		attribute := terraformSchemaAttribute{Schema: &schema{Template: &ti, BehaviourMode: "Resource"}, OpenAPISchemaProperty: property}
+		if _, err := defaultValue(attribute.ValueKind(), value); err != nil {
+			errs = append(errs, fmt.Errorf("defaults: '%s': %w", path, err))
+		} else if augment.Properties[path].Required {
+			errs = append(errs, fmt.Errorf("defaults: '%s' is required, so it can't have a default", path))
+		} else if augment.isExcluded(path) {
+			warnings = append(warnings, fmt.Sprintf("defaults: '%s' is excluded", path))
+		} else if ti.OpenAPIPath.Patch().Summary() == "" {
+			warnings = append(warnings, fmt.Sprintf("defaults: '%s' has a default, but there is no resource", path))
+		}
+	}
+
+	for _, optional := range augment.DataSourceExtraOptionals {
+		if !slices.ContainsFunc(properties, func(p string) bool { return strcase.ToSnake(p) == optional }) {
+			errs = append(errs, fmt.Errorf("dataSourceExtraOptionals: '%s' is not an attribute of %s", optional, ti.OpenAPIPath.Path))
+		} else if slices.ContainsFunc(augment.ExcludedProperties, func(p string) bool { return strcase.ToSnake(p) == optional }) {
+			warnings = append(warnings, fmt.Sprintf("dataSourceExtraOptionals: '%s' is also excluded", optional))
+		}
+	}
+
+	for _, altReadMethod := range augment.AltReadMethods {
+		if !slices.ContainsFunc(properties, func(p string) bool { return upperFirst(p) == altReadMethod["if"] }) {
+			errs = append(errs, fmt.Errorf("altReadMethods: '%s' is not a property of %s", altReadMethod["if"], ti.OpenAPIPath.Path))
+		}
+	}
+	if len(augment.AltReadMethods) > 0 {
+		warnings = append(warnings, "altReadMethods: not used by the generator yet")
+	}
+
+	for _, computed := range augment.ResourceExtraComputed {
+		if !slices.ContainsFunc(properties, func(p string) bool { return strcase.ToSnake(p) == computed }) {
+			errs = append(errs, fmt.Errorf("resourceExtraComputed: '%s' is not an attribute of %s", computed, ti.OpenAPIPath.Path))
+		}
+	}
+	if len(augment.ResourceExtraComputed) > 0 {
+		warnings = append(warnings, "resourceExtraComputed: not used by the generator yet, as every attribute of a resource is already computed")
+	}
+
+	for prefix, path := range augment.ImportIdentifiers {
+		property, ok := paths[path]
+		if !ok {
+			errs = append(errs, fmt.Errorf("importIdentifiers: '%s' is not a property of %s", path, ti.OpenAPIPath.Path))
+		} else if strings.Contains(path, ".") || property.Type() != "string" {
+			errs = append(errs, fmt.Errorf("importIdentifiers: '%s' is not a top-level string property", path))
+		} else if prefix == "" || strings.Contains(prefix, ":") {
+			errs = append(errs, fmt.Errorf("importIdentifiers: '%s' can't be a prefix, as it's empty or contains ':'", prefix))
+		} else if len(ti.ParentParameters()) > 0 {
+			errs = append(errs, fmt.Errorf("importIdentifiers: '%s' can't be looked up, as %s is nested in a parent", path, ti.OpenAPIPath.Path))
+		} else if augment.isExcluded(path) {
+			warnings = append(warnings, fmt.Sprintf("importIdentifiers: '%s' is excluded", path))
+		} else if ti.OpenAPIPath.Patch().Summary() == "" {
+			warnings = append(warnings, fmt.Sprintf("importIdentifiers: '%s' is an import identifier, but there is no resource", path))
+		}
+	}
+
+	for _, moveState := range augment.MoveStateFrom {
+		if moveState.Provider == "" || moveState.Type == "" {
+			errs = append(errs, fmt.Errorf("moveStateFrom: '%s' needs both a provider and a type", moveState.Provider+moveState.Type))
+		}
+		for attribute, path := range moveState.Attributes {
+			property, ok := paths[path]
+			if !ok {
+				errs = append(errs, fmt.Errorf("moveStateFrom: %s: '%s' is not a property of %s", moveState.Type, path, ti.OpenAPIPath.Path))
+			} else if _, ok := movedValueKinds[(terraformSchemaAttribute{Schema: &schema{Template: &ti, BehaviourMode: "Resource"}, OpenAPISchemaProperty: property}).Type()]; strings.Contains(path, ".") || !ok {
+				errs = append(errs, fmt.Errorf("moveStateFrom: %s: '%s' is not a top-level string, boolean, integer, number or list of strings", moveState.Type, path))
+			} else if !attributeNamePattern.MatchString(attribute) {
+				errs = append(errs, fmt.Errorf("moveStateFrom: %s: '%s' is not a valid attribute name", moveState.Type, attribute))
+			} else if augment.isExcluded(path) {
+				warnings = append(warnings, fmt.Sprintf("moveStateFrom: %s: '%s' is excluded", moveState.Type, path))
+			}
+		}
+		if ti.OpenAPIPath.Patch().Summary() == "" {
+			warnings = append(warnings, fmt.Sprintf("moveStateFrom: %s can be moved from, but there is no resource", moveState.Type))
+		}
+	}
+
+	if augment.SoftDeleted && len(ti.ParentParameters()) > 0 {
+		errs = append(errs, fmt.Errorf("softDeleted: %s is nested in a parent, so it isn't a directory object", ti.OpenAPIPath.Path))
+	}
+	attributes := attributeSnapshots(ti.SchemaResource().Attributes())
+	for _, name := range ti.deletionAttributes() {
+		if _, ok := attributes[name]; ok {
+			errs = append(errs, fmt.Errorf("'%s' clashes with the attribute added to delete the resource", name))
+		}
+	}
+	if (augment.PreventDeletion || augment.SoftDeleted) && ti.OpenAPIPath.Patch().Summary() == "" {
+		warnings = append(warnings, "preventDeletion, softDeleted: there is no resource")
+	}
+
+	errs = append(errs, ti.validateRenames(paths)...)
+
+	for name := range augment.Example.Resource {
+		if !slices.ContainsFunc(ti.SchemaResource().Attributes(), func(tsa terraformSchemaAttribute) bool { return tsa.Name() == name }) {
+			errs = append(errs, fmt.Errorf("example: resource: '%s' is not an attribute of %s", name, ti.OpenAPIPath.Path))
+		}
+	}
+	for _, values := range []map[string]any{augment.Example.Test, augment.Example.Update} {
+		for name := range values {
+			if !slices.ContainsFunc(ti.SchemaResource().Attributes(), func(tsa terraformSchemaAttribute) bool { return tsa.Name() == name }) {
+				errs = append(errs, fmt.Errorf("example: '%s' is not an attribute of %s", name, ti.OpenAPIPath.Path))
+			}
+		}
+	}
+	for name := range augment.Example.DataSource {
+		if !slices.ContainsFunc(ti.SchemaDataSource().Attributes(), func(tsa terraformSchemaAttribute) bool { return tsa.Name() == name && (tsa.Required() || tsa.Optional()) }) {
+			errs = append(errs, fmt.Errorf("example: dataSource: '%s' is not an attribute of %s that can be set", name, ti.OpenAPIPath.Path))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return warnings, fmt.Errorf("augment file %s:\n%w", augmentPath, err)
+	}
+
+	return warnings, nil
+}
+
+// validateRenames checks every renamed property exists, and that its new name is valid and doesn't clash with the attributes next to it.
+// Objects of the same type share a model wherever they're nested, so a property of one needs the same name in all of them
+func (ti TemplateInput) validateRenames(paths map[string]extract.OpenAPISchemaProperty) []error {
+
+	var errs []error
+
+	parentPath := func(path string) string {
+		if i := strings.LastIndex(path, "."); i != -1 {
+			return path[:i]
+		}
+		return ""
+	}
+	parentTitle := func(path string) string {
+		if parent, ok := paths[parentPath(path)]; ok {
+			return parent.ObjectOf().Title()
+		}
+		return ""
+	}
+
+	// Sorted, so errors are reported in the same order every time
+	otherPaths := slices.Sorted(maps.Keys(paths))
+
+	for _, path := range slices.Sorted(maps.Keys(ti.Augment().Renames)) {
+		name := ti.Augment().Renames[path]
+		property, ok := paths[path]
+		if !ok {
+			errs = append(errs, fmt.Errorf("renames: '%s' is not a property of %s", path, ti.OpenAPIPath.Path))
+			continue
+		}
+
+		if path == "id" {
+			errs = append(errs, fmt.Errorf("renames: 'id' can't be renamed, as resources are imported by it"))
+		} else if !attributeNamePattern.MatchString(name) {
+			errs = append(errs, fmt.Errorf("renames: '%s' is not a valid attribute name. Use lowercase letters, digits and underscores", name))
+		} else if !strings.Contains(path, ".") && slices.Contains(reservedAttributeNames, name) {
+			errs = append(errs, fmt.Errorf("renames: '%s' is reserved by Terraform", name))
+		}
+
+		for _, otherPath := range otherPaths {
+			other := paths[otherPath]
+			if otherPath == path {
+				continue
+			}
+			if parentPath(otherPath) == parentPath(path) && ti.attributeName(other) == name {
+				errs = append(errs, fmt.Errorf("renames: '%s' clashes with the attribute of '%s'", name, otherPath))
+			}
+			if parentPath(path) != "" && other.Name == property.Name && parentTitle(otherPath) == parentTitle(path) && ti.attributeName(other) != name {
+				errs = append(errs, fmt.Errorf("renames: '%s' shares a model with '%s', which needs to be renamed to '%s' as well", path, otherPath, name))
+			}
+		}
+	}
+
+	return slices.CompactFunc(errs, func(a, b error) bool { return a.Error() == b.Error() })
+}
+
+// isExcluded determines if the property at the path, or one of the properties it's nested in, is excluded
+func (augment templateAugment) isExcluded(path string) bool {
+	return slices.ContainsFunc(augment.ExcludedProperties, func(excluded string) bool {
+		return path == excluded || strings.HasPrefix(path, excluded+".")
+	})
+}
+
+// defaultValue returns the Go expression setting the default of an attribute holding the kind of value, e.g. stringdefault.StaticString("Private")
+func defaultValue(kind string, value any) (string, error) {
+
+	switch kind {
+	case "String":
+		if v, ok := value.(string); ok {
+			return fmt.Sprintf("stringdefault.StaticString(%s)", strconv.Quote(v)), nil
+		}
+	case "Bool":
+		if v, ok := value.(bool); ok {
+			return fmt.Sprintf("booldefault.StaticBool(%t)", v), nil
+		}
+	case "Int64":
+		if v, ok := value.(int); ok {
+			return fmt.Sprintf("int64default.StaticInt64(%d)", v), nil
+		}
+	case "Float64":
+		switch v := value.(type) {
+		case int:
+			return fmt.Sprintf("float64default.StaticFloat64(%d)", v), nil
+		case float64:
+			return fmt.Sprintf("float64default.StaticFloat64(%s)", strconv.FormatFloat(v, 'g', -1, 64)), nil
+		}
+	default:
+		return "", fmt.Errorf("defaults are not supported for %s attributes", strings.ToLower(kind))
+	}
+
+	return "", fmt.Errorf("%v is not a valid default for a %s attribute", value, strings.ToLower(kind))
+}
+
+// allProperties returns all properties that can be generated by their path, including excluded ones, e.g. 'settings.summary'
+func (ti TemplateInput) allProperties() map[string]extract.OpenAPISchemaProperty {
+
+	paths := map[string]extract.OpenAPISchemaProperty{}
+
+	maxDepth := MaxDepth
+	if ti.Augment().MaxDepth != 0 {
+		maxDepth = ti.Augment().MaxDepth
+	}
+
+	var walk func(properties []extract.OpenAPISchemaProperty)
+	walk = func(properties []extract.OpenAPISchemaProperty) {
+		for _, property := range properties {
+			paths[property.Path()] = property
+			if property.IsObject() && !property.IsRecursive() && (maxDepth == 0 || property.Depth() < maxDepth) {
+				walk(append(property.DerivedTypes(), property.ObjectOf().Properties()...))
+			}
+		}
+	}
+	response := ti.OpenAPIPath.Get().Response()
+	walk(slices.Concat(ti.ParentParameters(), response.DerivedTypes(), response.Properties()))
+
+	return paths
+}
+
+// allPropertyNames returns the names of all properties of an object, and of the objects nested in it, at any depth.
+// It's used for validation, so it doesn't leave out properties the way includeProperty() does.
+// Each object is only walked once, as the same objects are nested in many places in the MS Graph OpenAPI spec
+func allPropertyNames(object extract.OpenAPISchemaObject) []string {
+
+	var names []string
+	walked := map[*openapi3.Schema]bool{}
+
+	var walk func(properties []extract.OpenAPISchemaProperty)
+	walk = func(properties []extract.OpenAPISchemaProperty) {
+		for _, property := range properties {
+			if !slices.Contains(names, property.Name) {
+				names = append(names, property.Name)
+			}
+			if property.IsObject() && !walked[property.ObjectOf().Schema] {
+				walked[property.ObjectOf().Schema] = true
+				walk(append(property.DerivedTypes(), property.ObjectOf().Properties()...))
+			}
+		}
+	}
+	walk(append(object.DerivedTypes(), object.Properties()...))
+
+	return names
+}
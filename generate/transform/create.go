@@ -1,11 +1,8 @@
 package transform
 
 import (
-	"slices"
 	"strings"
 
-	"github.com/iancoleman/strcase"
-
 	"terraform-provider-msgraph/generate/extract"
 )
 
@@ -18,32 +15,24 @@ func (cr createRequest) PostMethod() []queryMethod {
 	pathFields := strings.Split(cr.Template.OpenAPIPath.Path, "/")[1:]
 	pathFields = pathFields[:len(pathFields)-1] // Cut last element, since the endpoint to create uses the previous method
 
-	var postMethod []queryMethod
-	for _, p := range pathFields {
-		newMethod := new(queryMethod)
-		if strings.HasPrefix(p, "{") {
-			pLeft, pRight := pathFieldName(p)
-			pLeft = strcase.ToCamel(pLeft)
-			pRight = strcase.ToCamel(pRight)
-			newMethod.MethodName = "By" + pLeft + pRight
-			newMethod.Parameter = "state." + pRight + ".ValueString()"
-		} else {
-			newMethod.MethodName = strcase.ToCamel(p)
-		}
-		postMethod = append(postMethod, *newMethod)
-	}
+	return cr.Template.requestBuilderMethods(pathFields, "tfPlan" + cr.Template.BlockName().UpperCamel())
+}
 
-	return postMethod
+// Configuration returns the prefix of the name of the request configuration of the POST request, e.g. 'teams.ItemChannels'
+func (cr createRequest) Configuration() string {
+	pathFields := strings.Split(cr.Template.OpenAPIPath.Path, "/")[1:]
+	return requestBuilderConfiguration(pathFields[:len(pathFields)-1])
 }
 
 func (cr createRequest) Attributes() []createRequestAttribute {
 
 	var cra []createRequestAttribute
 
-	for _, property := range cr.Template.OpenAPIPath.Get().Response().Properties() {
+	response := cr.Template.OpenAPIPath.Get().Response()
+	for _, property := range append(response.DerivedTypes(), response.Properties()...) {
 
-		// Skip excluded properties
-		if slices.Contains(cr.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !cr.Template.includeProperty(property) {
 			continue
 		}
 
@@ -89,6 +78,11 @@ func (cr createRequest) IfUuidImportNeeded() bool {
 
 }
 
+// Determines if the request body needs to be declared as an interface, so it can be replaced by one of its derived types
+func (cr createRequest) HasDerivedTypes() bool {
+	return len(cr.Template.OpenAPIPath.Get().Response().DerivedTypes()) > 0
+}
+
 type createRequestAttribute struct {
 	CreateRequest *createRequest
 	Property      extract.OpenAPISchemaProperty
@@ -101,6 +95,10 @@ func (cra createRequestAttribute) Name() string {
 
 func (cra createRequestAttribute) Type() string {
 
+	if cra.Property.IsDerivedType() {
+		return "CreateDerivedTypeAttribute"
+	}
+
 	switch cra.Property.Type() {
 	case "string":
 		switch cra.Property.Format() {
@@ -112,8 +110,8 @@ func (cra createRequestAttribute) Type() string {
 			return "CreateStringBase64UrlAttribute"
 		}
 		return "CreateStringAttribute"
-	case "integer":
-		return "CreateInt64Attribute"
+	case "integer", "number":
+		return "Create" + numberKind(cra.Property) + "Attribute"
 	case "boolean":
 		return "CreateBoolAttribute"
 	case "array":
@@ -159,6 +157,11 @@ func (cra createRequestAttribute) SetModelMethod() string {
 	}
 }
 
+// The Go type the SDK holds the number in, e.g. int32
+func (cra createRequestAttribute) NumberType() string {
+	return cra.Property.NumberType()
+}
+
 // If this attribute is an object, returns the name of the object that is is.
 // This can be slightly (grammatically) different from the name of the attribute.
 // The attribute name may be plural if it's an array of some kind, but the ObjectOf will be singular
@@ -166,18 +169,28 @@ func (cra createRequestAttribute) ObjectOf() string {
 	return upperFirst(cra.Property.ObjectOf().Title())
 }
 
+// Determines if the request body of this object needs to be declared as an interface, so it can be replaced by one of its derived types
+func (cra createRequestAttribute) HasDerivedTypes() bool {
+	return len(cra.Property.DerivedTypes()) > 0
+}
+
+// The value '@odata.type' is set to when this attribute is a derived type of its parent
+func (cra createRequestAttribute) DiscriminatorValue() string {
+	return cra.Property.DiscriminatorValue
+}
+
 // Generates the Terraform Model name of the given attribute
 func (cra createRequestAttribute) TfModelName() string {
-	return cra.CreateRequest.Template.BlockName().LowerCamel() + cra.ObjectOf()
+	return cra.CreateRequest.Template.modelName(cra.Property.ObjectOf().Title(), false)
 }
 
 func (cra createRequestAttribute) NestedCreate() []createRequestAttribute {
 	var attributes []createRequestAttribute
 
-	for _, property := range cra.Property.ObjectOf().Properties() {
+	for _, property := range append(cra.Property.DerivedTypes(), cra.Property.ObjectOf().Properties()...) {
 
-		// Skip excluded properties
-		if slices.Contains(cra.CreateRequest.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !cra.CreateRequest.Template.includeProperty(property) {
 			continue
 		}
 
@@ -0,0 +1,31 @@
+package transform
+
+// deletionAttributes returns the attributes the augment file adds to the resource to control how it's deleted, which aren't sent to the API
+func (ti TemplateInput) deletionAttributes() []string {
+	var attributes []string
+	if ti.Augment().PreventDeletion {
+		attributes = append(attributes, "prevent_deletion")
+	}
+	if ti.Augment().SoftDeleted {
+		attributes = append(attributes, "permanently_delete")
+	}
+	return attributes
+}
+
+// Determines if the resource has 'prevent_deletion', which makes deleting it fail while it's true
+func (ti TemplateInput) IfPreventDeletion() bool {
+	return ti.Augment().PreventDeletion
+}
+
+// Determines if deleted objects are kept in /directory/deletedItems, and the resource has 'permanently_delete' to purge them from it
+func (ti TemplateInput) IfSoftDeleted() bool {
+	return ti.Augment().SoftDeleted
+}
+
+// The model of the resource, which embeds the model of the type when the resource has attributes the data source doesn't, e.g. 'groupResourceModel'
+func (ti TemplateInput) ResourceModelName() string {
+	if len(ti.deletionAttributes()) > 0 {
+		return ti.BlockName().LowerCamel() + "ResourceModel"
+	}
+	return ti.BlockName().LowerCamel() + "Model"
+}
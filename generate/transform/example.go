@@ -0,0 +1,184 @@
+package transform
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Placeholder for IDs in examples, as real ones are specific to a tenant
+const exampleId = "00000000-0000-0000-0000-000000000000"
+
+// Used by the example templates to generate the examples tfplugindocs adds to the documentation
+type example struct {
+	Template      *TemplateInput
+	BehaviourMode string
+}
+
+func (ti TemplateInput) ResourceExample() example {
+	return example{Template: &ti, BehaviourMode: "Resource"}
+}
+
+func (ti TemplateInput) DataSourceExample() example {
+	return example{Template: &ti, BehaviourMode: "DataSource"}
+}
+
+// The name of the resource or data source in Terraform configurations, e.g. 'msgraph_beta_user'
+func (ti TemplateInput) TerraformTypeName() string {
+	if ti.Beta {
+		return "msgraph_beta_" + ti.BlockName().Snake()
+	}
+	return "msgraph_" + ti.BlockName().Snake()
+}
+
+// The ID to import the example resource with, e.g. '00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000000'
+func (ti TemplateInput) ImportIdExample() string {
+	var ids []string
+	for range ti.ImportIdAttributes() {
+		ids = append(ids, exampleId)
+	}
+	return strings.Join(ids, "/")
+}
+
+func (e example) schema() schema {
+	return schema{Template: e.Template, BehaviourMode: e.BehaviourMode}
+}
+
+func (e example) augmentValues() map[string]any {
+	if e.BehaviourMode == "Resource" {
+		return e.Template.Augment().Example.Resource
+	}
+	return e.Template.Augment().Example.DataSource
+}
+
+// Arguments returns the arguments of the example as lines of HCL, aligned the way 'terraform fmt' aligns them
+func (e example) Arguments() []string {
+	return hclArguments(e.values())
+}
+
+// values returns the values of the arguments of the example, by attribute name.
+// Resources set the required attributes and display_name, and data sources set the id. The 'example' of the augment file adds to these, or removes them with null
+func (e example) values() map[string]any {
+
+	values := map[string]any{}
+
+	for _, tsa := range e.schema().Attributes() {
+		if e.BehaviourMode == "Resource" && tsa.Name() == "display_name" {
+			values[tsa.Name()] = "Example"
+		} else if tsa.Required() {
+			values[tsa.Name()] = tsa.exampleValue()
+		} else if e.BehaviourMode == "DataSource" && tsa.Name() == "id" && tsa.Optional() {
+			values[tsa.Name()] = exampleId
+		}
+	}
+
+	for name, value := range e.augmentValues() {
+		if value == nil {
+			delete(values, name)
+		} else {
+			values[name] = value
+		}
+	}
+
+	return values
+
+}
+
+// exampleValue returns a placeholder value for a required attribute
+func (tsa terraformSchemaAttribute) exampleValue() any {
+	switch tsa.ValueKind() {
+	case "String":
+		if tsa.OpenAPISchemaProperty.IsPathParameter() {
+			return exampleId
+		}
+		return "example"
+	case "Int64", "Float64":
+		return 0
+	case "Bool":
+		return false
+	case "List":
+		return []any{}
+	default:
+		return map[string]any{}
+	}
+}
+
+// hclArguments renders attribute values from YAML as HCL arguments, sorted by name.
+// Strings of the form '${...}' are written as the expression they contain, e.g. '${msgraph_team.example.id}' as 'msgraph_team.example.id'
+func hclArguments(values map[string]any) []string {
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var lines []string
+	var group []int // Lines of consecutive single line arguments, which have their '=' aligned
+
+	alignGroup := func() {
+		width := 0
+		for _, i := range group {
+			width = max(width, strings.Index(lines[i], " = "))
+		}
+		for _, i := range group {
+			name, value, _ := strings.Cut(lines[i], " = ")
+			lines[i] = fmt.Sprintf("%-*s = %s", width, name, value)
+		}
+		group = nil
+	}
+
+	for _, name := range names {
+		if values[name] == nil {
+			continue
+		}
+		value := strings.Split(hclValue(values[name]), "\n")
+		if len(value) == 1 {
+			group = append(group, len(lines))
+		} else {
+			alignGroup()
+		}
+		lines = append(lines, name+" = "+value[0])
+		lines = append(lines, value[1:]...)
+	}
+	alignGroup()
+
+	return lines
+
+}
+
+func hclValue(value any) string {
+
+	switch v := value.(type) {
+	case string:
+		if strings.HasPrefix(v, "${") && strings.HasSuffix(v, "}") {
+			return v[2 : len(v)-1]
+		}
+		return strconv.Quote(v)
+	case []any:
+		var elements []string
+		for _, element := range v {
+			elements = append(elements, hclValue(element))
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	case map[string]any:
+		if len(v) == 0 {
+			return "{}"
+		}
+		lines := slices.Concat([]string{"{"}, indent(hclArguments(v)), []string{"}"})
+		return strings.Join(lines, "\n")
+	default:
+		return fmt.Sprint(v)
+	}
+
+}
+
+func indent(lines []string) []string {
+	var indented []string
+	for _, line := range lines {
+		indented = append(indented, "  "+line)
+	}
+	return indented
+}
@@ -0,0 +1,44 @@
+package transform
+
+import (
+	"strings"
+)
+
+// Determines if the objects of the resource can be listed to export them, which needs their collection to be at the root of the API
+func (ti TemplateInput) IfExportable() bool {
+	return len(ti.ParentParameters()) == 0
+}
+
+// The request builder method of the collection at the root of the path, e.g. 'ServicePrincipals'
+func (ti TemplateInput) RootCollectionMethod() string {
+	pathFields := strings.Split(ti.OpenAPIPath.Path, "/")[1:]
+	return ti.requestBuilderMethods(pathFields[:1], "")[0].MethodName
+}
+
+// The prefix of the request configuration of the collection at the root of the path, e.g. 'serviceprincipals.ServicePrincipals'
+func (ti TemplateInput) RootCollectionConfiguration() string {
+	return ti.PackageName() + "." + upperFirst(strings.Split(ti.OpenAPIPath.Path, "/")[1])
+}
+
+// ReadOnlyAttributes returns the paths of the resource attributes the API sets, which exports leave out of the configuration they write,
+// e.g. 'created_date_time', or 'sign_in_activity' for an object that is read-only as a whole. Attributes nested in lists are under the path of the list
+func (ti TemplateInput) ReadOnlyAttributes() []string {
+
+	var paths []string
+
+	var walk func(attributes []terraformSchemaAttribute, parent string)
+	walk = func(attributes []terraformSchemaAttribute, parent string) {
+		for _, tsa := range attributes {
+			path := parent + tsa.Name()
+			if tsa.OpenAPISchemaProperty.IsReadOnly() {
+				paths = append(paths, path)
+			} else if tsa.OpenAPISchemaProperty.IsObject() {
+				walk(tsa.NestedAttribute(), path+".")
+			}
+		}
+	}
+	walk(ti.SchemaResource().Attributes(), "")
+
+	return paths
+
+}
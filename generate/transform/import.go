@@ -0,0 +1,54 @@
+package transform
+
+import (
+	"sort"
+	"strings"
+)
+
+// A natural identifier a resource can be imported by, besides its ID, e.g. 'userPrincipalName:alice@contoso.com' for a user.
+// The object is looked up in its collection by the property, which has to match a single object
+type importIdentifier struct {
+	Prefix       string // Prefix of the import ID, e.g. 'userPrincipalName'
+	PropertyName string // Property the object is looked up by, e.g. 'userPrincipalName'
+	Attribute    string // Terraform attribute of the property, e.g. 'user_principal_name'
+	Example      string // Value of the property in the examples, e.g. 'example@contoso.onmicrosoft.com'
+}
+
+// ImportIdentifiers returns the natural identifiers from the augment file, sorted by prefix.
+// Only resources at the root of the API have them, as the collection of a nested object depends on the IDs of its parents
+func (ti TemplateInput) ImportIdentifiers() []importIdentifier {
+
+	if len(ti.ParentParameters()) > 0 {
+		return nil
+	}
+
+	paths := ti.allProperties()
+	exampleValues := ti.ResourceExample().values()
+
+	var identifiers []importIdentifier
+	for prefix, propertyPath := range ti.Augment().ImportIdentifiers {
+		property, ok := paths[propertyPath]
+		if !ok || ti.Augment().isExcluded(propertyPath) {
+			continue
+		}
+
+		identifier := importIdentifier{
+			Prefix:       prefix,
+			PropertyName: property.Name,
+			Attribute:    ti.attributeName(property),
+			Example:      "example",
+		}
+		if value, ok := exampleValues[identifier.Attribute].(string); ok && !strings.HasPrefix(value, "${") {
+			identifier.Example = value
+		} else if property.Format() == "uuid" {
+			identifier.Example = exampleId
+		}
+
+		identifiers = append(identifiers, identifier)
+	}
+
+	sort.Slice(identifiers, func(i, j int) bool { return identifiers[i].Prefix < identifiers[j].Prefix })
+
+	return identifiers
+
+}
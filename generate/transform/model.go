@@ -5,8 +5,6 @@ import (
 	"slices"
 	"strings"
 
-	"github.com/iancoleman/strcase"
-
 	"terraform-provider-msgraph/generate/extract"
 )
 
@@ -14,8 +12,23 @@ type model struct {
 	Template      *TemplateInput
 }
 
+// Definitions returns the models generated in the package of the type. Models generated in the 'sharedmodels' package are left out
 func (m model) Definitions() []ModelDefinition {
 
+	var definitions []ModelDefinition
+
+	for _, definition := range m.allDefinitions() {
+		if !definition.Shared() {
+			definitions = append(definitions, definition)
+		}
+	}
+
+	return definitions
+
+}
+
+func (m model) allDefinitions() []ModelDefinition {
+
 	// Recurse all definitions
 	var recurseDefinitions func(definitions []ModelDefinition) []ModelDefinition
 	recurseDefinitions = func(definitions []ModelDefinition) []ModelDefinition{
@@ -29,7 +42,8 @@ func (m model) Definitions() []ModelDefinition {
 	}
 
 	var allDefinitions []ModelDefinition
-	newDefinition := ModelDefinition{Model: &m, OpenAPISchema: m.Template.OpenAPIPath.Get().Response()}
+	response := m.Template.OpenAPIPath.Get().Response()
+	newDefinition := ModelDefinition{Model: &m, OpenAPISchema: response, DerivedTypes: response.DerivedTypes(), root: true}
 	allDefinitions = append(allDefinitions, newDefinition)
 	allDefinitions = append(allDefinitions, recurseDefinitions(allDefinitions)...)
 
@@ -53,11 +67,41 @@ func (m model) Definitions() []ModelDefinition {
 type ModelDefinition struct {
 	Model         *model
 	OpenAPISchema extract.OpenAPISchemaObject
+	DerivedTypes  []extract.OpenAPISchemaProperty
+
+	root            bool
+	inSharedPackage bool
+}
+
+// Determines if the model is generated in the 'sharedmodels' package, rather than the package of the type
+func (md ModelDefinition) Shared() bool {
+	return !md.root && md.Model.Template.isSharedModel(md.OpenAPISchema.Title())
+}
+
+// fingerprint describes the structure of the model, and all models nested in it, to tell if it's generated identically for different types
+func (md ModelDefinition) fingerprint() string {
+
+	fingerprint := md.OpenAPISchema.Title() + "{"
+	for _, field := range md.ModelFields() {
+		fingerprint += field.AttributeName() + ":" + field.FieldType() + ";"
+	}
+	for _, nested := range md.NestedDefinitions() {
+		fingerprint += nested.fingerprint()
+	}
+
+	return fingerprint + "}"
+
 }
 
 func (md ModelDefinition) ModelName() string {
 
-	if len(md.OpenAPISchema.Title()) > 0 && strings.ToLower(md.Model.Template.BlockName().LowerCamel()) != strings.ToLower(md.OpenAPISchema.Title()) {
+	if md.Shared() {
+		return md.Model.Template.modelName(md.OpenAPISchema.Title(), md.inSharedPackage)
+	}
+
+	if md.root {
+		return md.Model.Template.BlockName().LowerCamel() + "Model"
+	} else if len(md.OpenAPISchema.Title()) > 0 && strings.ToLower(md.Model.Template.BlockName().LowerCamel()) != strings.ToLower(md.OpenAPISchema.Title()) {
 		return md.Model.Template.BlockName().LowerCamel() + upperFirst(md.OpenAPISchema.Title()) + "Model"
 	} else {
 		return md.Model.Template.BlockName().LowerCamel() + "Model"
@@ -69,10 +113,16 @@ func (md ModelDefinition) ModelFields() []ModelField {
 
 	var newModelFields []ModelField
 
-	for _, property := range md.OpenAPISchema.Properties() {
+	// Parent IDs from the path are stored alongside the attributes of the object itself
+	var parentParameters []extract.OpenAPISchemaProperty
+	if md.root {
+		parentParameters = md.Model.Template.ParentParameters()
+	}
+
+	for _, property := range slices.Concat(parentParameters, md.DerivedTypes, md.OpenAPISchema.Properties()) {
 
-		// Skip excluded properties
-		if slices.Contains(md.Model.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !md.Model.Template.includeProperty(property) {
 			continue
 		}
 
@@ -93,17 +143,17 @@ func (md ModelDefinition) NestedDefinitions() []ModelDefinition {
 
 	var definitions []ModelDefinition
 
-	for _, property := range md.OpenAPISchema.Properties() {
+	for _, property := range slices.Concat(md.DerivedTypes, md.OpenAPISchema.Properties()) {
 
-		// Skip excluded properties
-		if slices.Contains(md.Model.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !md.Model.Template.includeProperty(property) {
 			continue
 		}
 
 		if property.Type() == "object" && property.ObjectOf().Type() != "string" {
-			definitions = append(definitions, ModelDefinition{Model: md.Model, OpenAPISchema: property.ObjectOf()})
+			definitions = append(definitions, ModelDefinition{Model: md.Model, OpenAPISchema: property.ObjectOf(), DerivedTypes: property.DerivedTypes(), inSharedPackage: md.inSharedPackage})
 		} else if property.Type() == "array" && property.ArrayOf() == "object" && property.ObjectOf().Type() != "string" {
-			definitions = append(definitions, ModelDefinition{Model: md.Model, OpenAPISchema: property.ObjectOf()})
+			definitions = append(definitions, ModelDefinition{Model: md.Model, OpenAPISchema: property.ObjectOf(), DerivedTypes: property.DerivedTypes(), inSharedPackage: md.inSharedPackage})
 		}
 
 	}
@@ -122,7 +172,7 @@ func (mf ModelField) FieldName() string {
 }
 
 func (mf ModelField) AttributeName() string {
-	return strcase.ToSnake(mf.Property.Name)
+	return mf.Definition.Model.Template.attributeName(mf.Property)
 }
 
 func (m ModelField) IfObjectType() bool {
@@ -138,8 +188,8 @@ func (mf ModelField) FieldType() string {
 	switch mf.Property.Type() {
 	case "string":
 		return "types.String"
-	case "number":
-		return "types.Int64"
+	case "integer", "number":
+		return "types." + numberKind(mf.Property)
 	case "boolean":
 		return "types.Bool"
 	case "object":
@@ -171,15 +221,15 @@ func (mf ModelField) AttributeType() string {
 	switch mf.Property.Type() {
 	case "string":
 		return "types.StringType"
-	case "number":
-		return "types.Int64Type"
+	case "integer", "number":
+		return "types." + numberKind(mf.Property) + "Type"
 	case "boolean":
 		return "types.BoolType"
 	case "object":
 		if mf.Property.ObjectOf().Type() == "string" { // This is a string enum.
 			return "types.StringType"
 		} else {
-			return fmt.Sprintf("types.ObjectType{AttrTypes:%sModel{}.AttributeTypes()}", mf.Definition.Model.Template.BlockName().LowerCamel()+upperFirst(mf.Property.ObjectOf().Title()))
+			return fmt.Sprintf("types.ObjectType{AttrTypes:%s{}.AttributeTypes()}", mf.Definition.Model.Template.modelName(mf.Property.ObjectOf().Title(), mf.Definition.inSharedPackage))
 		}
 	case "array":
 		switch mf.Property.ArrayOf() {
@@ -187,7 +237,7 @@ func (mf ModelField) AttributeType() string {
 			if mf.Property.ObjectOf().Type() == "string" { // This is a string enum.
 				return "types.ListType{ElemType:types.StringType}"
 			} else {
-				return fmt.Sprintf("types.ListType{ElemType:types.ObjectType{AttrTypes:%sModel{}.AttributeTypes()}}", mf.Definition.Model.Template.BlockName().LowerCamel()+upperFirst(mf.Property.ObjectOf().Title()))
+				return fmt.Sprintf("types.ListType{ElemType:types.ObjectType{AttrTypes:%s{}.AttributeTypes()}}", mf.Definition.Model.Template.modelName(mf.Property.ObjectOf().Title(), mf.Definition.inSharedPackage))
 			}
 		case "string":
 			return "types.ListType{ElemType:types.StringType}"
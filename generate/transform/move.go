@@ -0,0 +1,58 @@
+package transform
+
+import (
+	"sort"
+)
+
+// The kind of value of the attributes whose state can be moved from another provider, by attribute type
+var movedValueKinds = map[string]string{
+	"StringAttribute":  "String",
+	"BoolAttribute":    "Bool",
+	"Int64Attribute":   "Int64",
+	"Float64Attribute": "Float64",
+	"ListAttribute":    "StringList",
+}
+
+// A resource of another provider whose state can be moved to this resource, e.g. 'azuread_group'
+type stateMover struct {
+	Provider   string // Namespace and type of the provider, e.g. 'hashicorp/azuread'
+	Type       string
+	Attributes []movedAttribute // Sorted by the attribute of the other resource
+}
+
+// An attribute of a resource of another provider, whose value is moved to an attribute of this resource
+type movedAttribute struct {
+	Source    string // Attribute of the other resource, e.g. 'object_id'
+	Attribute string // Attribute of this resource, e.g. 'id'
+	ValueKind string // 'String', 'Bool', 'Int64', 'Float64' or 'StringList'
+}
+
+// StateMovers returns the resources of other providers from the augment file whose state can be moved to the resource
+func (ti TemplateInput) StateMovers() []stateMover {
+
+	paths := ti.allProperties()
+
+	var movers []stateMover
+	for _, moveState := range ti.Augment().MoveStateFrom {
+
+		mover := stateMover{Provider: moveState.Provider, Type: moveState.Type}
+		for source, propertyPath := range moveState.Attributes {
+			property, ok := paths[propertyPath]
+			if !ok || ti.Augment().isExcluded(propertyPath) {
+				continue
+			}
+			attribute := terraformSchemaAttribute{Schema: &schema{Template: &ti, BehaviourMode: "Resource"}, OpenAPISchemaProperty: property}
+			mover.Attributes = append(mover.Attributes, movedAttribute{
+				Source:    source,
+				Attribute: ti.attributeName(property),
+				ValueKind: movedValueKinds[attribute.Type()],
+			})
+		}
+		sort.Slice(mover.Attributes, func(i, j int) bool { return mover.Attributes[i].Source < mover.Attributes[j].Source })
+
+		movers = append(movers, mover)
+	}
+
+	return movers
+
+}
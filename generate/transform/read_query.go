@@ -1,10 +1,7 @@
 package transform
 
 import (
-	"slices"
 	"strings"
-
-	"github.com/iancoleman/strcase"
 )
 
 // Used by templates defined inside of read_query_template.go to generate the read query code
@@ -22,18 +19,33 @@ func (rq readQuery) PathFields() []string {
 }
 
 func (rq readQuery) Configuration() string {
+	return requestBuilderConfiguration(rq.PathFields())
+}
+
+// requestBuilderConfiguration returns the prefix of the names of the request configurations of the msgraph-sdk-go request builder of a path,
+// e.g. 'teams.TeamItem' for 'TeamItemRequestBuilderGetRequestConfiguration' of '/teams/{team-id}'
+func requestBuilderConfiguration(pathFields []string) string {
+
+	config := strings.ToLower(pathFields[0]) + "."
+	if len(pathFields) == 1 {
+		return config + upperFirst(pathFields[0])
+	}
 
-	var config string
+	// msgraph-sdk-go names the request builders of nested paths after the path fields leading to them, with parameters named 'Item'
+	// e.g. '/teams/{team-id}/channels/{channel-id}' is 'teams.ItemChannelsChannelItem'
+	for _, p := range pathFields[1 : len(pathFields)-1] {
+		if strings.HasPrefix(p, "{") {
+			config += "Item"
+		} else {
+			config += upperFirst(p)
+		}
+	}
 
-	// Generate ReadQuery.Configuration
-	config = strings.ToLower(rq.PathFields()[0]) + "."
-	if len(rq.PathFields()) == 1 {
-		config += upperFirst(rq.PathFields()[0])
-	} else if len(rq.PathFields()) == 2 {
-		s, _ := pathFieldName(rq.PathFields()[1])
+	if last := pathFields[len(pathFields)-1]; strings.HasPrefix(last, "{") {
+		s, _ := pathFieldName(last)
 		config += upperFirst(s) + "Item"
 	} else {
-		config += "MISSING"
+		config += upperFirst(last)
 	}
 
 	return config
@@ -45,7 +57,7 @@ func (rq readQuery) SelectParameters() []string {
 	var selectParams []string
 
 	for _, p := range rq.Template.OpenAPIPath.Get().Response().Properties() {
-		if !slices.Contains(rq.Template.Augment().ExcludedProperties, p.Name) {
+		if rq.Template.includeProperty(p) {
 			selectParams = append(selectParams, p.Name)
 		}
 	}
@@ -54,29 +66,16 @@ func (rq readQuery) SelectParameters() []string {
 
 }
 
+// Determines if the object can be read by more than its path, e.g. by 'id', or by an alternate key from the augment file.
+// Parent IDs in the path are required attributes, so they don't need an alternative
 func (rq readQuery) MultipleGetMethodParameters() bool {
-	for _, p := range rq.PathFields()[1:] {
-		if strings.HasPrefix(p, "{") {
-			return true
-		}
-	}
-	return false
+	return strings.HasPrefix(rq.PathFields()[len(rq.PathFields())-1], "{")
+}
+
+func (rq readQuery) SdkModelName() string {
+	return rq.Template.SdkModelName()
 }
 
 func (rq readQuery) GetMethod() []queryMethod {
-	var getMethod []queryMethod
-	for _, p := range rq.PathFields() {
-		newMethod := new(queryMethod)
-		if strings.HasPrefix(p, "{") {
-			pLeft, pRight := pathFieldName(p)
-			pLeft = strcase.ToCamel(pLeft)
-			pRight = strcase.ToCamel(pRight)
-			newMethod.MethodName = "By" + pLeft + pRight
-			newMethod.Parameter = "tfState" + rq.BlockName() + "." + pRight + ".ValueString()"
-		} else {
-			newMethod.MethodName = strcase.ToCamel(p)
-		}
-		getMethod = append(getMethod, *newMethod)
-	}
-	return getMethod
+	return rq.Template.requestBuilderMethods(rq.PathFields(), "tfState"+rq.BlockName())
 }
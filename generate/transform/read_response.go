@@ -1,7 +1,6 @@
 package transform
 
 import (
-	"slices"
 	"strings"
 
 	"terraform-provider-msgraph/generate/extract"
@@ -15,10 +14,11 @@ func (rr readResponse) Attributes() []readResponseAttribute {
 
 	var readResponseAttributes []readResponseAttribute
 
-	for _, property := range rr.Template.OpenAPIPath.Get().Response().Properties() {
+	response := rr.Template.OpenAPIPath.Get().Response()
+	for _, property := range append(response.DerivedTypes(), response.Properties()...) {
 
-		// Skip excluded properties
-		if slices.Contains(rr.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !rr.Template.includeProperty(property) {
 			continue
 		}
 
@@ -41,7 +41,7 @@ func (rr readResponse) AllAttributes() []readResponseAttribute {
 	recurseAttributes = func(attributes []readResponseAttribute) []readResponseAttribute{
 
 		for _, rra := range attributes {
-			if rra.Type() == "ReadSingleNestedAttribute" || rra.Type() == "ReadListNestedAttribute" {
+			if rra.Type() == "ReadSingleNestedAttribute" || rra.Type() == "ReadListNestedAttribute" || rra.Type() == "ReadDerivedTypeAttribute" {
 				attributes = append(attributes, recurseAttributes(rra.NestedRead())...)
 			}
 		}
@@ -77,6 +77,18 @@ func (rr readResponse) IfBasetypesImportNeeded() bool {
 	return false
 }
 
+// Determines if a terraform datasource needs to import msgraph-sdk-go/models to assert derived types
+func (rr readResponse) IfDerivedTypesUsed() bool {
+
+	for _, rra := range rr.AllAttributes() {
+		if rra.Type() == "ReadDerivedTypeAttribute" {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Used by 'read_response_template' to generate code to map the query response to the terraform model
 type readResponseAttribute struct {
 	ReadResponse *readResponse
@@ -90,6 +102,10 @@ func (rra readResponseAttribute) Name() string {
 
 func (rra readResponseAttribute) Type() string {
 
+	if rra.Property.IsDerivedType() {
+		return "ReadDerivedTypeAttribute"
+	}
+
 	switch rra.Property.Type() {
 	case "string":
 		if rra.Property.Format() == "" {
@@ -99,8 +115,8 @@ func (rra readResponseAttribute) Type() string {
 		} else {
 			return "ReadStringFormattedAttribute"
 		}
-	case "integer":
-		return "ReadInt64Attribute"
+	case "integer", "number":
+		return "Read" + numberKind(rra.Property) + "Attribute"
 	case "boolean":
 		return "ReadBoolAttribute"
 	case "object":
@@ -142,7 +158,7 @@ func (rra readResponseAttribute) ObjectOf() string {
 }
 
 func (rra readResponseAttribute) TfModelName() string {
-	return rra.ReadResponse.Template.BlockName().LowerCamel() + rra.ObjectOf()
+	return rra.ReadResponse.Template.modelName(rra.Property.ObjectOf().Title(), false)
 }
 
 
@@ -162,10 +178,10 @@ func (rra readResponseAttribute) NestedRead() []readResponseAttribute {
 
 	var read []readResponseAttribute
 
-	for _, property := range rra.Property.ObjectOf().Properties() {
+	for _, property := range append(rra.Property.DerivedTypes(), rra.Property.ObjectOf().Properties()...) {
 
-		// Skip excluded properties
-		if slices.Contains(rra.ReadResponse.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !rra.ReadResponse.Template.includeProperty(property) {
 			continue
 		}
 
@@ -1,7 +1,9 @@
 package transform
 
 import (
+	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/iancoleman/strcase"
 
@@ -17,10 +19,11 @@ func (ts schema) Attributes() []terraformSchemaAttribute {
 
 	var attributes []terraformSchemaAttribute
 
-	for _, property := range ts.Template.OpenAPIPath.Get().Response().Properties() {
+	response := ts.Template.OpenAPIPath.Get().Response()
+	for _, property := range slices.Concat(ts.Template.ParentParameters(), response.DerivedTypes(), response.Properties()) {
 
-		// Skip excluded properties
-		if slices.Contains(ts.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !ts.Template.includeProperty(property) {
 			continue
 		}
 
@@ -32,7 +35,7 @@ func (ts schema) Attributes() []terraformSchemaAttribute {
 		attributes = append(attributes, newAttribute)
 	}
 
-	return attributes
+	return withDerivedTypeConflicts(attributes)
 
 }
 
@@ -55,11 +58,16 @@ func (ts schema) AllAttributes() []terraformSchemaAttribute {
 
 }
 
-// Determines if a terraform resource needs to import terraform-provider-msgraph/planmodifiers/listplanmodifiers
-func (ts schema) IfListPlanModifiersImportNeeded() bool {
+// Determines if a terraform resource needs to import terraform-plugin-framework/resource/schema/stringplanmodifier
+func (ts schema) IfRequiresReplaceUsed() bool {
+	return ts.BehaviourMode == "Resource" && len(ts.Template.ParentParameters()) > 0
+}
+
+// Determines if a terraform resource needs to import terraform-provider-msgraph/planmodifiers/<kind>planmodifiers, e.g. listplanmodifiers for "List"
+func (ts schema) IfPlanModifiersImportNeeded(kind string) bool {
 
 	for _, tsa := range ts.AllAttributes() {
-		if (tsa.Type() == "ListAttribute" || tsa.Type() == "ListNestedAttribute") && tsa.PlanModifiers() {
+		if tsa.ValueKind() == kind && tsa.PlanModifiers() {
 			return true
 		}
 	}
@@ -68,38 +76,104 @@ func (ts schema) IfListPlanModifiersImportNeeded() bool {
 
 }
 
-func (ts schema) IfSingleNestedAttributeUsed(attributes []terraformSchemaAttribute) bool {
+// Determines if a terraform resource needs to import the terraform-plugin-framework/resource/schema/<kind>default package
+func (ts schema) IfDefaultUsed(kind string) bool {
 
-	result := false
-
-	if attributes == nil {
-		attributes = ts.Attributes()
+	for _, tsa := range ts.AllAttributes() {
+		if tsa.ValueKind() == kind && tsa.Default() != "" {
+			return true
+		}
 	}
 
-	for _, attribute := range attributes {
-		if attribute.Type() == "SingleNestedAttribute" {
+	return false
+
+}
+
+// Determines if validators from the augment file need terraform-plugin-framework/schema/validator
+func (ts schema) IfValidatorsUsed() bool {
+
+	for _, tsa := range ts.AllAttributes() {
+		if len(tsa.Validators()) > 0 {
 			return true
-		} else if attribute.Type() == "ListNestedAttribute" {
-			result = ts.IfSingleNestedAttributeUsed(attribute.NestedAttribute())
 		}
 	}
 
-	return result
+	return false
+
+}
+
+// OverrideImports returns the packages used by validators and plan modifiers from the augment file, which the template doesn't already import
+func (ts schema) OverrideImports() []string {
+
+	var expressions []string
+	for _, tsa := range ts.AllAttributes() {
+		expressions = slices.Concat(expressions, tsa.Validators(), tsa.CustomPlanModifiers())
+	}
+
+	var imports []string
+	for _, importPath := range slices.Concat(knownImports, ts.Template.Augment().Imports) {
+		packageName := importPath[strings.LastIndex(importPath, "/")+1:]
+		used := slices.ContainsFunc(expressions, func(expression string) bool { return strings.Contains(expression, packageName+".") })
+		if used && !slices.Contains(imports, importPath) && !slices.Contains(ts.templateImports(), importPath) {
+			imports = append(imports, importPath)
+		}
+	}
+
+	return imports
+
+}
+
+// templateImports returns the plan modifier packages the template imports by itself. This needs to be kept in line with resource_template.go
+func (ts schema) templateImports() []string {
+
+	if ts.BehaviourMode != "Resource" {
+		return nil
+	}
+
+	var imports []string
+	if ts.IfRequiresReplaceUsed() {
+		imports = append(imports, "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier")
+	}
+	for _, kind := range []string{"Bool", "Float64", "Int64", "List", "Object", "String"} {
+		if ts.IfPlanModifiersImportNeeded(kind) {
+			imports = append(imports, "terraform-provider-msgraph/planmodifiers/"+strings.ToLower(kind)+"planmodifiers")
+		}
+	}
+
+	return imports
+
+}
 
+// numberKind returns the kind of value a number is held in, 'Int64' for integers and 'Float64' otherwise
+func numberKind(property extract.OpenAPISchemaProperty) string {
+	if strings.HasPrefix(property.NumberType(), "float") {
+		return "Float64"
+	}
+	return "Int64"
 }
 
 // Used by templates defined inside of data_source_template.go to generate the schema
 type terraformSchemaAttribute struct {
 	Schema                *schema
 	OpenAPISchemaProperty extract.OpenAPISchemaProperty
+
+	// Names of the other derived types of the same object, when the attribute is a derived type
+	conflictsWith []string
 }
 
 func (tsa terraformSchemaAttribute) Description() string {
+	if override := tsa.override().Description; override != "" {
+		return override
+	}
 	return tsa.OpenAPISchemaProperty.Description()
 }
 
+func (tsa terraformSchemaAttribute) override() propertyOverride {
+	return tsa.Schema.Template.propertyOverride(tsa.OpenAPISchemaProperty)
+}
+
 func (tsa terraformSchemaAttribute) Name() string {
-	return strcase.ToSnake(tsa.OpenAPISchemaProperty.Name)
+	return tsa.Schema.Template.attributeName(tsa.OpenAPISchemaProperty)
 }
 
 func (tsa terraformSchemaAttribute) Type() string {
@@ -108,8 +182,8 @@ func (tsa terraformSchemaAttribute) Type() string {
 	switch tsa.OpenAPISchemaProperty.Type() {
 	case "string":
 		return "StringAttribute"
-	case "integer":
-		return "Int64Attribute"
+	case "integer", "number":
+		return numberKind(tsa.OpenAPISchemaProperty) + "Attribute"
 	case "boolean":
 		return "BoolAttribute"
 	case "object":
@@ -135,16 +209,84 @@ func (tsa terraformSchemaAttribute) Type() string {
 
 }
 
+// The kind of value the attribute holds, as used by the validator and planmodifier packages, e.g. validator.String
+func (tsa terraformSchemaAttribute) ValueKind() string {
+	switch tsa.Type() {
+	case "StringAttribute":
+		return "String"
+	case "Int64Attribute":
+		return "Int64"
+	case "Float64Attribute":
+		return "Float64"
+	case "BoolAttribute":
+		return "Bool"
+	case "ListAttribute", "ListNestedAttribute":
+		return "List"
+	case "SingleNestedAttribute":
+		return "Object"
+	}
+	return "UNKNOWN"
+}
+
+// Parent IDs from the path are always required, as the object can't be found without them.
+// Other attributes can be made required in the resource by the augment file
 func (tsa terraformSchemaAttribute) Required() bool {
-	return false
+	return tsa.OpenAPISchemaProperty.IsPathParameter() || (tsa.Schema.BehaviourMode == "Resource" && tsa.override().Required)
+}
+
+func (tsa terraformSchemaAttribute) Sensitive() bool {
+	return tsa.override().Sensitive
+}
+
+// Validators from the augment file, and for a derived type in a resource, one making it conflict with the other derived types of the object
+func (tsa terraformSchemaAttribute) Validators() []string {
+
+	validators := tsa.override().Validators
+	if tsa.Schema.BehaviourMode != "Resource" || len(tsa.conflictsWith) == 0 {
+		return validators
+	}
+
+	var expressions []string
+	for _, name := range tsa.conflictsWith {
+		expressions = append(expressions, fmt.Sprintf("path.MatchRelative().AtParent().AtName(%q)", name))
+	}
+
+	return append(slices.Clone(validators), "objectvalidator.ConflictsWith("+strings.Join(expressions, ", ")+")")
+
+}
+
+// Default returns the Go expression setting the default value from the augment file, if any. Only resources support defaults
+func (tsa terraformSchemaAttribute) Default() string {
+
+	value, ok := tsa.Schema.Template.Augment().Defaults[tsa.OpenAPISchemaProperty.Path()]
+	if !ok || tsa.Schema.BehaviourMode != "Resource" {
+		return ""
+	}
+
+	// Invalid defaults are reported by ValidateAugment()
+	expression, _ := defaultValue(tsa.ValueKind(), value)
+	return expression
+
+}
+
+// Plan modifiers from the augment file, which replace the default ones of the resource
+func (tsa terraformSchemaAttribute) CustomPlanModifiers() []string {
+	if tsa.Schema.BehaviourMode != "Resource" {
+		return nil
+	}
+	return tsa.override().PlanModifiers
 }
 
 func (tsa terraformSchemaAttribute) Optional() bool {
 
-	if tsa.Schema.BehaviourMode == "DataSource" {
-		if slices.Contains(tsa.Schema.Template.OpenAPIPath.Parameters(), tsa.Schema.Template.OpenAPIPath.Get().Response().Title()+"-"+tsa.Name()) {
+	if tsa.Required() {
+		return false
+	} else if tsa.Schema.BehaviourMode == "DataSource" {
+		// Path parameters and dataSourceExtraOptionals refer to the property, not to the attribute it may be renamed to
+		name := strcase.ToSnake(tsa.OpenAPISchemaProperty.Name)
+		if slices.Contains(tsa.Schema.Template.OpenAPIPath.Parameters(), tsa.Schema.Template.OpenAPIPath.Get().Response().Title()+"-"+name) {
 			return true
-		} else if slices.Contains(tsa.Schema.Template.Augment().DataSourceExtraOptionals, tsa.Name()) {
+		} else if slices.Contains(tsa.Schema.Template.Augment().DataSourceExtraOptionals, name) {
 			return true
 		}
 	} else if tsa.Schema.BehaviourMode == "Resource" {
@@ -156,11 +298,17 @@ func (tsa terraformSchemaAttribute) Optional() bool {
 }
 
 func (tsa terraformSchemaAttribute) Computed() bool {
-	return true
+	return !tsa.Required()
+}
+
+// Changing a parent ID moves the object to another parent, which the API can't do in place
+func (tsa terraformSchemaAttribute) RequiresReplace() bool {
+	return tsa.Schema.BehaviourMode == "Resource" && tsa.OpenAPISchemaProperty.IsPathParameter()
 }
 
 func (tsa terraformSchemaAttribute) PlanModifiers() bool {
-	if tsa.Schema.BehaviourMode == "DataSource" {
+	// An unconfigured attribute with a default is planned to be reset to it, rather than keeping the value in state
+	if tsa.Schema.BehaviourMode == "DataSource" || tsa.Required() || len(tsa.CustomPlanModifiers()) > 0 || tsa.Default() != "" {
 		return false
 	} else { // Resource
 		return true
@@ -168,13 +316,18 @@ func (tsa terraformSchemaAttribute) PlanModifiers() bool {
 	}
 }
 
+// ClearUnconfigured determines if the resource clears the attribute when it's removed from the configuration, with NullIfUnconfigured() instead of UseStateForUnconfigured()
+func (tsa terraformSchemaAttribute) ClearUnconfigured() bool {
+	return tsa.Schema.BehaviourMode == "Resource" && tsa.Schema.Template.clearsUnconfigured(tsa.OpenAPISchemaProperty)
+}
+
 func (tsa terraformSchemaAttribute) NestedAttribute() []terraformSchemaAttribute {
 	var attributes []terraformSchemaAttribute
 
-	for _, property := range tsa.OpenAPISchemaProperty.ObjectOf().Properties() {
+	for _, property := range append(tsa.OpenAPISchemaProperty.DerivedTypes(), tsa.OpenAPISchemaProperty.ObjectOf().Properties()...) {
 
-		// Skip excluded properties
-		if slices.Contains(tsa.Schema.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !tsa.Schema.Template.includeProperty(property) {
 			continue
 		}
 
@@ -186,6 +339,27 @@ func (tsa terraformSchemaAttribute) NestedAttribute() []terraformSchemaAttribute
 		attributes = append(attributes, newAttribute)
 	}
 
+	return withDerivedTypeConflicts(attributes)
+}
+
+// withDerivedTypeConflicts sets the derived types each derived type of an object conflicts with, as the object can only be one of them.
+// Otherwise the last one configured would silently replace the others in the request body
+func withDerivedTypeConflicts(attributes []terraformSchemaAttribute) []terraformSchemaAttribute {
+
+	var derivedTypes []string
+	for _, tsa := range attributes {
+		if tsa.OpenAPISchemaProperty.IsDerivedType() {
+			derivedTypes = append(derivedTypes, tsa.Name())
+		}
+	}
+
+	for i, tsa := range attributes {
+		if tsa.OpenAPISchemaProperty.IsDerivedType() {
+			attributes[i].conflictsWith = slices.DeleteFunc(slices.Clone(derivedTypes), func(name string) bool { return name == tsa.Name() })
+		}
+	}
+
 	return attributes
+
 }
 
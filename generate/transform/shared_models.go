@@ -0,0 +1,101 @@
+package transform
+
+import (
+	"slices"
+	"sort"
+)
+
+// SharedModels collects the models of nested objects that more than one type would otherwise generate identically, e.g. identitySet.
+// These are generated once in the 'sharedmodels' package, and referenced by every type that uses them.
+type SharedModels struct {
+	models map[string]*sharedModel
+	shared map[string]bool
+}
+
+type sharedModel struct {
+	definition  ModelDefinition
+	fingerprint string
+	types       int
+	conflicting bool
+}
+
+func NewSharedModels() *SharedModels {
+	return &SharedModels{models: map[string]*sharedModel{}, shared: map[string]bool{}}
+}
+
+// Register collects the nested models of a type.
+// Every type needs to be registered before any code is generated, as a model can only be shared once it's known to be identical for every type using it
+func (sm *SharedModels) Register(ti TemplateInput) {
+
+	// The first definition is the root of the response, which is always specific to the type
+	for _, definition := range ti.Model().allDefinitions()[1:] {
+
+		title := definition.OpenAPISchema.Title()
+		if title == "" {
+			continue
+		}
+
+		fingerprint := definition.fingerprint()
+		if registered, ok := sm.models[title]; ok {
+			registered.types++
+			if registered.fingerprint != fingerprint {
+				registered.conflicting = true
+			}
+		} else {
+			definition.inSharedPackage = true
+			sm.models[title] = &sharedModel{definition: definition, fingerprint: fingerprint, types: 1}
+		}
+
+	}
+
+	sm.shared = map[string]bool{}
+
+}
+
+// isShared determines if the model with the given title is generated in the 'sharedmodels' package.
+// A model is shared when more than one type uses it, every one of those types generates it identically, and all of the models nested in it are also shared
+func (sm *SharedModels) isShared(title string) bool {
+
+	if shared, ok := sm.shared[title]; ok {
+		return shared
+	}
+
+	registered, ok := sm.models[title]
+	if !ok || registered.conflicting || registered.types < 2 {
+		sm.shared[title] = false
+		return false
+	}
+
+	sm.shared[title] = false // Guards against models nested in themselves
+	for _, nested := range registered.definition.NestedDefinitions() {
+		if !sm.isShared(nested.OpenAPISchema.Title()) {
+			return false
+		}
+	}
+
+	sm.shared[title] = true
+	return true
+
+}
+
+// Used by shared_model_template.go to generate the 'sharedmodels' package
+func (sm *SharedModels) Definitions() []ModelDefinition {
+
+	var definitions []ModelDefinition
+
+	for title, registered := range sm.models {
+		if sm.isShared(title) {
+			definitions = append(definitions, registered.definition)
+		}
+	}
+
+	sort.Slice(definitions[:], func(i, j int) bool { return definitions[i].ModelName() < definitions[j].ModelName() })
+
+	return definitions
+
+}
+
+// Determines if any of the shared models have fields, which are all held in types from the framework's 'types' package
+func (sm *SharedModels) IfTypesImportNeeded() bool {
+	return slices.ContainsFunc(sm.Definitions(), func(definition ModelDefinition) bool { return len(definition.ModelFields()) > 0 })
+}
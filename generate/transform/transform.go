@@ -1,11 +1,12 @@
 package transform
 
 import (
-	"os"
-	"gopkg.in/yaml.v3"
+	"fmt"
+	"slices"
 	"strings"
 	"terraform-provider-msgraph/generate/extract"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/iancoleman/strcase"
 )
 
@@ -46,8 +47,187 @@ type queryMethod struct {
 	Parameter  string
 }
 
+// requestBuilderMethods returns the msgraph-sdk-go request builder methods for the given path fields, e.g. 'Teams().ByTeamId(...).Channels()'
+// The path parameter identifying the object itself is read from the 'id' attribute of the given variable, and those identifying its parents from their own attributes, e.g. 'team_id'
+func (ti TemplateInput) requestBuilderMethods(pathFields []string, variable string) []queryMethod {
+
+	allPathFields := strings.Split(ti.OpenAPIPath.Path, "/")
+	ownPathField := allPathFields[len(allPathFields)-1]
+
+	var methods []queryMethod
+	for _, p := range pathFields {
+		newMethod := new(queryMethod)
+		if strings.HasPrefix(p, "{") {
+			pLeft, pRight := pathFieldName(p)
+			newMethod.MethodName = "By" + strcase.ToCamel(pLeft) + strcase.ToCamel(pRight)
+			if p == ownPathField {
+				newMethod.Parameter = variable + "." + strcase.ToCamel(pRight) + ".ValueString()"
+			} else {
+				newMethod.Parameter = variable + "." + upperFirst(pLeft) + "Id.ValueString()"
+			}
+		} else {
+			newMethod.MethodName = strcase.ToCamel(p)
+		}
+		methods = append(methods, *newMethod)
+	}
+
+	return methods
+}
+
+// The path parameters identifying the parents of the object, exposed as required attributes. e.g. 'team_id' for '/teams/{team-id}/channels/{channel-id}'
+func (ti TemplateInput) ParentParameters() []extract.OpenAPISchemaProperty {
+	return ti.OpenAPIPath.ParentParameters()
+}
+
+// The attributes making up the ID to import a resource with, separated by '/'. e.g. 'team_id' and 'id'
+func (ti TemplateInput) ImportIdAttributes() []string {
+	var attributes []string
+	for _, parameter := range ti.ParentParameters() {
+		attributes = append(attributes, ti.attributeName(parameter))
+	}
+	return append(attributes, "id")
+}
+
+func (ti TemplateInput) ImportIdFormat() string {
+	return strings.Join(ti.ImportIdAttributes(), "/")
+}
+
+// The name of the msgraph-sdk-go model of the object the path returns, e.g. 'Channel'
+func (ti TemplateInput) SdkModelName() string {
+	return upperFirst(ti.OpenAPIPath.Get().Response().Title())
+}
+
 type TemplateInput struct {
 	OpenAPIPath   extract.OpenAPIPathObject
+
+	// Name of the terraform block, in snake case, as configured in generate/config.yaml. Derived from the path when empty
+	TypeName string
+
+	// Augment file to apply, relative to generate/augment/. Derived from the path when TypeName is empty
+	AugmentFile string
+
+	// Models shared with other types. When nil, every model is generated in the package of the type
+	SharedModels *SharedModels
+
+	// Generate from the MS Graph beta API, as a 'msgraph_beta_' resource or data source using msgraph-beta-sdk-go
+	Beta bool
+
+	// The type also has a resource, which the acceptance test of the data source creates to look it up
+	HasResource bool
+
+	// Snapshot of the schema the resource was last generated with, or nil when it's new
+	PriorSchema *SchemaSnapshot
+}
+
+// The directory the code of the type is generated in. Beta types are generated under 'msgraph/beta/', as their packages have the same names
+func (ti TemplateInput) OutputDirectory() string {
+	if ti.Beta {
+		return "msgraph/beta/" + ti.PackageName() + "/"
+	} else {
+		return "msgraph/" + ti.PackageName() + "/"
+	}
+}
+
+func (ti TemplateInput) SdkModule() string {
+	if ti.Beta {
+		return "github.com/microsoftgraph/msgraph-beta-sdk-go"
+	} else {
+		return "github.com/microsoftgraph/msgraph-sdk-go"
+	}
+}
+
+func (ti TemplateInput) SharedModelsPackage() string {
+	return "terraform-provider-msgraph/" + strings.TrimSuffix(ti.OutputDirectory(), ti.PackageName()+"/") + "sharedmodels"
+}
+
+func (ti TemplateInput) isSharedModel(title string) bool {
+	return ti.SharedModels != nil && title != "" && ti.SharedModels.isShared(title)
+}
+
+// modelName returns the name of the model of a nested object, qualified with the 'sharedmodels' package when referenced from outside of it
+func (ti TemplateInput) modelName(title string, fromSharedPackage bool) string {
+
+	if !ti.isSharedModel(title) {
+		return ti.BlockName().LowerCamel() + upperFirst(title) + "Model"
+	} else if fromSharedPackage {
+		return upperFirst(title) + "Model"
+	} else {
+		return "sharedmodels." + upperFirst(title) + "Model"
+	}
+
+}
+
+// Determines if any of the models of the type are generated in the 'sharedmodels' package
+func (ti TemplateInput) IfSharedModelsImportNeeded() bool {
+	return slices.ContainsFunc(ti.Model().allDefinitions(), ModelDefinition.Shared)
+}
+
+// MaxDepth is the maximum depth properties can be nested, before they are truncated from the generated code.
+// It can be overridden with 'maxDepth' in an augment file. Zero means there is no maximum.
+var MaxDepth = 8
+
+// Identifies a property of a type generated from an OpenAPI document. Paths are only unique within a document,
+// as the v1.0 and beta documents, or the documents of different tests, have paths in common
+type propertyKey struct {
+	doc *openapi3.T
+	key string
+}
+
+// Properties that have already been reported as truncated, so each is only reported once
+var truncatedProperties = map[propertyKey]bool{}
+
+// Whether properties are generated, by type and property path. Checking if an object has properties left calls includeProperty()
+// for every property nested in it, which the generator checks again as it goes down each level, so results are kept rather than recomputed
+var includedProperties = map[propertyKey]bool{}
+
+// Determines if a property should be generated.
+// Properties are left out when they are excluded by the augment file, when they hold an object they are already nested in, or when they are nested too deeply
+func (ti TemplateInput) includeProperty(property extract.OpenAPISchemaProperty) bool {
+
+	key := propertyKey{ti.OpenAPIPath.Doc(), fmt.Sprintf("%s %t %s %d %s", ti.OpenAPIPath.Path, ti.Beta, ti.augmentPath(), MaxDepth, property.Path())}
+	if included, ok := includedProperties[key]; ok {
+		return included
+	}
+
+	included := ti.checkProperty(property)
+	includedProperties[key] = included
+
+	return included
+
+}
+
+func (ti TemplateInput) checkProperty(property extract.OpenAPISchemaProperty) bool {
+
+	augment := ti.Augment()
+
+	if slices.Contains(augment.ExcludedProperties, property.Path()) {
+		return false
+	}
+
+	maxDepth := MaxDepth
+	if augment.MaxDepth != 0 {
+		maxDepth = augment.MaxDepth
+	}
+
+	var reason string
+	if property.IsRecursive() {
+		reason = "recursive schema"
+	} else if maxDepth > 0 && property.IsObject() && property.Depth() >= maxDepth {
+		reason = fmt.Sprintf("properties would be nested deeper than %d", maxDepth)
+	} else if property.IsObject() && !slices.ContainsFunc(append(property.DerivedTypes(), property.ObjectOf().Properties()...), ti.includeProperty) {
+		reason = "no properties left to generate"
+	}
+
+	if reason != "" {
+		key := propertyKey{ti.OpenAPIPath.Doc(), ti.OpenAPIPath.Path + " " + property.Path()}
+		if !truncatedProperties[key] {
+			fmt.Printf("Truncated %s: %s (%s)\n", ti.OpenAPIPath.Path, property.Path(), reason)
+			truncatedProperties[key] = true
+		}
+		return false
+	}
+
+	return true
 }
 
 func (ti TemplateInput) PackageName() string {
@@ -56,24 +236,30 @@ func (ti TemplateInput) PackageName() string {
 
 func (ti TemplateInput) BlockName() strWithCases {
 
+	if ti.TypeName != "" {
+		return strWithCases{String: strcase.ToLowerCamel(ti.TypeName)}
+	}
+
 	pathFields := strings.Split(ti.OpenAPIPath.Path, "/")[1:] // Paths start with a '/', so we need to get rid of the first empty entry in the array
 
-	// Generate name of the terraform block
-	blockName := ""
+	// Generate name of the terraform block, from the name of each object in the path, e.g. 'team_channel' for '/teams/{team-id}/channels/{channel-id}'
+	var blockNameParts []string
 	if len(pathFields) > 1 {
-		for _, p := range pathFields[1:] {
-			if strings.HasPrefix(p, "{") {
+		for i, p := range pathFields[1:] {
+			if i+2 < len(pathFields) && strings.HasPrefix(pathFields[i+2], "{") { // Collection of the item that follows, e.g. 'channels' in 'channels/{channel-id}'
+				continue
+			} else if strings.HasPrefix(p, "{") {
 				pLeft, _ := pathFieldName(p)
-				blockName += pLeft
+				blockNameParts = append(blockNameParts, pLeft)
 			} else {
-				blockName += p
+				blockNameParts = append(blockNameParts, p)
 			}
 		}
 	} else {
-		blockName = pathFields[0]
+		blockNameParts = []string{pathFields[0]}
 	}
 
-	return strWithCases{String: blockName}
+	return strWithCases{String: strings.Join(blockNameParts, "_")}
 }
 
 func (ti TemplateInput) Model() model {
@@ -107,28 +293,3 @@ func (ti TemplateInput) CreateRequest() createRequest {
 func (ti TemplateInput) UpdateRequest() updateRequest {
 	return updateRequest{Template: &ti}
 }
-
-// Represents an 'augment' YAML file, used to describe manual changes from the MS Graph OpenAPI spec
-type templateAugment struct {
-	ExcludedProperties       []string            `yaml:"excludedProperties"`
-	AltReadMethods           []map[string]string `yaml:"altReadMethods"`
-	DataSourceExtraOptionals []string            `yaml:"dataSourceExtraOptionals"`
-	ResourceExtraComputed    []string            `yaml:"resourceExtraComputed"`
-}
-
-func (ti TemplateInput) Augment() templateAugment {
-
-	pathFields := strings.Split(ti.OpenAPIPath.Path, "/")[1:] // Paths start with a '/', so we need to get rid of the first empty entry in the array
-	packageName := strings.ToLower(pathFields[0])
-
-	// Open augment file if available
-	var err error = nil
-	augment := templateAugment{}
-	augmentFile, err := os.ReadFile("generate/augment/" + packageName + "/" + ti.BlockName().LowerCamel() + ".yaml")
-	if err == nil {
-		yaml.Unmarshal(augmentFile, &augment)
-	}
-
-	return augment
-}
-
@@ -1,11 +1,8 @@
 package transform
 
 import (
-	"slices"
 	"strings"
 
-	"github.com/iancoleman/strcase"
-
 	"terraform-provider-msgraph/generate/extract"
 )
 
@@ -17,32 +14,18 @@ func (ur updateRequest) PostMethod() []queryMethod {
 
 	pathFields := strings.Split(ur.Template.OpenAPIPath.Path, "/")[1:]
 
-	var postMethod []queryMethod
-	for _, p := range pathFields {
-		newMethod := new(queryMethod)
-		if strings.HasPrefix(p, "{") {
-			pLeft, pRight := pathFieldName(p)
-			pLeft = strcase.ToCamel(pLeft)
-			pRight = strcase.ToCamel(pRight)
-			newMethod.MethodName = "By" + pLeft + pRight
-			newMethod.Parameter = "tfState" + ur.Template.BlockName().UpperCamel() + "." + pRight + ".ValueString()"
-		} else {
-			newMethod.MethodName = strcase.ToCamel(p)
-		}
-		postMethod = append(postMethod, *newMethod)
-	}
-
-	return postMethod
+	return ur.Template.requestBuilderMethods(pathFields, "tfState" + ur.Template.BlockName().UpperCamel())
 }
 
 func (ur updateRequest) Attributes() []updateRequestAttribute {
 
 	var newAttributes []updateRequestAttribute
 
-	for _, property := range ur.Template.OpenAPIPath.Get().Response().Properties() {
+	response := ur.Template.OpenAPIPath.Get().Response()
+	for _, property := range append(response.DerivedTypes(), response.Properties()...) {
 
-		// Skip excluded properties
-		if slices.Contains(ur.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !ur.Template.includeProperty(property) {
 			continue
 		}
 
@@ -58,6 +41,33 @@ func (ur updateRequest) Attributes() []updateRequestAttribute {
 	return newAttributes
 }
 
+// Determines if the request body needs to be declared as an interface, so it can be replaced by one of its derived types
+func (ur updateRequest) HasDerivedTypes() bool {
+	return len(ur.Template.OpenAPIPath.Get().Response().DerivedTypes()) > 0
+}
+
+// Determines if the request body may set properties to null, to clear them, which needs kiota-abstractions-go/serialization
+func (ur updateRequest) IfNullValuesSent() bool {
+
+	var sendsNull func(attributes []updateRequestAttribute) bool
+	sendsNull = func(attributes []updateRequestAttribute) bool {
+		for _, ura := range attributes {
+			if ura.SendsNull() {
+				return true
+			}
+			if ura.Property.Type() == "object" || ura.Property.Type() == "array" {
+				if sendsNull(ura.NestedUpdate()) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	return sendsNull(ur.Attributes())
+
+}
+
 type updateRequestAttribute struct {
 	UpdateRequest *updateRequest
 	Property      extract.OpenAPISchemaProperty
@@ -72,6 +82,10 @@ func (ura updateRequestAttribute) Name() string {
 
 func (ura updateRequestAttribute) Type() string {
 
+	if ura.Property.IsDerivedType() {
+		return "UpdateDerivedTypeAttribute"
+	}
+
 	switch ura.Property.Type() {
 	case "string":
 		switch ura.Property.Format() {
@@ -83,8 +97,8 @@ func (ura updateRequestAttribute) Type() string {
 			return "UpdateStringBase64UrlAttribute"
 		}
 		return "UpdateStringAttribute"
-	case "integer":
-		return "UpdateInt64Attribute"
+	case "integer", "number":
+		return "Update" + numberKind(ura.Property) + "Attribute"
 	case "boolean":
 		return "UpdateBoolAttribute"
 	case "array":
@@ -131,6 +145,11 @@ func (ura updateRequestAttribute) SetModelMethod() string {
 	}
 }
 
+// The Go type the SDK holds the number in, e.g. int32
+func (ura updateRequestAttribute) NumberType() string {
+	return ura.Property.NumberType()
+}
+
 // If this attribute is an object, returns the name of the object that is is.
 // This can be slightly (grammatically) different from the name of the attribute.
 // The attribute name may be plural if it's an array of some kind, but the ObjectOf will be singular
@@ -138,18 +157,65 @@ func (ura updateRequestAttribute) ObjectOf() string {
 	return upperFirst(ura.Property.ObjectOf().Title())
 }
 
+// Determines if the request body of this object needs to be declared as an interface, so it can be replaced by one of its derived types
+func (ura updateRequestAttribute) HasDerivedTypes() bool {
+	return len(ura.Property.DerivedTypes()) > 0
+}
+
+// The value '@odata.type' is set to when this attribute is a derived type of its parent
+func (ura updateRequestAttribute) DiscriminatorValue() string {
+	return ura.Property.DiscriminatorValue
+}
+
 // Generates the Terraform Model name of the given attribute
 func (ura updateRequestAttribute) TfModelName() string {
-	return ura.UpdateRequest.Template.BlockName().LowerCamel() + ura.ObjectOf()
+	return ura.UpdateRequest.Template.modelName(ura.Property.ObjectOf().Title(), false)
+}
+
+// Whole determines if the attribute is sent whenever it's set, rather than only when it changes.
+// The API replaces nested objects whole, so every property nested in an object or an element of a collection is sent, not only those that changed.
+// The properties of a derived type of the resource itself are top-level properties
+func (ura updateRequestAttribute) Whole() bool {
+	if ura.Parent == nil {
+		return false
+	}
+	if ura.Parent.Property.IsDerivedType() {
+		return ura.Parent.Whole()
+	}
+	return true
+}
+
+// ClearUnconfigured determines if the attribute is cleared when it's planned to be null, after being removed from the configuration
+func (ura updateRequestAttribute) ClearUnconfigured() bool {
+	return ura.UpdateRequest.Template.clearsUnconfigured(ura.Property)
+}
+
+// SendsNull determines if the attribute is cleared by setting its property to null.
+// Collections are cleared by setting them to an empty collection instead, which the API accepts more widely,
+// and nested properties by leaving them out of the object they're sent in
+func (ura updateRequestAttribute) SendsNull() bool {
+	if ura.Whole() {
+		return false
+	}
+	switch ura.Type() {
+	case "UpdateArrayStringAttribute", "UpdateArrayUuidAttribute", "UpdateArrayObjectAttribute", "UpdateDerivedTypeAttribute":
+		return false
+	}
+	return ura.ClearUnconfigured()
+}
+
+// The name of the property in the request body, e.g. 'displayName'
+func (ura updateRequestAttribute) PropertyName() string {
+	return ura.Property.Name
 }
 
 func (ura updateRequestAttribute) NestedUpdate() []updateRequestAttribute {
 	var attributes []updateRequestAttribute
 
-	for _, property := range ura.Property.ObjectOf().Properties() {
+	for _, property := range append(ura.Property.DerivedTypes(), ura.Property.ObjectOf().Properties()...) {
 
-		// Skip excluded properties
-		if slices.Contains(ura.UpdateRequest.Template.Augment().ExcludedProperties, property.Name) {
+		// Skip excluded, recursive and too deeply nested properties
+		if !ura.UpdateRequest.Template.includeProperty(property) {
 			continue
 		}
 
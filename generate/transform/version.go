@@ -0,0 +1,125 @@
+package transform
+
+import (
+	"sort"
+)
+
+// A snapshot of the schema of a resource, saved next to its code, which the schema is compared to when the resource is next generated.
+// Attributes that are renamed, removed or change type break state saved with the earlier schema, so they bump its version,
+// and state saved with earlier versions is upgraded by dropping them. The next read sets them again
+type SchemaSnapshot struct {
+	Version    int64                        `json:"version"`
+	Attributes map[string]attributeSnapshot `json:"attributes"`
+	Upgrades   []schemaUpgrade              `json:"upgrades,omitempty"`
+}
+
+type attributeSnapshot struct {
+	Type       string                       `json:"type"` // e.g. 'StringAttribute'
+	Attributes map[string]attributeSnapshot `json:"attributes,omitempty"`
+}
+
+// The top-level attributes of the schema a version breaks, by their name in the version before it
+type schemaUpgrade struct {
+	Version int64    `json:"version"`
+	Changed []string `json:"changed"`
+}
+
+// Upgrades state saved with an earlier version of the schema, by dropping the top-level attributes that changed since
+type stateUpgrader struct {
+	PriorVersion int64
+	Dropped      []string
+}
+
+// SchemaSnapshot returns the snapshot of the schema of the resource, with its version bumped if it breaks the snapshot it was last generated with
+func (ti TemplateInput) SchemaSnapshot() SchemaSnapshot {
+
+	snapshot := SchemaSnapshot{Attributes: attributeSnapshots(ti.SchemaResource().Attributes())}
+	for _, name := range ti.deletionAttributes() {
+		snapshot.Attributes[name] = attributeSnapshot{Type: "BoolAttribute"}
+	}
+	if ti.PriorSchema == nil {
+		return snapshot
+	}
+
+	snapshot.Version = ti.PriorSchema.Version
+	snapshot.Upgrades = ti.PriorSchema.Upgrades
+
+	var changed []string
+	for name, prior := range ti.PriorSchema.Attributes {
+		if current, ok := snapshot.Attributes[name]; !ok || !prior.compatible(current) {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) > 0 {
+		sort.Strings(changed)
+		snapshot.Version++
+		snapshot.Upgrades = append(snapshot.Upgrades[:len(snapshot.Upgrades):len(snapshot.Upgrades)], schemaUpgrade{Version: snapshot.Version, Changed: changed})
+	}
+
+	return snapshot
+
+}
+
+// SchemaVersion returns the version of the schema of the resource, which is 0 until it breaks state saved with an earlier version
+func (ti TemplateInput) SchemaVersion() int64 {
+	return ti.SchemaSnapshot().Version
+}
+
+// StateUpgraders returns an upgrader for each earlier version of the schema of the resource
+func (ti TemplateInput) StateUpgraders() []stateUpgrader {
+
+	snapshot := ti.SchemaSnapshot()
+
+	var upgraders []stateUpgrader
+	for version := int64(0); version < snapshot.Version; version++ {
+
+		dropped := map[string]bool{}
+		for _, upgrade := range snapshot.Upgrades {
+			if upgrade.Version > version {
+				for _, name := range upgrade.Changed {
+					// The ID is always carried over, as the object can't be read again without it
+					if name != "id" {
+						dropped[name] = true
+					}
+				}
+			}
+		}
+
+		upgrader := stateUpgrader{PriorVersion: version}
+		for name := range dropped {
+			upgrader.Dropped = append(upgrader.Dropped, name)
+		}
+		sort.Strings(upgrader.Dropped)
+
+		upgraders = append(upgraders, upgrader)
+	}
+
+	return upgraders
+
+}
+
+func attributeSnapshots(attributes []terraformSchemaAttribute) map[string]attributeSnapshot {
+	snapshots := map[string]attributeSnapshot{}
+	for _, tsa := range attributes {
+		snapshot := attributeSnapshot{Type: tsa.Type()}
+		if tsa.Type() == "SingleNestedAttribute" || tsa.Type() == "ListNestedAttribute" {
+			snapshot.Attributes = attributeSnapshots(tsa.NestedAttribute())
+		}
+		snapshots[tsa.Name()] = snapshot
+	}
+	return snapshots
+}
+
+// compatible determines if state saved with the prior attribute can be read with the current one: they have the same type,
+// and every attribute nested in the prior one is still nested in the current one, compatibly. Nested attributes that were added are read as null
+func (prior attributeSnapshot) compatible(current attributeSnapshot) bool {
+	if prior.Type != current.Type {
+		return false
+	}
+	for name, nested := range prior.Attributes {
+		if other, ok := current.Attributes[name]; !ok || !nested.compatible(other) {
+			return false
+		}
+	}
+	return true
+}
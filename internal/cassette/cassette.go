@@ -0,0 +1,229 @@
+// Package cassette records the requests acceptance tests send to the MS Graph API and their responses, and replays them,
+// so tests recorded once against a tenant can run without one.
+//
+// Each test has a cassette, a YAML file of its interactions. Secrets are scrubbed from them before they are saved:
+// request headers aren't recorded, so neither are tokens, and properties such as passwords, JWTs and the tenant ID, taken from the
+// tokens, are replaced.
+// Replayed requests are matched to interactions by method and URL, in the order they were recorded,
+// so the same request can get different responses, e.g. reading an object before and after it's updated
+package cassette
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"terraform-provider-msgraph/msgraph/clients"
+)
+
+type Mode int
+
+const (
+	// Replay responds to requests with the responses of the cassette, without sending them
+	Replay Mode = iota
+
+	// Record sends requests, and saves them and their responses to the cassette
+	Record
+)
+
+// Cassette is the file interactions are recorded in
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+}
+
+type Request struct {
+	Method string `yaml:"method"`
+	Url    string `yaml:"url"`
+	Body   string `yaml:"body,omitempty"`
+}
+
+type Response struct {
+	Status  int               `yaml:"status"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+	Body    string            `yaml:"body,omitempty"`
+}
+
+// Response headers kept in cassettes. The others are specific to the request, e.g. request-id, or of no use to the provider
+var recordedHeaders = []string{"Content-Location", "Content-Type", "Location"}
+
+// Recorder records or replays the interactions of a cassette
+type Recorder struct {
+	mode     Mode
+	path     string
+	cassette Cassette
+	scrubber scrubber
+
+	mu     sync.Mutex
+	parent http.RoundTripper
+	played []bool // Interactions of the cassette already replayed
+}
+
+// New returns a recorder for the cassette at path, e.g. 'testdata/cassettes/TestAccUserResource.yaml'.
+// Replaying needs the cassette to exist, which is reported by an error satisfying errors.Is(err, os.ErrNotExist)
+func New(path string, mode Mode) (*Recorder, error) {
+
+	r := &Recorder{mode: mode, path: path, scrubber: newScrubber()}
+
+	if mode == Replay {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(content, &r.cassette); err != nil {
+			return nil, fmt.Errorf("reading cassette %s: %w", path, err)
+		}
+		r.played = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+
+}
+
+// Redact replaces a secret with a placeholder wherever it's recorded, in addition to those scrubbed by default
+func (r *Recorder) Redact(secret string, placeholder string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.scrubber.redact(secret, placeholder)
+}
+
+// Transport returns a transport that records or replays the requests of the client whose transport is parent.
+// Pass it to msgraph.NewWithTransport
+func (r *Recorder) Transport(parent http.RoundTripper) http.RoundTripper {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.parent = parent
+	return r
+}
+
+// Clients returns clients for the provider that replay the cassette, authenticated with a static token, as no tenant is needed.
+// Pass them to msgraph.NewWithClients
+func (r *Recorder) Clients() (*clients.Clients, error) {
+
+	credential := clients.StaticTokenCredential{Token: "cassette"}
+	scopes := []string{"https://graph.microsoft.com/.default"}
+
+	return clients.New(credential, scopes, r.Transport)
+
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.scrubber.redactTenant(req.Header.Get("Authorization"))
+
+	request := Request{
+		Method: req.Method,
+		Url:    r.scrubber.scrub(req.URL.String()),
+		Body:   r.scrubber.scrubBody(body),
+	}
+
+	if r.mode == Replay {
+		return r.replay(req, request)
+	}
+
+	if r.parent == nil {
+		return nil, errors.New("cassette: recording needs the transport of the client, see Transport")
+	}
+
+	resp, err := r.parent.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	response := Response{Status: resp.StatusCode, Body: r.scrubber.scrubBody(responseBody)}
+	for _, name := range recordedHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			if response.Headers == nil {
+				response.Headers = map[string]string{}
+			}
+			response.Headers[name] = r.scrubber.scrub(value)
+		}
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{Request: request, Response: response})
+
+	return resp, nil
+
+}
+
+// replay responds with the first interaction not yet replayed with the method and URL of the request
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.played[i] || interaction.Request.Method != request.Method || interaction.Request.Url != request.Url {
+			continue
+		}
+		r.played[i] = true
+
+		header := http.Header{}
+		for name, value := range interaction.Response.Headers {
+			header.Set(name, value)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("cassette %s has no interaction left for %s %s, record it again", r.path, request.Method, request.Url)
+
+}
+
+// Save writes the recorded interactions to the cassette. Nothing is written when replaying
+func (r *Recorder) Save() error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == Replay {
+		return nil
+	}
+
+	content, err := yaml.Marshal(r.cassette)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, content, 0o644)
+
+}
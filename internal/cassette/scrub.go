@@ -0,0 +1,136 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Placeholder for secrets removed from cassettes
+const redacted = "REDACTED"
+
+// Placeholder for the tenant ID, which is a GUID like the IDs it replaces, so it can still be used as one
+const tenantPlaceholder = "00000000-0000-0000-0000-000000000000"
+
+// Properties of request and response bodies whose values are secrets, compared ignoring case,
+// e.g. the password of a user's passwordProfile, or the secretText of a password credential
+var secretProperties = []string{
+	"access_token",
+	"clientSecret",
+	"id_token",
+	"password",
+	"refresh_token",
+	"secretText",
+}
+
+// Tokens, which the API returns in some properties and the SDK sends in the Authorization header
+var jwtPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+
+// scrubber removes secrets from what is recorded
+type scrubber struct {
+	replacements map[string]string // Placeholder by secret
+}
+
+// newScrubber returns a scrubber for the secrets of the tenant the provider is configured for, from the MSGRAPH_* environment variables.
+// The tenant ID isn't set when the provider authenticates with the Azure CLI, so it's also taken from the token of each request, see redactTenant
+func newScrubber() scrubber {
+	s := scrubber{replacements: map[string]string{}}
+	s.redact(os.Getenv("MSGRAPH_TENANT_ID"), tenantPlaceholder)
+	s.redact(os.Getenv("MSGRAPH_CLIENT_SECRET"), redacted)
+	return s
+}
+
+func (s *scrubber) redact(secret string, placeholder string) {
+	if secret != "" {
+		s.replacements[secret] = placeholder
+	}
+}
+
+// redactTenant redacts the tenant a request is sent to, from the 'tid' claim of the token in its Authorization header
+func (s *scrubber) redactTenant(authorization string) {
+
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return
+	}
+	var claims struct {
+		Tenant string `json:"tid"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return
+	}
+
+	s.redact(claims.Tenant, tenantPlaceholder)
+
+}
+
+func (s scrubber) scrub(value string) string {
+	for secret, placeholder := range s.replacements {
+		value = strings.ReplaceAll(value, secret, placeholder)
+	}
+	return jwtPattern.ReplaceAllString(value, redacted)
+}
+
+// scrubBody scrubs a body, and when it's JSON, redacts the values of the secret properties
+func (s scrubber) scrubBody(body []byte) string {
+
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return s.scrub(string(body))
+	}
+
+	scrubbed, err := json.Marshal(redactProperties(value))
+	if err != nil {
+		return s.scrub(string(body))
+	}
+
+	return s.scrub(string(scrubbed))
+
+}
+
+func redactProperties(value any) any {
+
+	switch v := value.(type) {
+	case map[string]any:
+		for name, property := range v {
+			if isSecretProperty(name) && property != nil {
+				v[name] = redacted
+			} else {
+				v[name] = redactProperties(property)
+			}
+		}
+	case []any:
+		for i, element := range v {
+			v[i] = redactProperties(element)
+		}
+	}
+
+	return value
+
+}
+
+func isSecretProperty(name string) bool {
+	for _, secret := range secretProperties {
+		if strings.EqualFold(name, secret) {
+			return true
+		}
+	}
+	return false
+}
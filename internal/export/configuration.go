@@ -0,0 +1,224 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// configuration returns the attributes to set in the configuration of an object, from its state, by name.
+// Its ID, read-only attributes and those that are null, empty or have their default value are left out
+func configuration(ctx context.Context, attributes map[string]schema.Attribute, state tftypes.Value, readOnly map[string]bool) (map[string]cty.Value, error) {
+	values, err := objectValues(ctx, attributes, state, readOnly, "")
+	delete(values, "id")
+	return values, err
+}
+
+// objectValues returns the attributes to set of an object at the path parent, e.g. 'settings.', which is empty for the resource itself
+func objectValues(ctx context.Context, attributes map[string]schema.Attribute, object tftypes.Value, readOnly map[string]bool, parent string) (map[string]cty.Value, error) {
+
+	var values map[string]tftypes.Value
+	if err := object.As(&values); err != nil {
+		return nil, err
+	}
+
+	result := map[string]cty.Value{}
+	for name, attribute := range attributes {
+		path := parent + name
+		if readOnly[path] {
+			continue
+		}
+
+		value, ok, err := attributeValue(ctx, attribute, values[name], readOnly, path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if ok {
+			result[name] = value
+		}
+	}
+
+	return result, nil
+
+}
+
+// attributeValue returns the value to set an attribute to, or false if it has to be left out of the configuration
+func attributeValue(ctx context.Context, attribute schema.Attribute, value tftypes.Value, readOnly map[string]bool, path string) (cty.Value, bool, error) {
+
+	if value.IsNull() || !value.IsKnown() {
+		return cty.NilVal, false, nil
+	}
+
+	isDefault, err := hasDefaultValue(ctx, attribute, value)
+	if err != nil || isDefault {
+		return cty.NilVal, false, err
+	}
+
+	switch attribute := attribute.(type) {
+
+	case schema.SingleNestedAttribute:
+		values, err := objectValues(ctx, attribute.Attributes, value, readOnly, path+".")
+		if err != nil || len(values) == 0 {
+			return cty.NilVal, false, err
+		}
+		return cty.ObjectVal(values), true, nil
+
+	case schema.ListNestedAttribute:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil || len(elements) == 0 {
+			return cty.NilVal, false, err
+		}
+		objects := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			values, err := objectValues(ctx, attribute.NestedObject.Attributes, element, readOnly, path+".")
+			if err != nil {
+				return cty.NilVal, false, err
+			}
+			objects = append(objects, cty.ObjectVal(values))
+		}
+		return cty.TupleVal(objects), true, nil
+
+	}
+
+	if value.Type().Is(tftypes.List{}) || value.Type().Is(tftypes.Set{}) {
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil || len(elements) == 0 {
+			return cty.NilVal, false, err
+		}
+	}
+
+	primitive, err := primitiveValue(value)
+	return primitive, err == nil, err
+
+}
+
+// primitiveValue converts a string, bool, number, or a list or set of them
+func primitiveValue(value tftypes.Value) (cty.Value, error) {
+
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	switch {
+
+	case value.Type().Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return cty.StringVal(s), err
+
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return cty.BoolVal(b), err
+
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		err := value.As(&n)
+		return cty.NumberVal(n), err
+
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		if len(elements) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		values := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			v, err := primitiveValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values = append(values, v)
+		}
+		return cty.TupleVal(values), nil
+
+	}
+
+	return cty.NilVal, fmt.Errorf("values of type %s aren't supported", value.Type())
+
+}
+
+// hasDefaultValue determines if the value of an attribute is its default value, which needn't be configured
+func hasDefaultValue(ctx context.Context, attribute schema.Attribute, value tftypes.Value) (bool, error) {
+
+	var defaultValue interface {
+		ToTerraformValue(context.Context) (tftypes.Value, error)
+	}
+
+	switch attribute := attribute.(type) {
+	case schema.StringAttribute:
+		if attribute.Default == nil {
+			return false, nil
+		}
+		var resp defaults.StringResponse
+		attribute.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+		defaultValue = resp.PlanValue
+	case schema.BoolAttribute:
+		if attribute.Default == nil {
+			return false, nil
+		}
+		var resp defaults.BoolResponse
+		attribute.Default.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
+		defaultValue = resp.PlanValue
+	case schema.Int64Attribute:
+		if attribute.Default == nil {
+			return false, nil
+		}
+		var resp defaults.Int64Response
+		attribute.Default.DefaultInt64(ctx, defaults.Int64Request{}, &resp)
+		defaultValue = resp.PlanValue
+	default:
+		return false, nil
+	}
+
+	tfDefault, err := defaultValue.ToTerraformValue(ctx)
+	if err != nil {
+		return false, err
+	}
+	return tfDefault.Equal(value), nil
+
+}
+
+// readOnlySet returns the paths of the read-only attributes of a resource as a set
+func readOnlySet(paths []string) map[string]bool {
+	set := map[string]bool{}
+	for _, path := range paths {
+		set[path] = true
+	}
+	return set
+}
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// resourceName returns the name of the resource block of an object, from its display name or else its ID, e.g. 'sales_team' for 'Sales Team'.
+// Names start with a letter, and are made unique with a number, e.g. 'sales_team_2'
+func resourceName(typeName string, id string, attributes map[string]cty.Value, names map[string]bool) string {
+
+	name := id
+	if displayName, ok := attributes["display_name"]; ok && displayName.Type() == cty.String && displayName.AsString() != "" {
+		name = displayName.AsString()
+	}
+
+	name = strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || !(name[0] >= 'a' && name[0] <= 'z') {
+		name = strings.TrimPrefix(typeName, "msgraph_") + "_" + name
+	}
+
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[unique] = true
+
+	return unique
+
+}
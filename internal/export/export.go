@@ -0,0 +1,240 @@
+// Package export writes the Terraform configuration of objects that already exist in a tenant, to bring them under management:
+// an import block for each object, and a resource block setting its attributes.
+//
+// Objects are listed and read by the resources of the provider, with their generated ListIds and Read,
+// so the configuration is what the provider reads after importing them. Attributes the API sets, and those that are null,
+// empty or have their default value, are left out, so the configuration only sets what was chosen for the object
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exportable is a resource whose objects can be listed, which the generator implements for those at the root of the API
+type exportable interface {
+	resource.ResourceWithConfigure
+	ListIds(ctx context.Context, filter string) ([]string, error)
+	ReadOnlyAttributes() []string
+}
+
+// Run runs 'terraform-provider-msgraph export' with its arguments, writing the configuration to stdout unless '-out' is given.
+// The provider is configured the same way as in Terraform, from the MSGRAPH_* environment variables or the Azure CLI
+func Run(ctx context.Context, newProvider func() provider.Provider, args []string, stdout io.Writer) error {
+
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: terraform-provider-msgraph export [-types msgraph_group,...] [-filter expression] [-out file]")
+		fmt.Fprintln(flags.Output(), "Writes import blocks and resource configuration for the existing objects of a tenant.")
+		flags.PrintDefaults()
+	}
+	types := flags.String("types", "", "Resource types to export, separated by commas, e.g. 'msgraph_group,msgraph_application'. Defaults to every type that can be exported")
+	filter := flags.String("filter", "", "OData $filter expression the objects have to match, e.g. \"startswith(displayName,'app-')\". Applies to every type")
+	out := flags.String("out", "", "File to write the configuration to, instead of stdout")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	exporter, err := New(ctx, newProvider())
+	if err != nil {
+		return err
+	}
+
+	typeNames := exporter.TypeNames()
+	if *types != "" {
+		typeNames = strings.Split(*types, ",")
+	}
+
+	file, err := exporter.Export(ctx, typeNames, *filter)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = stdout.Write(file.Bytes())
+		return err
+	}
+	return os.WriteFile(*out, file.Bytes(), 0o644)
+
+}
+
+// Exporter writes the configuration of the objects of the resources of a provider
+type Exporter struct {
+	resources map[string]exportable    // By type name, e.g. 'msgraph_group'
+	schemas   map[string]schema.Schema // By type name
+}
+
+// New configures the provider, with an empty configuration, and the resources whose objects can be exported
+func New(ctx context.Context, p provider.Provider) (*Exporter, error) {
+
+	var metadata provider.MetadataResponse
+	p.Metadata(ctx, provider.MetadataRequest{}, &metadata)
+
+	var providerSchema provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &providerSchema)
+	if err := diagnosticsError(providerSchema.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	var configured provider.ConfigureResponse
+	config := tfsdk.Config{Schema: providerSchema.Schema, Raw: nullObject(providerSchema.Schema.Type().TerraformType(ctx).(tftypes.Object))}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &configured)
+	if err := diagnosticsError(configured.Diagnostics); err != nil {
+		return nil, fmt.Errorf("configuring the provider: %w", err)
+	}
+
+	e := &Exporter{resources: map[string]exportable{}, schemas: map[string]schema.Schema{}}
+
+	for _, newResource := range p.Resources(ctx) {
+		r, ok := newResource().(exportable)
+		if !ok {
+			continue
+		}
+
+		var resourceMetadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: metadata.TypeName}, &resourceMetadata)
+
+		var resourceConfigured resource.ConfigureResponse
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: configured.ResourceData}, &resourceConfigured)
+
+		var resourceSchema resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+		if err := errors.Join(diagnosticsError(resourceConfigured.Diagnostics), diagnosticsError(resourceSchema.Diagnostics)); err != nil {
+			return nil, fmt.Errorf("%s: %w", resourceMetadata.TypeName, err)
+		}
+
+		e.resources[resourceMetadata.TypeName] = r
+		e.schemas[resourceMetadata.TypeName] = resourceSchema.Schema
+	}
+
+	return e, nil
+
+}
+
+// TypeNames returns the resource types whose objects can be exported, sorted
+func (e *Exporter) TypeNames() []string {
+	var typeNames []string
+	for typeName := range e.resources {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	return typeNames
+}
+
+// Export returns the configuration of the objects of the resource types that match filter, an OData $filter expression, or of all of them when it's empty
+func (e *Exporter) Export(ctx context.Context, typeNames []string, filter string) (*hclwrite.File, error) {
+
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	for _, typeName := range typeNames {
+
+		r, ok := e.resources[typeName]
+		if !ok {
+			return nil, fmt.Errorf("%s can't be exported. The types that can be are: %s", typeName, strings.Join(e.TypeNames(), ", "))
+		}
+
+		ids, err := r.ListIds(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", typeName, err)
+		}
+
+		names := map[string]bool{} // Names of the resource blocks already written, which have to be unique
+		for _, id := range ids {
+
+			state, err := e.read(ctx, typeName, id)
+			if err != nil {
+				return nil, fmt.Errorf("reading %s %s: %w", typeName, id, err)
+			}
+
+			attributes, err := configuration(ctx, e.schemas[typeName].Attributes, state, readOnlySet(r.ReadOnlyAttributes()))
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", typeName, id, err)
+			}
+
+			name := resourceName(typeName, id, attributes, names)
+
+			if len(body.Blocks()) > 0 {
+				body.AppendNewline()
+			}
+
+			importBlock := body.AppendNewBlock("import", nil).Body()
+			importBlock.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: typeName}, hcl.TraverseAttr{Name: name}})
+			importBlock.SetAttributeValue("id", cty.StringVal(id))
+
+			body.AppendNewline()
+
+			resourceBlock := body.AppendNewBlock("resource", []string{typeName, name}).Body()
+			attributeNames := make([]string, 0, len(attributes))
+			for attributeName := range attributes {
+				attributeNames = append(attributeNames, attributeName)
+			}
+			sort.Strings(attributeNames)
+			for _, attributeName := range attributeNames {
+				resourceBlock.SetAttributeValue(attributeName, attributes[attributeName])
+			}
+
+		}
+	}
+
+	return file, nil
+
+}
+
+// read reads an object with the Read of its resource, from a state where only its ID is known, as when it's imported
+func (e *Exporter) read(ctx context.Context, typeName string, id string) (tftypes.Value, error) {
+
+	resourceSchema := e.schemas[typeName]
+	objectType := resourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, id)
+
+	state := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(objectType, values)}
+	resp := resource.ReadResponse{State: tfsdk.State{Schema: resourceSchema, Raw: state.Raw.Copy()}}
+	e.resources[typeName].Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return resp.State.Raw, nil
+
+}
+
+// nullObject returns an object of the type whose attributes are all null
+func nullObject(objectType tftypes.Object) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// diagnosticsError returns the errors of diagnostics as an error, or nil when there are none
+func diagnosticsError(diagnostics diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diagnostics.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,90 @@
+package export_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-msgraph/internal/export"
+	"terraform-provider-msgraph/internal/graphfake"
+	"terraform-provider-msgraph/msgraph"
+)
+
+func TestExport(t *testing.T) {
+
+	server := graphfake.NewServer()
+	defer server.Close()
+	server.PageSize = 1 // So the groups are listed in pages
+
+	server.Add("groups", map[string]any{
+		"id":              "00000000-0000-0000-0000-000000000001",
+		"displayName":     "Sales Team",
+		"mailNickname":    "sales",
+		"mailEnabled":     false,
+		"securityEnabled": true,
+		"createdDateTime": "2024-01-01T00:00:00Z",
+	})
+	server.Add("groups", map[string]any{
+		"id":              "00000000-0000-0000-0000-000000000002",
+		"displayName":     "Sales Team",
+		"mailNickname":    "sales2",
+		"mailEnabled":     false,
+		"securityEnabled": true,
+	})
+	server.Add("groups", map[string]any{
+		"id":              "00000000-0000-0000-0000-000000000003",
+		"displayName":     "Marketing",
+		"mailNickname":    "marketing",
+		"mailEnabled":     true,
+		"securityEnabled": false,
+	})
+
+	clients, err := server.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	exporter, err := export.New(ctx, msgraph.NewWithClients("test", clients)())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := exporter.Export(ctx, []string{"msgraph_group"}, "securityEnabled eq true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The second group has the same name as the first, the third doesn't match the filter, and created_date_time is read-only
+	want := `import {
+  to = msgraph_group.sales_team
+  id = "00000000-0000-0000-0000-000000000001"
+}
+
+resource "msgraph_group" "sales_team" {
+  display_name     = "Sales Team"
+  mail_enabled     = false
+  mail_nickname    = "sales"
+  security_enabled = true
+}
+
+import {
+  to = msgraph_group.sales_team_2
+  id = "00000000-0000-0000-0000-000000000002"
+}
+
+resource "msgraph_group" "sales_team_2" {
+  display_name     = "Sales Team"
+  mail_enabled     = false
+  mail_nickname    = "sales2"
+  security_enabled = true
+}
+`
+	if got := string(file.Bytes()); got != want {
+		t.Errorf("got configuration\n%s\nwant\n%s", got, want)
+	}
+
+	if _, err := exporter.Export(ctx, []string{"msgraph_site"}, ""); err == nil || !strings.Contains(err.Error(), "msgraph_site can't be exported") {
+		t.Errorf("got error %v exporting sites, which only have a data source, want that they can't be exported", err)
+	}
+
+}
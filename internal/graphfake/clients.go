@@ -0,0 +1,51 @@
+package graphfake
+
+import (
+	"net/url"
+
+	azauth "github.com/microsoft/kiota-authentication-azure-go"
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
+
+	"terraform-provider-msgraph/msgraph/clients"
+)
+
+// The token every request to the server has to be authenticated with
+const token = "graphfake"
+
+// Clients returns clients for the provider that call the server instead of the MS Graph API, authenticated with a static token.
+// Pass them to msgraph.NewWithClients
+func (s *Server) Clients() (*clients.Clients, error) {
+
+	serverUrl, err := url.Parse(s.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := clients.StaticTokenCredential{Token: token}
+	scopes := []string{"https://graph.microsoft.com/.default"}
+
+	// The SDK only authenticates requests to the hosts it is given, over https unless the host is localhost, which the server listens on
+	auth, err := azauth.NewAzureIdentityAuthenticationProviderWithScopesAndValidHosts(credential, scopes, []string{serverUrl.Hostname()})
+	if err != nil {
+		return nil, err
+	}
+
+	v1Adapter, err := msgraphsdk.NewGraphRequestAdapter(auth)
+	if err != nil {
+		return nil, err
+	}
+	v1Adapter.SetBaseUrl(s.URL + "/v1.0")
+
+	betaAdapter, err := msgraphbetasdk.NewGraphRequestAdapter(auth)
+	if err != nil {
+		return nil, err
+	}
+	betaAdapter.SetBaseUrl(s.URL + "/beta")
+
+	return &clients.Clients{
+		V1:   msgraphsdk.NewGraphServiceClient(v1Adapter),
+		Beta: betaAdapter,
+	}, nil
+
+}
@@ -0,0 +1,153 @@
+package graphfake
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// filter is a parsed $filter, the comparisons of which all have to match an object.
+// Only the basics of OData filters are supported: 'eq', 'ne' and 'startswith()', joined by 'and'.
+// Strings are compared ignoring case, as the API does for directory objects
+type filter []comparison
+
+type comparison struct {
+	Property string // Path of the property, e.g. 'displayName' or 'passwordProfile/forceChangePasswordNextSignIn'
+	Operator string // 'eq', 'ne' or 'startswith'
+	Value    any    // A string, bool, float64 or nil
+}
+
+func parseFilter(expression string) (filter, error) {
+
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+
+	var f filter
+	for _, clause := range splitAnd(expression) {
+		c, err := parseComparison(strings.TrimSpace(clause))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid filter clause: %w", err)
+		}
+		f = append(f, c)
+	}
+
+	return f, nil
+
+}
+
+// splitAnd splits an expression on 'and', outside of quoted strings
+func splitAnd(expression string) []string {
+
+	var clauses []string
+	quoted := false
+	start := 0
+
+	for i := 0; i < len(expression); i++ {
+		switch {
+		case expression[i] == '\'':
+			quoted = !quoted
+		case !quoted && strings.HasPrefix(strings.ToLower(expression[i:]), " and "):
+			clauses = append(clauses, expression[start:i])
+			start = i + len(" and ")
+			i = start - 1
+		}
+	}
+
+	return append(clauses, expression[start:])
+
+}
+
+func parseComparison(clause string) (comparison, error) {
+
+	if rest, ok := strings.CutPrefix(strings.ToLower(clause), "startswith("); ok && strings.HasSuffix(rest, ")") {
+		arguments := clause[len("startswith(") : len(clause)-1]
+		property, value, ok := strings.Cut(arguments, ",")
+		if !ok {
+			return comparison{}, fmt.Errorf("startswith needs a property and a value: '%s'", clause)
+		}
+		literal, err := parseLiteral(strings.TrimSpace(value))
+		if err != nil {
+			return comparison{}, err
+		}
+		if _, ok := literal.(string); !ok {
+			return comparison{}, fmt.Errorf("startswith needs a string: '%s'", clause)
+		}
+		return comparison{Property: strings.TrimSpace(property), Operator: "startswith", Value: literal}, nil
+	}
+
+	property, rest, _ := strings.Cut(clause, " ")
+	operator, value, _ := strings.Cut(strings.TrimSpace(rest), " ")
+	operator = strings.ToLower(operator)
+	if property == "" || (operator != "eq" && operator != "ne") {
+		return comparison{}, fmt.Errorf("unsupported expression: '%s'", clause)
+	}
+
+	literal, err := parseLiteral(strings.TrimSpace(value))
+	if err != nil {
+		return comparison{}, err
+	}
+
+	return comparison{Property: property, Operator: operator, Value: literal}, nil
+
+}
+
+func parseLiteral(literal string) (any, error) {
+
+	switch {
+	case len(literal) >= 2 && strings.HasPrefix(literal, "'") && strings.HasSuffix(literal, "'"):
+		return strings.ReplaceAll(literal[1:len(literal)-1], "''", "'"), nil
+	case literal == "true" || literal == "false":
+		return literal == "true", nil
+	case literal == "null":
+		return nil, nil
+	}
+
+	number, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported value: '%s'", literal)
+	}
+	return number, nil
+
+}
+
+func (f filter) matches(object map[string]any) bool {
+	for _, c := range f {
+		if !c.matches(object) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c comparison) matches(object map[string]any) bool {
+
+	var value any = object
+	for _, name := range strings.Split(c.Property, "/") {
+		properties, ok := value.(map[string]any)
+		if !ok {
+			value = nil
+			break
+		}
+		value = properties[name]
+	}
+
+	switch c.Operator {
+	case "startswith":
+		s, ok := value.(string)
+		return ok && strings.HasPrefix(strings.ToLower(s), strings.ToLower(c.Value.(string)))
+	case "ne":
+		return !equal(value, c.Value)
+	default:
+		return equal(value, c.Value)
+	}
+
+}
+
+func equal(value any, literal any) bool {
+	if s, ok := value.(string); ok {
+		l, ok := literal.(string)
+		return ok && strings.EqualFold(s, l)
+	}
+	return value == literal
+}
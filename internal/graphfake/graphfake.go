@@ -0,0 +1,441 @@
+// Package graphfake is an in-memory stand-in for the MS Graph API, so acceptance tests can run without a tenant.
+//
+// It serves the collections the provider manages (users, groups, applications, service principals, devices, teams and sites)
+// and the collections nested in their objects, e.g. /teams/{team-id}/channels. Objects are stored as the JSON they are
+// created with, so it knows nothing of their schema: what is created is what is read back.
+// It implements the parts of the API the generated resources and data sources use:
+//   - POST to a collection creates an object with a new id. Teams are created asynchronously, as the API does: the response is
+//     202 Accepted, with no body, and the location of the team and of the operation creating it in its headers
+//   - Users can also be referred to by their userPrincipalName instead of their id, e.g. /users/adele@contoso.com
+//   - GET of an object or a collection, with $select, and for collections $filter, $top and paging with @odata.nextLink
+//   - PATCH of an object sets the properties of the request, and removes those set to null
+//   - DELETE of an object removes it, and the objects nested in it. Deleted users, groups and applications are moved to
+//     /directory/deletedItems, after DeletedItemsDelay, from which DELETE removes them for good
+//   - 404 with a Request_ResourceNotFound error when an object doesn't exist
+package graphfake
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The collections at the root of the API, and whether objects can be created in them.
+// Sites can't be created through the API, so tests add them with Add
+var rootCollections = map[string]bool{
+	"applications":      true,
+	"devices":           true,
+	"groups":            true,
+	"servicePrincipals": true,
+	"sites":             false,
+	"teams":             true,
+	"users":             true,
+}
+
+// The collections whose objects the API creates asynchronously, responding with their location rather than the object
+var asyncCollections = map[string]bool{
+	"teams": true,
+}
+
+// The collections whose objects are kept in /directory/deletedItems when they're deleted, as the directory does for 30 days
+var softDeletedCollections = map[string]bool{
+	"applications": true,
+	"groups":       true,
+	"users":        true,
+}
+
+// Number of objects in each page of a collection, unless $top asks for fewer. The API's default for most collections
+const defaultPageSize = 100
+
+// Server is an MS Graph API serving objects from memory, over an httptest.Server
+type Server struct {
+	*httptest.Server
+
+	// Number of objects in each page of a collection, unless $top asks for fewer
+	PageSize int
+
+	// How long deleted objects take to show up in /directory/deletedItems, which can be a few seconds in the API
+	DeletedItemsDelay time.Duration
+
+	mu       sync.Mutex
+	objects  map[string]map[string]any // By path, e.g. 'users/00000000-0000-0000-0000-000000000000'
+	sequence map[string]int            // Order objects were created in, by path, which is the order collections are listed in
+	created  int
+	deleted  []deletedItem // Deleted objects that haven't shown up in /directory/deletedItems yet
+}
+
+// A deleted object, and when it shows up in /directory/deletedItems
+type deletedItem struct {
+	path    string
+	object  map[string]any
+	visible time.Time
+}
+
+// NewServer starts a server, which is stopped with Close
+func NewServer() *Server {
+	s := &Server{
+		PageSize: defaultPageSize,
+		objects:  map[string]map[string]any{},
+		sequence: map[string]int{},
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Add stores an object in a collection, e.g. 'sites', as if it was created through the API, and returns its id.
+// The object keeps its id when it has one
+func (s *Server) Add(collection string, object map[string]any) string {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object = maps.Clone(object)
+	if _, ok := object["id"].(string); !ok {
+		object["id"] = uuid.NewString()
+	}
+	s.store(strings.Trim(collection, "/")+"/"+object["id"].(string), object)
+
+	return object["id"].(string)
+
+}
+
+// Get returns the object at a path, e.g. 'users/00000000-0000-0000-0000-000000000000'
+// or 'directory/deletedItems/00000000-0000-0000-0000-000000000000', so tests can check what the provider did
+func (s *Server) Get(path string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.showDeletedItems()
+	object, ok := s.objects[strings.TrimPrefix(strings.Trim(path, "/"), "directory/")]
+	return maps.Clone(object), ok
+}
+
+// showDeletedItems stores the deleted objects whose delay has passed in /directory/deletedItems
+func (s *Server) showDeletedItems() {
+	var pending []deletedItem
+	for _, item := range s.deleted {
+		if time.Now().Before(item.visible) {
+			pending = append(pending, item)
+		} else {
+			s.store(item.path, item.object)
+		}
+	}
+	s.deleted = pending
+}
+
+func (s *Server) store(path string, object map[string]any) {
+	if _, ok := s.sequence[path]; !ok {
+		s.created++
+		s.sequence[path] = s.created
+	}
+	s.objects[path] = object
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Header.Get("Authorization") != "Bearer "+token {
+		writeError(w, http.StatusUnauthorized, "InvalidAuthenticationToken", "Access token is empty or invalid.")
+		return
+	}
+
+	path := strings.Trim(r.URL.Path, "/")
+	version, path, _ := strings.Cut(path, "/")
+	if version != "v1.0" && version != "beta" {
+		writeError(w, http.StatusNotFound, "BadRequest", fmt.Sprintf("Invalid version: %s", version))
+		return
+	}
+
+	segments := strings.Split(path, "/")
+
+	// Deleted objects are stored as if deletedItems was a collection at the root, in which objects can't be created
+	deletedItems := len(segments) > 1 && segments[0] == "directory" && segments[1] == "deletedItems"
+	if deletedItems {
+		segments = segments[1:]
+		path = strings.Join(segments, "/")
+	}
+
+	if _, ok := rootCollections[segments[0]]; (!ok && !deletedItems) || slices.Contains(segments, "") {
+		writeError(w, http.StatusBadRequest, "BadRequest", fmt.Sprintf("Resource not found for the segment '%s'.", segments[0]))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.showDeletedItems()
+
+	// Users can be referred to by their userPrincipalName, which contains '@', where the API otherwise expects an id
+	if len(segments) > 1 && segments[0] == "users" && strings.Contains(segments[1], "@") {
+		if id, ok := s.userId(segments[1]); ok {
+			segments[1] = id
+			path = strings.Join(segments, "/")
+		}
+	}
+
+	// Paths with an odd number of segments are collections, e.g. 'teams/{team-id}/channels', and the others objects
+	if len(segments)%2 == 1 {
+		parent := strings.Join(segments[:len(segments)-1], "/")
+		if _, ok := s.objects[parent]; parent != "" && !ok {
+			writeNotFound(w, parent)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			s.list(w, r, path)
+		case http.MethodPost:
+			s.create(w, r, path)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Request_BadRequest", fmt.Sprintf("The method %s is not supported for a collection.", r.Method))
+		}
+		return
+	}
+
+	object, ok := s.objects[path]
+	if !ok {
+		writeNotFound(w, path)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		selected, err := selectProperties(object, r.URL.Query().Get("$select"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Request_BadRequest", err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, selected)
+	case http.MethodPatch:
+		s.update(w, r, path, object)
+	case http.MethodDelete:
+		for p := range s.objects {
+			if p == path || strings.HasPrefix(p, path+"/") {
+				delete(s.objects, p)
+				delete(s.sequence, p)
+			}
+		}
+		if len(segments) == 2 && softDeletedCollections[segments[0]] {
+			s.deleted = append(s.deleted, deletedItem{path: "deletedItems/" + segments[1], object: object, visible: time.Now().Add(s.DeletedItemsDelay)})
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Request_BadRequest", fmt.Sprintf("The method %s is not supported for an object.", r.Method))
+	}
+
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, collection string) {
+
+	query := r.URL.Query()
+
+	filter, err := parseFilter(query.Get("$filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Request_UnsupportedQuery", err.Error())
+		return
+	}
+
+	pageSize := s.PageSize
+	if top := query.Get("$top"); top != "" {
+		pageSize, err = strconv.Atoi(top)
+		if err != nil || pageSize < 1 || pageSize > 999 {
+			writeError(w, http.StatusBadRequest, "Request_BadRequest", fmt.Sprintf("Invalid page size specified: '%s'. Must be between 1 and 999 inclusive.", top))
+			return
+		}
+	}
+
+	skip := 0
+	if token := query.Get("$skiptoken"); token != "" {
+		skip, err = strconv.Atoi(token)
+		if err != nil || skip < 0 {
+			writeError(w, http.StatusBadRequest, "Request_BadRequest", "Invalid $skiptoken.")
+			return
+		}
+	}
+
+	var paths []string
+	for p := range s.objects {
+		if parent, id, _ := cutLast(p); parent == collection && id != "" {
+			paths = append(paths, p)
+		}
+	}
+	slices.SortFunc(paths, func(a, b string) int { return s.sequence[a] - s.sequence[b] })
+
+	var matching []map[string]any
+	for _, p := range paths {
+		if filter.matches(s.objects[p]) {
+			matching = append(matching, s.objects[p])
+		}
+	}
+
+	response := map[string]any{}
+	value := []map[string]any{}
+	for _, object := range matching[min(skip, len(matching)):min(skip+pageSize, len(matching))] {
+		selected, err := selectProperties(object, query.Get("$select"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Request_BadRequest", err.Error())
+			return
+		}
+		value = append(value, selected)
+	}
+	response["value"] = value
+
+	if skip+pageSize < len(matching) {
+		query.Set("$skiptoken", strconv.Itoa(skip+pageSize))
+		next := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path, RawQuery: query.Encode()}
+		response["@odata.nextLink"] = next.String()
+	}
+
+	writeJSON(w, http.StatusOK, response)
+
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, collection string) {
+
+	if root, _, _ := strings.Cut(collection, "/"); collection == root && !rootCollections[root] {
+		writeError(w, http.StatusMethodNotAllowed, "Request_BadRequest", fmt.Sprintf("Objects can't be created in '%s'.", collection))
+		return
+	}
+
+	object, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	id := uuid.NewString()
+	object["id"] = id
+	s.store(collection+"/"+id, object)
+
+	if asyncCollections[collection] {
+		location := fmt.Sprintf("/%s('%s')", collection, id)
+		w.Header().Set("Content-Location", location)
+		w.Header().Set("Location", fmt.Sprintf("%s/operations('%s')", location, uuid.NewString()))
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, object)
+
+}
+
+// userId finds the id of the user with a userPrincipalName, which the API compares ignoring case
+func (s *Server) userId(userPrincipalName string) (string, bool) {
+	for p, object := range s.objects {
+		if parent, id, _ := cutLast(p); parent == "users" {
+			if upn, ok := object["userPrincipalName"].(string); ok && strings.EqualFold(upn, userPrincipalName) {
+				return id, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, path string, object map[string]any) {
+
+	changes, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	updated := maps.Clone(object)
+	for name, value := range changes {
+		if name == "id" {
+			continue
+		}
+		if value == nil {
+			delete(updated, name)
+		} else {
+			updated[name] = value
+		}
+	}
+	s.store(path, updated)
+
+	w.WriteHeader(http.StatusNoContent)
+
+}
+
+// readObject decodes the JSON object in the body of a request, which the SDK compresses with gzip
+func readObject(r *http.Request) (map[string]any, error) {
+
+	body := r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		body = reader
+	}
+
+	var object map[string]any
+	if err := json.NewDecoder(body).Decode(&object); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("Invalid JSON in the request body: %w", err)
+	}
+	if object == nil {
+		return nil, fmt.Errorf("The request body must be a JSON object")
+	}
+
+	return object, nil
+
+}
+
+// selectProperties returns the properties of an object named by $select, and its id, which the API always returns.
+// Every property is returned without $select
+func selectProperties(object map[string]any, selection string) (map[string]any, error) {
+
+	if selection == "" {
+		return object, nil
+	}
+
+	selected := map[string]any{"id": object["id"]}
+	for _, name := range strings.Split(selection, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("Invalid $select: '%s'", selection)
+		}
+		if value, ok := object[name]; ok {
+			selected[name] = value
+		}
+	}
+
+	return selected, nil
+
+}
+
+func cutLast(path string) (string, string, bool) {
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return "", path, false
+	}
+	return path[:i], path[i+1:], true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error the way the API does, which the SDK returns as an ODataError
+func writeError(w http.ResponseWriter, status int, code string, message string) {
+	writeJSON(w, status, map[string]any{
+		"error": map[string]any{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, path string) {
+	_, id, _ := cutLast(path)
+	writeError(w, http.StatusNotFound, "Request_ResourceNotFound", fmt.Sprintf("Resource '%s' does not exist or one of its queried reference-property objects are not present.", id))
+}
@@ -0,0 +1,304 @@
+package graphfake_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/groups"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/models/odataerrors"
+	"github.com/microsoftgraph/msgraph-sdk-go/teams"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"terraform-provider-msgraph/internal/graphfake"
+	"terraform-provider-msgraph/msgraph/clients"
+)
+
+func TestServer(t *testing.T) {
+
+	server := graphfake.NewServer()
+	defer server.Close()
+
+	clients, err := server.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := clients.V1
+	ctx := context.Background()
+
+	// Create
+	user := models.NewUser()
+	user.SetDisplayName(ptr("Example"))
+	user.SetMailNickname(ptr("example"))
+	user.SetAccountEnabled(ptr(true))
+	created, err := client.Users().Post(ctx, user, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.GetId()
+	if id == nil || *id == "" {
+		t.Fatal("created user has no id")
+	}
+
+	// Read with $select
+	got, err := client.Users().ByUserId(*id).Get(ctx, &users.UserItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: &users.UserItemRequestBuilderGetQueryParameters{Select: []string{"displayName"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got.GetId() != *id || *got.GetDisplayName() != "Example" || got.GetMailNickname() != nil {
+		t.Errorf("got user %s %s %v, want %s Example with only displayName selected", *got.GetId(), *got.GetDisplayName(), got.GetMailNickname(), *id)
+	}
+
+	// Update, removing a property set to null
+	update := models.NewUser()
+	update.SetDisplayName(ptr("Example updated"))
+	update.GetBackingStore().Set("mailNickname", nil)
+	if _, err := client.Users().ByUserId(*id).Patch(ctx, update, nil); err != nil {
+		t.Fatal(err)
+	}
+	stored, _ := server.Get("users/" + *id)
+	if stored["displayName"] != "Example updated" || stored["accountEnabled"] != true {
+		t.Errorf("got %v after update, want displayName updated and accountEnabled kept", stored)
+	}
+	if _, ok := stored["mailNickname"]; ok {
+		t.Errorf("got %v after update, want mailNickname removed", stored)
+	}
+
+	// Delete, after which the user isn't found
+	if err := client.Users().ByUserId(*id).Delete(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Users().ByUserId(*id).Get(ctx, nil)
+	var odataError *odataerrors.ODataError
+	if !errors.As(err, &odataError) || odataError.ResponseStatusCode != http.StatusNotFound || *odataError.GetErrorEscaped().GetCode() != "Request_ResourceNotFound" {
+		t.Errorf("got error %v reading a deleted user, want Request_ResourceNotFound", err)
+	}
+
+}
+
+func TestServerBeta(t *testing.T) {
+
+	server := graphfake.NewServer()
+	defer server.Close()
+
+	clients, err := server.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(clients.Beta.GetBaseUrl(), "/beta") {
+		t.Fatalf("got beta base URL %s, want the server's beta API", clients.Beta.GetBaseUrl())
+	}
+
+	// msgraph_beta_* types wrap the adapter in a msgraph-beta-sdk-go client
+	client := msgraphbetasdk.NewGraphServiceClient(clients.Beta)
+	id := server.Add("users", map[string]any{"displayName": "Example"})
+	got, err := client.Users().ByUserId(id).Get(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got.GetDisplayName() != "Example" {
+		t.Errorf("got user %s from the beta API, want Example", *got.GetDisplayName())
+	}
+
+}
+
+func TestServerList(t *testing.T) {
+
+	server := graphfake.NewServer()
+	defer server.Close()
+	server.PageSize = 2
+
+	for i := range 5 {
+		server.Add("groups", map[string]any{"displayName": fmt.Sprintf("Group %d", i), "securityEnabled": i%2 == 0})
+	}
+	server.Add("groups", map[string]any{"displayName": "Other", "securityEnabled": true})
+
+	clients, err := server.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := clients.V1
+	ctx := context.Background()
+
+	tests := []struct {
+		filter string
+		top    int32
+		want   []string
+	}{
+		{filter: "", want: []string{"Group 0", "Group 1", "Group 2", "Group 3", "Group 4", "Other"}},
+		{filter: "startswith(displayName,'group')", want: []string{"Group 0", "Group 1", "Group 2", "Group 3", "Group 4"}},
+		{filter: "startswith(displayName,'Group') and securityEnabled eq true", want: []string{"Group 0", "Group 2", "Group 4"}},
+		{filter: "displayName eq 'other'", want: []string{"Other"}},
+		{filter: "displayName ne 'Other' and securityEnabled eq false", want: []string{"Group 1", "Group 3"}},
+		{filter: "displayName eq 'Group 1'", top: 1, want: []string{"Group 1"}},
+		{filter: "", top: 4, want: []string{"Group 0", "Group 1", "Group 2", "Group 3", "Group 4", "Other"}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%q top %d", test.filter, test.top), func(t *testing.T) {
+
+			parameters := &groups.GroupsRequestBuilderGetQueryParameters{Select: []string{"displayName"}}
+			if test.filter != "" {
+				parameters.Filter = &test.filter
+			}
+			if test.top != 0 {
+				parameters.Top = &test.top
+			}
+
+			// Follow @odata.nextLink through every page
+			var names []string
+			page, err := client.Groups().Get(ctx, &groups.GroupsRequestBuilderGetRequestConfiguration{QueryParameters: parameters})
+			for err == nil {
+				for _, group := range page.GetValue() {
+					names = append(names, *group.GetDisplayName())
+				}
+				if page.GetOdataNextLink() == nil {
+					break
+				}
+				page, err = client.Groups().WithUrl(*page.GetOdataNextLink()).Get(ctx, nil)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if fmt.Sprint(names) != fmt.Sprint(test.want) {
+				t.Errorf("got %v, want %v", names, test.want)
+			}
+
+		})
+	}
+
+	_, err = client.Groups().Get(ctx, &groups.GroupsRequestBuilderGetRequestConfiguration{QueryParameters: &groups.GroupsRequestBuilderGetQueryParameters{Filter: ptr("displayName gt 'A'")}})
+	var odataError *odataerrors.ODataError
+	if !errors.As(err, &odataError) || odataError.ResponseStatusCode != http.StatusBadRequest {
+		t.Errorf("got error %v for an unsupported filter, want a bad request", err)
+	}
+
+}
+
+func TestServerNested(t *testing.T) {
+
+	server := graphfake.NewServer()
+	defer server.Close()
+
+	graphClients, err := server.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := graphClients.V1
+	ctx := context.Background()
+
+	// Sites can't be created through the API, so are added
+	siteId := server.Add("sites", map[string]any{"displayName": "Example"})
+	if site, err := client.Sites().BySiteId(siteId).Get(ctx, nil); err != nil || *site.GetDisplayName() != "Example" {
+		t.Errorf("got site %v, error %v, want the added site", site, err)
+	}
+
+	// Teams are created asynchronously, so the response only has their location
+	createdTeam := clients.NewCreatedObject()
+	team, err := client.Teams().Post(ctx, models.NewTeam(), &teams.TeamsRequestBuilderPostRequestConfiguration{Options: createdTeam.Options()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if team != nil {
+		t.Errorf("got team %v in the response, want none", team)
+	}
+	teamId, err := createdTeam.Id(team)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := server.Get("teams/" + teamId); !ok {
+		t.Errorf("team %s from the location in the response doesn't exist", teamId)
+	}
+
+	channel := models.NewChannel()
+	channel.SetDisplayName(ptr("General"))
+	created, err := client.Teams().ByTeamId(teamId).Channels().Post(ctx, channel, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	channels, err := client.Teams().ByTeamId(teamId).Channels().Get(ctx, nil)
+	if err != nil || len(channels.GetValue()) != 1 || *channels.GetValue()[0].GetId() != *created.GetId() {
+		t.Errorf("got channels %v, error %v, want the created channel", channels, err)
+	}
+
+	// Deleting the team deletes its channels, and objects can't be created in a team that doesn't exist
+	if err := client.Teams().ByTeamId(teamId).Delete(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := server.Get("teams/" + teamId + "/channels/" + *created.GetId()); ok {
+		t.Error("channel of a deleted team still exists")
+	}
+	if _, err := client.Teams().ByTeamId(teamId).Channels().Post(ctx, channel, nil); err == nil {
+		t.Error("created a channel in a deleted team, want an error")
+	}
+
+}
+
+func TestServerUserPrincipalName(t *testing.T) {
+
+	server := graphfake.NewServer()
+	defer server.Close()
+
+	clients, err := server.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := clients.V1
+	ctx := context.Background()
+
+	id := server.Add("users", map[string]any{"displayName": "Example", "userPrincipalName": "example@contoso.com"})
+
+	// Users are found by their userPrincipalName like by their id, ignoring its case
+	got, err := client.Users().ByUserId("Example@contoso.com").Get(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got.GetId() != id {
+		t.Errorf("got user %s, want %s", *got.GetId(), id)
+	}
+
+	update := models.NewUser()
+	update.SetDisplayName(ptr("Example updated"))
+	if _, err := client.Users().ByUserId("example@contoso.com").Patch(ctx, update, nil); err != nil {
+		t.Fatal(err)
+	}
+	if stored, _ := server.Get("users/" + id); stored["displayName"] != "Example updated" {
+		t.Errorf("got %v after update, want displayName updated", stored)
+	}
+
+	_, err = client.Users().ByUserId("unknown@contoso.com").Get(ctx, nil)
+	var odataError *odataerrors.ODataError
+	if !errors.As(err, &odataError) || odataError.ResponseStatusCode != http.StatusNotFound {
+		t.Errorf("got error %v reading an unknown user, want Request_ResourceNotFound", err)
+	}
+
+}
+
+func TestServerUnauthenticated(t *testing.T) {
+
+	server := graphfake.NewServer()
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/v1.0/users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("got status %d without a token, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
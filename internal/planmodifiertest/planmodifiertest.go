@@ -0,0 +1,203 @@
+// Package planmodifiertest tests the plan modifiers of every attribute type with the same cases,
+// calling them through the planmodifier interface of the type of their values
+package planmodifiertest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Values of the attribute type the plan modifier is for, e.g. types.String
+type Values[T attr.Value] struct {
+	Null       T
+	Unknown    T
+	State      T // Known value of the attribute in state
+	Configured T // Known value of the attribute in the configuration, different from State
+}
+
+type testCase[T attr.Value] struct {
+	existingState bool
+	stateValue    T
+	configValue   T
+	planValue     T
+	want          T
+}
+
+// UseStateForUnconfigured tests a plan modifier keeping the value in state of attributes that aren't configured
+func UseStateForUnconfigured[T attr.Value](t *testing.T, modifier any, values Values[T]) {
+	run(t, modifier, values, map[string]testCase[T]{
+		"create, unconfigured": {
+			stateValue:  values.Null,
+			configValue: values.Null,
+			planValue:   values.Unknown,
+			want:        values.Unknown,
+		},
+		"create, configured": {
+			stateValue:  values.Null,
+			configValue: values.Configured,
+			planValue:   values.Configured,
+			want:        values.Configured,
+		},
+		"update, configured": {
+			existingState: true,
+			stateValue:    values.State,
+			configValue:   values.Configured,
+			planValue:     values.Configured,
+			want:          values.Configured,
+		},
+		"update, unconfigured": {
+			existingState: true,
+			stateValue:    values.State,
+			configValue:   values.Null,
+			planValue:     values.Unknown,
+			want:          values.State,
+		},
+		"update, unconfigured and null in state": {
+			existingState: true,
+			stateValue:    values.Null,
+			configValue:   values.Null,
+			planValue:     values.Unknown,
+			want:          values.Null,
+		},
+		"update, unconfigured and unknown in state": {
+			existingState: true,
+			stateValue:    values.Unknown,
+			configValue:   values.Null,
+			planValue:     values.Unknown,
+			want:          values.Unknown,
+		},
+		"update, configured with an unknown value": {
+			existingState: true,
+			stateValue:    values.State,
+			configValue:   values.Unknown,
+			planValue:     values.Unknown,
+			want:          values.Unknown,
+		},
+	})
+}
+
+// NullIfUnconfigured tests a plan modifier clearing attributes removed from the configuration of an existing resource
+func NullIfUnconfigured[T attr.Value](t *testing.T, modifier any, values Values[T]) {
+	run(t, modifier, values, map[string]testCase[T]{
+		"create, unconfigured": {
+			stateValue:  values.Null,
+			configValue: values.Null,
+			planValue:   values.Unknown,
+			want:        values.Unknown,
+		},
+		"update, configured": {
+			existingState: true,
+			stateValue:    values.State,
+			configValue:   values.Configured,
+			planValue:     values.Configured,
+			want:          values.Configured,
+		},
+		"update, unconfigured": {
+			existingState: true,
+			stateValue:    values.State,
+			configValue:   values.Null,
+			planValue:     values.Unknown,
+			want:          values.Null,
+		},
+		"update, configured with an unknown value": {
+			existingState: true,
+			stateValue:    values.State,
+			configValue:   values.Unknown,
+			planValue:     values.Unknown,
+			want:          values.Unknown,
+		},
+	})
+}
+
+func run[T attr.Value](t *testing.T, modifier any, values Values[T], tests map[string]testCase[T]) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	// The modifiers only check whether there is a state, so the resource has a single attribute
+	attributeType := values.Null.Type(ctx).TerraformType(ctx)
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"attribute": attributeType}}
+	noState := tfsdk.State{Raw: tftypes.NewValue(stateType, nil)}
+	existingState := tfsdk.State{Raw: tftypes.NewValue(stateType, map[string]tftypes.Value{"attribute": tftypes.NewValue(attributeType, nil)})}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+
+			state := noState
+			if test.existingState {
+				state = existingState
+			}
+
+			planValue, diags := modify(ctx, modifier, state, test.stateValue, test.configValue, test.planValue)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !planValue.Equal(test.want) {
+				t.Errorf("got plan value %s, want %s", planValue, test.want)
+			}
+
+		})
+	}
+
+}
+
+// modify calls the plan modifier with a request of the type of its values, and returns the value it planned
+func modify(ctx context.Context, modifier any, state tfsdk.State, stateValue attr.Value, configValue attr.Value, planValue attr.Value) (attr.Value, diag.Diagnostics) {
+
+	switch m := modifier.(type) {
+	case planmodifier.Bool:
+		req := planmodifier.BoolRequest{State: state, StateValue: stateValue.(types.Bool), ConfigValue: configValue.(types.Bool), PlanValue: planValue.(types.Bool)}
+		resp := &planmodifier.BoolResponse{PlanValue: req.PlanValue}
+		m.PlanModifyBool(ctx, req, resp)
+		return resp.PlanValue, resp.Diagnostics
+	case planmodifier.Float64:
+		req := planmodifier.Float64Request{State: state, StateValue: stateValue.(types.Float64), ConfigValue: configValue.(types.Float64), PlanValue: planValue.(types.Float64)}
+		resp := &planmodifier.Float64Response{PlanValue: req.PlanValue}
+		m.PlanModifyFloat64(ctx, req, resp)
+		return resp.PlanValue, resp.Diagnostics
+	case planmodifier.Int64:
+		req := planmodifier.Int64Request{State: state, StateValue: stateValue.(types.Int64), ConfigValue: configValue.(types.Int64), PlanValue: planValue.(types.Int64)}
+		resp := &planmodifier.Int64Response{PlanValue: req.PlanValue}
+		m.PlanModifyInt64(ctx, req, resp)
+		return resp.PlanValue, resp.Diagnostics
+	case planmodifier.List:
+		req := planmodifier.ListRequest{State: state, StateValue: stateValue.(types.List), ConfigValue: configValue.(types.List), PlanValue: planValue.(types.List)}
+		resp := &planmodifier.ListResponse{PlanValue: req.PlanValue}
+		m.PlanModifyList(ctx, req, resp)
+		return resp.PlanValue, resp.Diagnostics
+	case planmodifier.Map:
+		req := planmodifier.MapRequest{State: state, StateValue: stateValue.(types.Map), ConfigValue: configValue.(types.Map), PlanValue: planValue.(types.Map)}
+		resp := &planmodifier.MapResponse{PlanValue: req.PlanValue}
+		m.PlanModifyMap(ctx, req, resp)
+		return resp.PlanValue, resp.Diagnostics
+	case planmodifier.Object:
+		req := planmodifier.ObjectRequest{State: state, StateValue: stateValue.(types.Object), ConfigValue: configValue.(types.Object), PlanValue: planValue.(types.Object)}
+		resp := &planmodifier.ObjectResponse{PlanValue: req.PlanValue}
+		m.PlanModifyObject(ctx, req, resp)
+		return resp.PlanValue, resp.Diagnostics
+	case planmodifier.Set:
+		req := planmodifier.SetRequest{State: state, StateValue: stateValue.(types.Set), ConfigValue: configValue.(types.Set), PlanValue: planValue.(types.Set)}
+		resp := &planmodifier.SetResponse{PlanValue: req.PlanValue}
+		m.PlanModifySet(ctx, req, resp)
+		return resp.PlanValue, resp.Diagnostics
+	case planmodifier.String:
+		req := planmodifier.StringRequest{State: state, StateValue: stateValue.(types.String), ConfigValue: configValue.(types.String), PlanValue: planValue.(types.String)}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+		m.PlanModifyString(ctx, req, resp)
+		return resp.PlanValue, resp.Diagnostics
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Unsupported plan modifier", fmt.Sprintf("%T doesn't implement the plan modifier of any attribute type", modifier))
+	return nil, diags
+
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"terraform-provider-msgraph/internal/export"
 	"terraform-provider-msgraph/msgraph"
 )
 
@@ -19,6 +21,13 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export.Run(context.Background(), msgraph.New(version), os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
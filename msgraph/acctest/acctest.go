@@ -0,0 +1,104 @@
+package acctest
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"terraform-provider-msgraph/internal/cassette"
+	"terraform-provider-msgraph/internal/graphfake"
+	"terraform-provider-msgraph/msgraph"
+)
+
+// The fake MS Graph API tests call when MSGRAPH_TEST_FAKE is set, shared by the tests of a package
+var fake *graphfake.Server
+
+func init() {
+
+	if os.Getenv("MSGRAPH_TEST_FAKE") == "" {
+		return
+	}
+
+	fake = graphfake.NewServer()
+
+	// Sites can't be created, so the site data source looks up one added to the server, unless given another.
+	// Tests read the variable before getting their provider, so it's set as soon as the package is loaded
+	if os.Getenv("MSGRAPH_TEST_SITE_ID") == "" {
+		os.Setenv("MSGRAPH_TEST_SITE_ID", fake.Add("sites", map[string]any{"displayName": "Example"}))
+	}
+
+}
+
+// ProtoV6ProviderFactories are used to instantiate the provider during acceptance testing.
+// The provider is configured the same way as outside of tests, from the MSGRAPH_* environment variables or the Azure CLI, unless:
+//   - MSGRAPH_TEST_FAKE is set, when it calls a fake MS Graph API instead of a tenant
+//   - MSGRAPH_TEST_CASSETTES is 'record', when the requests of the test and their responses are recorded to testdata/cassettes/<test>.yaml,
+//     or 'replay', when they are replayed from it instead of being sent. Tests without a cassette are skipped
+func ProtoV6ProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"msgraph": providerserver.NewProtocol6WithError(newProvider(t)),
+	}
+}
+
+func newProvider(t *testing.T) provider.Provider {
+
+	// resource.Test skips tests without TF_ACC, so there's nothing to record or replay
+	if os.Getenv("TF_ACC") == "" {
+		return msgraph.New("test")()
+	}
+
+	mode := os.Getenv("MSGRAPH_TEST_CASSETTES")
+
+	switch {
+	case fake != nil && mode != "":
+		t.Fatal("MSGRAPH_TEST_FAKE and MSGRAPH_TEST_CASSETTES can't both be set")
+
+	case fake != nil:
+		clients, err := fake.Clients()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msgraph.NewWithClients("test", clients)()
+
+	case mode == "record":
+		recorder, err := cassette.New(cassettePath(t), cassette.Record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			if err := recorder.Save(); err != nil {
+				t.Errorf("saving cassette: %s", err)
+			}
+		})
+		return msgraph.NewWithTransport("test", recorder.Transport)()
+
+	case mode == "replay":
+		recorder, err := cassette.New(cassettePath(t), cassette.Replay)
+		if errors.Is(err, os.ErrNotExist) {
+			t.Skipf("no cassette recorded at %s", cassettePath(t))
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		clients, err := recorder.Clients()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msgraph.NewWithClients("test", clients)()
+
+	case mode != "":
+		t.Fatalf("MSGRAPH_TEST_CASSETTES must be 'record' or 'replay', not '%s'", mode)
+	}
+
+	return msgraph.New("test")()
+
+}
+
+func cassettePath(t *testing.T) string {
+	return filepath.Join("testdata", "cassettes", t.Name()+".yaml")
+}
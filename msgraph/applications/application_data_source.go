@@ -11,6 +11,9 @@ import (
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/applications"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -35,12 +38,12 @@ func (d *applicationDataSource) Metadata(_ context.Context, req datasource.Metad
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *applicationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *applicationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -156,6 +159,10 @@ func (d *applicationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							},
 						},
 					},
+					"requested_access_token_version": schema.Int64Attribute{
+						Description: "Specifies the access token version expected by this resource. This changes the version and format of the JWT produced independent of the endpoint or client used to request the access token. The endpoint used, v1.0 or v2.0, is chosen by the client and only impacts the version of id_tokens. Resources need to explicitly configure requestedAccessTokenVersion to indicate the supported access token format. Possible values for requestedAccessTokenVersion are 1, 2, or null. If the value is null, this defaults to 1, which corresponds to the v1.0 endpoint. If signInAudience on the application is configured as AzureADandPersonalMicrosoftAccount or PersonalMicrosoftAccount, the value for this property must be 2.",
+						Computed:    true,
+					},
 				},
 			},
 			"app_id": schema.StringAttribute{
@@ -659,6 +666,10 @@ func (d *applicationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 						Computed:    true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
+								"index": schema.Int64Attribute{
+									Description: "",
+									Computed:    true,
+								},
 								"uri": schema.StringAttribute{
 									Description: "",
 									Computed:    true,
@@ -756,7 +767,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if len(responseApplication.GetAddIns()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAddIn := range responseApplication.GetAddIns() {
-			tfStateAddIn := applicationAddInModel{}
+			tfStateAddIn := sharedmodels.AddInModel{}
 
 			if responseAddIn.GetId() != nil {
 				tfStateAddIn.Id = types.StringValue(responseAddIn.GetId().String())
@@ -766,7 +777,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 			if len(responseAddIn.GetProperties()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseKeyValue := range responseAddIn.GetProperties() {
-					tfStateKeyValue := applicationKeyValueModel{}
+					tfStateKeyValue := sharedmodels.KeyValueModel{}
 
 					if responseKeyValue.GetKey() != nil {
 						tfStateKeyValue.Key = types.StringValue(*responseKeyValue.GetKey())
@@ -794,7 +805,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.AddIns, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 	}
 	if responseApplication.GetApi() != nil {
-		tfStateApiApplication := applicationApiApplicationModel{}
+		tfStateApiApplication := sharedmodels.ApiApplicationModel{}
 		responseApiApplication := responseApplication.GetApi()
 
 		if responseApiApplication.GetAcceptMappedClaims() != nil {
@@ -814,7 +825,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		if len(responseApiApplication.GetOauth2PermissionScopes()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responsePermissionScope := range responseApiApplication.GetOauth2PermissionScopes() {
-				tfStatePermissionScope := applicationPermissionScopeModel{}
+				tfStatePermissionScope := sharedmodels.PermissionScopeModel{}
 
 				if responsePermissionScope.GetAdminConsentDescription() != nil {
 					tfStatePermissionScope.AdminConsentDescription = types.StringValue(*responsePermissionScope.GetAdminConsentDescription())
@@ -869,7 +880,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		if len(responseApiApplication.GetPreAuthorizedApplications()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responsePreAuthorizedApplication := range responseApiApplication.GetPreAuthorizedApplications() {
-				tfStatePreAuthorizedApplication := applicationPreAuthorizedApplicationModel{}
+				tfStatePreAuthorizedApplication := sharedmodels.PreAuthorizedApplicationModel{}
 
 				if responsePreAuthorizedApplication.GetAppId() != nil {
 					tfStatePreAuthorizedApplication.AppId = types.StringValue(*responsePreAuthorizedApplication.GetAppId())
@@ -891,6 +902,11 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 			}
 			tfStateApiApplication.PreAuthorizedApplications, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 		}
+		if responseApiApplication.GetRequestedAccessTokenVersion() != nil {
+			tfStateApiApplication.RequestedAccessTokenVersion = types.Int64Value(int64(*responseApiApplication.GetRequestedAccessTokenVersion()))
+		} else {
+			tfStateApiApplication.RequestedAccessTokenVersion = types.Int64Null()
+		}
 
 		tfStateApplication.Api, _ = types.ObjectValueFrom(ctx, tfStateApiApplication.AttributeTypes(), tfStateApiApplication)
 	}
@@ -902,7 +918,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if len(responseApplication.GetAppRoles()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAppRole := range responseApplication.GetAppRoles() {
-			tfStateAppRole := applicationAppRoleModel{}
+			tfStateAppRole := sharedmodels.AppRoleModel{}
 
 			if len(responseAppRole.GetAllowedMemberTypes()) > 0 {
 				var valueArrayAllowedMemberTypes []attr.Value
@@ -955,7 +971,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.ApplicationTemplateId = types.StringNull()
 	}
 	if responseApplication.GetCertification() != nil {
-		tfStateCertification := applicationCertificationModel{}
+		tfStateCertification := sharedmodels.CertificationModel{}
 		responseCertification := responseApplication.GetCertification()
 
 		if responseCertification.GetCertificationDetailsUrl() != nil {
@@ -1037,7 +1053,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.IdentifierUris = types.ListNull(types.StringType)
 	}
 	if responseApplication.GetInfo() != nil {
-		tfStateInformationalUrl := applicationInformationalUrlModel{}
+		tfStateInformationalUrl := sharedmodels.InformationalUrlModel{}
 		responseInformationalUrl := responseApplication.GetInfo()
 
 		if responseInformationalUrl.GetLogoUrl() != nil {
@@ -1081,7 +1097,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if len(responseApplication.GetKeyCredentials()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseKeyCredential := range responseApplication.GetKeyCredentials() {
-			tfStateKeyCredential := applicationKeyCredentialModel{}
+			tfStateKeyCredential := sharedmodels.KeyCredentialModel{}
 
 			if responseKeyCredential.GetCustomKeyIdentifier() != nil {
 				tfStateKeyCredential.CustomKeyIdentifier = types.StringValue(string(responseKeyCredential.GetCustomKeyIdentifier()[:]))
@@ -1149,13 +1165,13 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.Oauth2RequirePostResponse = types.BoolNull()
 	}
 	if responseApplication.GetOptionalClaims() != nil {
-		tfStateOptionalClaims := applicationOptionalClaimsModel{}
+		tfStateOptionalClaims := sharedmodels.OptionalClaimsModel{}
 		responseOptionalClaims := responseApplication.GetOptionalClaims()
 
 		if len(responseOptionalClaims.GetAccessToken()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responseOptionalClaim := range responseOptionalClaims.GetAccessToken() {
-				tfStateOptionalClaim := applicationOptionalClaimModel{}
+				tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
 
 				if len(responseOptionalClaim.GetAdditionalProperties()) > 0 {
 					var valueArrayAdditionalProperties []attr.Value
@@ -1190,7 +1206,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		if len(responseOptionalClaims.GetIdToken()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responseOptionalClaim := range responseOptionalClaims.GetIdToken() {
-				tfStateOptionalClaim := applicationOptionalClaimModel{}
+				tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
 
 				if len(responseOptionalClaim.GetAdditionalProperties()) > 0 {
 					var valueArrayAdditionalProperties []attr.Value
@@ -1225,7 +1241,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		if len(responseOptionalClaims.GetSaml2Token()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responseOptionalClaim := range responseOptionalClaims.GetSaml2Token() {
-				tfStateOptionalClaim := applicationOptionalClaimModel{}
+				tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
 
 				if len(responseOptionalClaim.GetAdditionalProperties()) > 0 {
 					var valueArrayAdditionalProperties []attr.Value
@@ -1261,7 +1277,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.OptionalClaims, _ = types.ObjectValueFrom(ctx, tfStateOptionalClaims.AttributeTypes(), tfStateOptionalClaims)
 	}
 	if responseApplication.GetParentalControlSettings() != nil {
-		tfStateParentalControlSettings := applicationParentalControlSettingsModel{}
+		tfStateParentalControlSettings := sharedmodels.ParentalControlSettingsModel{}
 		responseParentalControlSettings := responseApplication.GetParentalControlSettings()
 
 		if len(responseParentalControlSettings.GetCountriesBlockedForMinors()) > 0 {
@@ -1285,7 +1301,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if len(responseApplication.GetPasswordCredentials()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responsePasswordCredential := range responseApplication.GetPasswordCredentials() {
-			tfStatePasswordCredential := applicationPasswordCredentialModel{}
+			tfStatePasswordCredential := sharedmodels.PasswordCredentialModel{}
 
 			if responsePasswordCredential.GetCustomKeyIdentifier() != nil {
 				tfStatePasswordCredential.CustomKeyIdentifier = types.StringValue(string(responsePasswordCredential.GetCustomKeyIdentifier()[:]))
@@ -1328,7 +1344,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.PasswordCredentials, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 	}
 	if responseApplication.GetPublicClient() != nil {
-		tfStatePublicClientApplication := applicationPublicClientApplicationModel{}
+		tfStatePublicClientApplication := sharedmodels.PublicClientApplicationModel{}
 		responsePublicClientApplication := responseApplication.GetPublicClient()
 
 		if len(responsePublicClientApplication.GetRedirectUris()) > 0 {
@@ -1350,7 +1366,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.PublisherDomain = types.StringNull()
 	}
 	if responseApplication.GetRequestSignatureVerification() != nil {
-		tfStateRequestSignatureVerification := applicationRequestSignatureVerificationModel{}
+		tfStateRequestSignatureVerification := sharedmodels.RequestSignatureVerificationModel{}
 		responseRequestSignatureVerification := responseApplication.GetRequestSignatureVerification()
 
 		if responseRequestSignatureVerification.GetAllowedWeakAlgorithms() != nil {
@@ -1369,12 +1385,12 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if len(responseApplication.GetRequiredResourceAccess()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseRequiredResourceAccess := range responseApplication.GetRequiredResourceAccess() {
-			tfStateRequiredResourceAccess := applicationRequiredResourceAccessModel{}
+			tfStateRequiredResourceAccess := sharedmodels.RequiredResourceAccessModel{}
 
 			if len(responseRequiredResourceAccess.GetResourceAccess()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseResourceAccess := range responseRequiredResourceAccess.GetResourceAccess() {
-					tfStateResourceAccess := applicationResourceAccessModel{}
+					tfStateResourceAccess := sharedmodels.ResourceAccessModel{}
 
 					if responseResourceAccess.GetId() != nil {
 						tfStateResourceAccess.Id = types.StringValue(responseResourceAccess.GetId().String())
@@ -1412,7 +1428,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.ServiceManagementReference = types.StringNull()
 	}
 	if responseApplication.GetServicePrincipalLockConfiguration() != nil {
-		tfStateServicePrincipalLockConfiguration := applicationServicePrincipalLockConfigurationModel{}
+		tfStateServicePrincipalLockConfiguration := sharedmodels.ServicePrincipalLockConfigurationModel{}
 		responseServicePrincipalLockConfiguration := responseApplication.GetServicePrincipalLockConfiguration()
 
 		if responseServicePrincipalLockConfiguration.GetAllProperties() != nil {
@@ -1449,7 +1465,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.SignInAudience = types.StringNull()
 	}
 	if responseApplication.GetSpa() != nil {
-		tfStateSpaApplication := applicationSpaApplicationModel{}
+		tfStateSpaApplication := sharedmodels.SpaApplicationModel{}
 		responseSpaApplication := responseApplication.GetSpa()
 
 		if len(responseSpaApplication.GetRedirectUris()) > 0 {
@@ -1486,7 +1502,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.UniqueName = types.StringNull()
 	}
 	if responseApplication.GetVerifiedPublisher() != nil {
-		tfStateVerifiedPublisher := applicationVerifiedPublisherModel{}
+		tfStateVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		responseVerifiedPublisher := responseApplication.GetVerifiedPublisher()
 
 		if responseVerifiedPublisher.GetAddedDateTime() != nil {
@@ -1508,7 +1524,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		tfStateApplication.VerifiedPublisher, _ = types.ObjectValueFrom(ctx, tfStateVerifiedPublisher.AttributeTypes(), tfStateVerifiedPublisher)
 	}
 	if responseApplication.GetWeb() != nil {
-		tfStateWebApplication := applicationWebApplicationModel{}
+		tfStateWebApplication := sharedmodels.WebApplicationModel{}
 		responseWebApplication := responseApplication.GetWeb()
 
 		if responseWebApplication.GetHomePageUrl() != nil {
@@ -1517,7 +1533,7 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 			tfStateWebApplication.HomePageUrl = types.StringNull()
 		}
 		if responseWebApplication.GetImplicitGrantSettings() != nil {
-			tfStateImplicitGrantSettings := applicationImplicitGrantSettingsModel{}
+			tfStateImplicitGrantSettings := sharedmodels.ImplicitGrantSettingsModel{}
 			responseImplicitGrantSettings := responseWebApplication.GetImplicitGrantSettings()
 
 			if responseImplicitGrantSettings.GetEnableAccessTokenIssuance() != nil {
@@ -1541,8 +1557,13 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		if len(responseWebApplication.GetRedirectUriSettings()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responseRedirectUriSettings := range responseWebApplication.GetRedirectUriSettings() {
-				tfStateRedirectUriSettings := applicationRedirectUriSettingsModel{}
+				tfStateRedirectUriSettings := sharedmodels.RedirectUriSettingsModel{}
 
+				if responseRedirectUriSettings.GetIndex() != nil {
+					tfStateRedirectUriSettings.Index = types.Int64Value(int64(*responseRedirectUriSettings.GetIndex()))
+				} else {
+					tfStateRedirectUriSettings.Index = types.Int64Null()
+				}
 				if responseRedirectUriSettings.GetUri() != nil {
 					tfStateRedirectUriSettings.Uri = types.StringValue(*responseRedirectUriSettings.GetUri())
 				} else {
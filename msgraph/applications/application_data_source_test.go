@@ -0,0 +1,29 @@
+package applications_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+func TestAccApplicationDataSource(t *testing.T) {
+	config := applicationResourceConfig + `
+data "msgraph_application" "test" {
+  id = msgraph_application.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.msgraph_application.test", "id", "msgraph_application.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -2,21 +2,31 @@ package applications
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"strings"
 	"time"
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/applications"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/models/odataerrors"
 
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers"
+	"terraform-provider-msgraph/planmodifiers/int64planmodifiers"
 	"terraform-provider-msgraph/planmodifiers/listplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/objectplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/stringplanmodifiers"
@@ -26,6 +36,7 @@ import (
 var (
 	_ resource.Resource              = &applicationResource{}
 	_ resource.ResourceWithConfigure = &applicationResource{}
+	_ resource.ResourceWithMoveState = &applicationResource{}
 )
 
 // NewApplicationResource is a helper function to simplify the provider implementation.
@@ -38,18 +49,25 @@ type applicationResource struct {
 	client *msgraphsdk.GraphServiceClient
 }
 
+// applicationResourceModel is the model of the resource, which has the attributes controlling how it's deleted besides those of the application
+type applicationResourceModel struct {
+	applicationModel
+	PreventDeletion   types.Bool `tfsdk:"prevent_deletion"`
+	PermanentlyDelete types.Bool `tfsdk:"permanently_delete"`
+}
+
 // Metadata returns the resource type name.
 func (d *applicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application"
 }
 
 // Configure adds the provider configured client to the resource.
-func (d *applicationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (d *applicationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the resource.
@@ -251,6 +269,14 @@ func (d *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 							},
 						},
 					},
+					"requested_access_token_version": schema.Int64Attribute{
+						Description: "Specifies the access token version expected by this resource. This changes the version and format of the JWT produced independent of the endpoint or client used to request the access token. The endpoint used, v1.0 or v2.0, is chosen by the client and only impacts the version of id_tokens. Resources need to explicitly configure requestedAccessTokenVersion to indicate the supported access token format. Possible values for requestedAccessTokenVersion are 1, 2, or null. If the value is null, this defaults to 1, which corresponds to the v1.0 endpoint. If signInAudience on the application is configured as AzureADandPersonalMicrosoftAccount or PersonalMicrosoftAccount, the value for this property must be 2.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifiers.UseStateForUnconfigured(),
+						},
+					},
 				},
 			},
 			"app_id": schema.StringAttribute{
@@ -1046,9 +1072,7 @@ func (d *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Specifies the Microsoft accounts that are supported for the current application. The possible values are: AzureADMyOrg (default), AzureADMultipleOrgs, AzureADandPersonalMicrosoftAccount, and PersonalMicrosoftAccount. See more in the table. The value of this object also limits the number of permissions an app can request. For more information, see Limits on requested permissions per app. The value for this property has implications on other app object properties. As a result, if you change this property, you might need to change other properties first. For more information, see Validation differences for signInAudience.Supports $filter (eq, ne, not).",
 				Optional:    true,
 				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifiers.UseStateForUnconfigured(),
-				},
+				Default:     stringdefault.StaticString("AzureADMyOrg"),
 			},
 			"spa": schema.SingleNestedAttribute{
 				Description: "Specifies settings for a single-page application, including sign out URLs and redirect URIs for authorization codes and access tokens.",
@@ -1187,6 +1211,14 @@ func (d *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 						},
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
+								"index": schema.Int64Attribute{
+									Description: "",
+									Optional:    true,
+									Computed:    true,
+									PlanModifiers: []planmodifier.Int64{
+										int64planmodifiers.UseStateForUnconfigured(),
+									},
+								},
 								"uri": schema.StringAttribute{
 									Description: "",
 									Optional:    true,
@@ -1209,6 +1241,19 @@ func (d *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					},
 				},
 			},
+
+			"prevent_deletion": schema.BoolAttribute{
+				Description: "Whether deleting the resource fails, to protect the object from being deleted by Terraform. Set it to `false`, and apply, before the resource can be deleted. Not sent to the API. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"permanently_delete": schema.BoolAttribute{
+				Description: "Whether the object is also purged from the deleted items of the directory when the resource is deleted, instead of being kept there for 30 days to be restored. Not sent to the API. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -1216,7 +1261,7 @@ func (d *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 // Create creates the resource and sets the initial Terraform state.
 func (r *applicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from Terraform plan
-	var tfPlanApplication applicationModel
+	var tfPlanApplication applicationResourceModel
 	diags := req.Plan.Get(ctx, &tfPlanApplication)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1229,7 +1274,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		var requestBodyAddIns []models.AddInable
 		for _, i := range tfPlanApplication.AddIns.Elements() {
 			requestBodyAddIn := models.NewAddIn()
-			tfPlanAddIn := applicationAddInModel{}
+			tfPlanAddIn := sharedmodels.AddInModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAddIn)
 
 			if !tfPlanAddIn.Id.IsUnknown() {
@@ -1244,7 +1289,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 				var requestBodyProperties []models.KeyValueable
 				for _, i := range tfPlanAddIn.Properties.Elements() {
 					requestBodyKeyValue := models.NewKeyValue()
-					tfPlanKeyValue := applicationKeyValueModel{}
+					tfPlanKeyValue := sharedmodels.KeyValueModel{}
 					types.ListValueFrom(ctx, i.Type(ctx), &tfPlanKeyValue)
 
 					if !tfPlanKeyValue.Key.IsUnknown() {
@@ -1282,7 +1327,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.Api.IsUnknown() {
 		requestBodyApiApplication := models.NewApiApplication()
-		tfPlanApiApplication := applicationApiApplicationModel{}
+		tfPlanApiApplication := sharedmodels.ApiApplicationModel{}
 		tfPlanApplication.Api.As(ctx, &tfPlanApiApplication, basetypes.ObjectAsOptions{})
 
 		if !tfPlanApiApplication.AcceptMappedClaims.IsUnknown() {
@@ -1307,7 +1352,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 			var requestBodyOauth2PermissionScopes []models.PermissionScopeable
 			for _, i := range tfPlanApiApplication.Oauth2PermissionScopes.Elements() {
 				requestBodyPermissionScope := models.NewPermissionScope()
-				tfPlanPermissionScope := applicationPermissionScopeModel{}
+				tfPlanPermissionScope := sharedmodels.PermissionScopeModel{}
 				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPermissionScope)
 
 				if !tfPlanPermissionScope.AdminConsentDescription.IsUnknown() {
@@ -1384,7 +1429,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 			var requestBodyPreAuthorizedApplications []models.PreAuthorizedApplicationable
 			for _, i := range tfPlanApiApplication.PreAuthorizedApplications.Elements() {
 				requestBodyPreAuthorizedApplication := models.NewPreAuthorizedApplication()
-				tfPlanPreAuthorizedApplication := applicationPreAuthorizedApplicationModel{}
+				tfPlanPreAuthorizedApplication := sharedmodels.PreAuthorizedApplicationModel{}
 				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPreAuthorizedApplication)
 
 				if !tfPlanPreAuthorizedApplication.AppId.IsUnknown() {
@@ -1410,6 +1455,13 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 			tfPlanApiApplication.PreAuthorizedApplications = types.ListNull(tfPlanApiApplication.PreAuthorizedApplications.ElementType(ctx))
 		}
 
+		if !tfPlanApiApplication.RequestedAccessTokenVersion.IsUnknown() {
+			tfPlanRequestedAccessTokenVersion := int32(tfPlanApiApplication.RequestedAccessTokenVersion.ValueInt64())
+			requestBodyApiApplication.SetRequestedAccessTokenVersion(&tfPlanRequestedAccessTokenVersion)
+		} else {
+			tfPlanApiApplication.RequestedAccessTokenVersion = types.Int64Null()
+		}
+
 		requestBodyApplication.SetApi(requestBodyApiApplication)
 		tfPlanApplication.Api, _ = types.ObjectValueFrom(ctx, tfPlanApiApplication.AttributeTypes(), tfPlanApiApplication)
 	} else {
@@ -1427,7 +1479,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		var requestBodyAppRoles []models.AppRoleable
 		for _, i := range tfPlanApplication.AppRoles.Elements() {
 			requestBodyAppRole := models.NewAppRole()
-			tfPlanAppRole := applicationAppRoleModel{}
+			tfPlanAppRole := sharedmodels.AppRoleModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAppRole)
 
 			if len(tfPlanAppRole.AllowedMemberTypes.Elements()) > 0 {
@@ -1498,7 +1550,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.Certification.IsUnknown() {
 		requestBodyCertification := models.NewCertification()
-		tfPlanCertification := applicationCertificationModel{}
+		tfPlanCertification := sharedmodels.CertificationModel{}
 		tfPlanApplication.Certification.As(ctx, &tfPlanCertification, basetypes.ObjectAsOptions{})
 
 		if !tfPlanCertification.CertificationDetailsUrl.IsUnknown() {
@@ -1614,7 +1666,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.Info.IsUnknown() {
 		requestBodyInformationalUrl := models.NewInformationalUrl()
-		tfPlanInformationalUrl := applicationInformationalUrlModel{}
+		tfPlanInformationalUrl := sharedmodels.InformationalUrlModel{}
 		tfPlanApplication.Info.As(ctx, &tfPlanInformationalUrl, basetypes.ObjectAsOptions{})
 
 		if !tfPlanInformationalUrl.LogoUrl.IsUnknown() {
@@ -1676,7 +1728,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		var requestBodyKeyCredentials []models.KeyCredentialable
 		for _, i := range tfPlanApplication.KeyCredentials.Elements() {
 			requestBodyKeyCredential := models.NewKeyCredential()
-			tfPlanKeyCredential := applicationKeyCredentialModel{}
+			tfPlanKeyCredential := sharedmodels.KeyCredentialModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanKeyCredential)
 
 			if !tfPlanKeyCredential.CustomKeyIdentifier.IsUnknown() {
@@ -1776,14 +1828,14 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.OptionalClaims.IsUnknown() {
 		requestBodyOptionalClaims := models.NewOptionalClaims()
-		tfPlanOptionalClaims := applicationOptionalClaimsModel{}
+		tfPlanOptionalClaims := sharedmodels.OptionalClaimsModel{}
 		tfPlanApplication.OptionalClaims.As(ctx, &tfPlanOptionalClaims, basetypes.ObjectAsOptions{})
 
 		if len(tfPlanOptionalClaims.AccessToken.Elements()) > 0 {
 			var requestBodyAccessToken []models.OptionalClaimable
 			for _, i := range tfPlanOptionalClaims.AccessToken.Elements() {
 				requestBodyOptionalClaim := models.NewOptionalClaim()
-				tfPlanOptionalClaim := applicationOptionalClaimModel{}
+				tfPlanOptionalClaim := sharedmodels.OptionalClaimModel{}
 				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOptionalClaim)
 
 				if len(tfPlanOptionalClaim.AdditionalProperties.Elements()) > 0 {
@@ -1827,7 +1879,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 			var requestBodyIdToken []models.OptionalClaimable
 			for _, i := range tfPlanOptionalClaims.IdToken.Elements() {
 				requestBodyOptionalClaim := models.NewOptionalClaim()
-				tfPlanOptionalClaim := applicationOptionalClaimModel{}
+				tfPlanOptionalClaim := sharedmodels.OptionalClaimModel{}
 				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOptionalClaim)
 
 				if len(tfPlanOptionalClaim.AdditionalProperties.Elements()) > 0 {
@@ -1871,7 +1923,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 			var requestBodySaml2Token []models.OptionalClaimable
 			for _, i := range tfPlanOptionalClaims.Saml2Token.Elements() {
 				requestBodyOptionalClaim := models.NewOptionalClaim()
-				tfPlanOptionalClaim := applicationOptionalClaimModel{}
+				tfPlanOptionalClaim := sharedmodels.OptionalClaimModel{}
 				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOptionalClaim)
 
 				if len(tfPlanOptionalClaim.AdditionalProperties.Elements()) > 0 {
@@ -1919,7 +1971,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.ParentalControlSettings.IsUnknown() {
 		requestBodyParentalControlSettings := models.NewParentalControlSettings()
-		tfPlanParentalControlSettings := applicationParentalControlSettingsModel{}
+		tfPlanParentalControlSettings := sharedmodels.ParentalControlSettingsModel{}
 		tfPlanApplication.ParentalControlSettings.As(ctx, &tfPlanParentalControlSettings, basetypes.ObjectAsOptions{})
 
 		if len(tfPlanParentalControlSettings.CountriesBlockedForMinors.Elements()) > 0 {
@@ -1949,7 +2001,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		var requestBodyPasswordCredentials []models.PasswordCredentialable
 		for _, i := range tfPlanApplication.PasswordCredentials.Elements() {
 			requestBodyPasswordCredential := models.NewPasswordCredential()
-			tfPlanPasswordCredential := applicationPasswordCredentialModel{}
+			tfPlanPasswordCredential := sharedmodels.PasswordCredentialModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPasswordCredential)
 
 			if !tfPlanPasswordCredential.CustomKeyIdentifier.IsUnknown() {
@@ -2012,7 +2064,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.PublicClient.IsUnknown() {
 		requestBodyPublicClientApplication := models.NewPublicClientApplication()
-		tfPlanPublicClientApplication := applicationPublicClientApplicationModel{}
+		tfPlanPublicClientApplication := sharedmodels.PublicClientApplicationModel{}
 		tfPlanApplication.PublicClient.As(ctx, &tfPlanPublicClientApplication, basetypes.ObjectAsOptions{})
 
 		if len(tfPlanPublicClientApplication.RedirectUris.Elements()) > 0 {
@@ -2040,7 +2092,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.RequestSignatureVerification.IsUnknown() {
 		requestBodyRequestSignatureVerification := models.NewRequestSignatureVerification()
-		tfPlanRequestSignatureVerification := applicationRequestSignatureVerificationModel{}
+		tfPlanRequestSignatureVerification := sharedmodels.RequestSignatureVerificationModel{}
 		tfPlanApplication.RequestSignatureVerification.As(ctx, &tfPlanRequestSignatureVerification, basetypes.ObjectAsOptions{})
 
 		if !tfPlanRequestSignatureVerification.AllowedWeakAlgorithms.IsUnknown() {
@@ -2069,14 +2121,14 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		var requestBodyRequiredResourceAccess []models.RequiredResourceAccessable
 		for _, i := range tfPlanApplication.RequiredResourceAccess.Elements() {
 			requestBodyRequiredResourceAccess := models.NewRequiredResourceAccess()
-			tfPlanRequiredResourceAccess := applicationRequiredResourceAccessModel{}
+			tfPlanRequiredResourceAccess := sharedmodels.RequiredResourceAccessModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanRequiredResourceAccess)
 
 			if len(tfPlanRequiredResourceAccess.ResourceAccess.Elements()) > 0 {
 				var requestBodyResourceAccess []models.ResourceAccessable
 				for _, i := range tfPlanRequiredResourceAccess.ResourceAccess.Elements() {
 					requestBodyResourceAccess := models.NewResourceAccess()
-					tfPlanResourceAccess := applicationResourceAccessModel{}
+					tfPlanResourceAccess := sharedmodels.ResourceAccessModel{}
 					types.ListValueFrom(ctx, i.Type(ctx), &tfPlanResourceAccess)
 
 					if !tfPlanResourceAccess.Id.IsUnknown() {
@@ -2129,7 +2181,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.ServicePrincipalLockConfiguration.IsUnknown() {
 		requestBodyServicePrincipalLockConfiguration := models.NewServicePrincipalLockConfiguration()
-		tfPlanServicePrincipalLockConfiguration := applicationServicePrincipalLockConfigurationModel{}
+		tfPlanServicePrincipalLockConfiguration := sharedmodels.ServicePrincipalLockConfigurationModel{}
 		tfPlanApplication.ServicePrincipalLockConfiguration.As(ctx, &tfPlanServicePrincipalLockConfiguration, basetypes.ObjectAsOptions{})
 
 		if !tfPlanServicePrincipalLockConfiguration.AllProperties.IsUnknown() {
@@ -2182,7 +2234,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.Spa.IsUnknown() {
 		requestBodySpaApplication := models.NewSpaApplication()
-		tfPlanSpaApplication := applicationSpaApplicationModel{}
+		tfPlanSpaApplication := sharedmodels.SpaApplicationModel{}
 		tfPlanApplication.Spa.As(ctx, &tfPlanSpaApplication, basetypes.ObjectAsOptions{})
 
 		if len(tfPlanSpaApplication.RedirectUris.Elements()) > 0 {
@@ -2228,7 +2280,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.VerifiedPublisher.IsUnknown() {
 		requestBodyVerifiedPublisher := models.NewVerifiedPublisher()
-		tfPlanVerifiedPublisher := applicationVerifiedPublisherModel{}
+		tfPlanVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		tfPlanApplication.VerifiedPublisher.As(ctx, &tfPlanVerifiedPublisher, basetypes.ObjectAsOptions{})
 
 		if !tfPlanVerifiedPublisher.AddedDateTime.IsUnknown() {
@@ -2261,7 +2313,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	if !tfPlanApplication.Web.IsUnknown() {
 		requestBodyWebApplication := models.NewWebApplication()
-		tfPlanWebApplication := applicationWebApplicationModel{}
+		tfPlanWebApplication := sharedmodels.WebApplicationModel{}
 		tfPlanApplication.Web.As(ctx, &tfPlanWebApplication, basetypes.ObjectAsOptions{})
 
 		if !tfPlanWebApplication.HomePageUrl.IsUnknown() {
@@ -2273,7 +2325,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 		if !tfPlanWebApplication.ImplicitGrantSettings.IsUnknown() {
 			requestBodyImplicitGrantSettings := models.NewImplicitGrantSettings()
-			tfPlanImplicitGrantSettings := applicationImplicitGrantSettingsModel{}
+			tfPlanImplicitGrantSettings := sharedmodels.ImplicitGrantSettingsModel{}
 			tfPlanWebApplication.ImplicitGrantSettings.As(ctx, &tfPlanImplicitGrantSettings, basetypes.ObjectAsOptions{})
 
 			if !tfPlanImplicitGrantSettings.EnableAccessTokenIssuance.IsUnknown() {
@@ -2307,9 +2359,16 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 			var requestBodyRedirectUriSettings []models.RedirectUriSettingsable
 			for _, i := range tfPlanWebApplication.RedirectUriSettings.Elements() {
 				requestBodyRedirectUriSettings := models.NewRedirectUriSettings()
-				tfPlanRedirectUriSettings := applicationRedirectUriSettingsModel{}
+				tfPlanRedirectUriSettings := sharedmodels.RedirectUriSettingsModel{}
 				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanRedirectUriSettings)
 
+				if !tfPlanRedirectUriSettings.Index.IsUnknown() {
+					tfPlanIndex := int32(tfPlanRedirectUriSettings.Index.ValueInt64())
+					requestBodyRedirectUriSettings.SetIndex(&tfPlanIndex)
+				} else {
+					tfPlanRedirectUriSettings.Index = types.Int64Null()
+				}
+
 				if !tfPlanRedirectUriSettings.Uri.IsUnknown() {
 					tfPlanUri := tfPlanRedirectUriSettings.Uri.ValueString()
 					requestBodyRedirectUriSettings.SetUri(&tfPlanUri)
@@ -2340,7 +2399,10 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Create new Application
-	result, err := r.client.Applications().Post(context.Background(), requestBodyApplication, nil)
+	created := clients.NewCreatedObject()
+	result, err := r.client.Applications().Post(ctx, requestBodyApplication, &applications.ApplicationsRequestBuilderPostRequestConfiguration{
+		Options: created.Options(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Application",
@@ -2351,7 +2413,15 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Map response body to schema and populate Computed attribute value
 	// TODO: Add support for other Computed values
-	tfPlanApplication.Id = types.StringValue(*result.GetId())
+	id, err := created.Id(result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Application",
+			err.Error(),
+		)
+		return
+	}
+	tfPlanApplication.Id = types.StringValue(id)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, tfPlanApplication)
@@ -2364,7 +2434,7 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 // Read refreshes the Terraform state with the latest data.
 func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var tfStateApplication applicationModel
+	var tfStateApplication applicationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &tfStateApplication)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -2441,7 +2511,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	if len(responseApplication.GetAddIns()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAddIn := range responseApplication.GetAddIns() {
-			tfStateAddIn := applicationAddInModel{}
+			tfStateAddIn := sharedmodels.AddInModel{}
 
 			if responseAddIn.GetId() != nil {
 				tfStateAddIn.Id = types.StringValue(responseAddIn.GetId().String())
@@ -2451,7 +2521,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 			if len(responseAddIn.GetProperties()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseKeyValue := range responseAddIn.GetProperties() {
-					tfStateKeyValue := applicationKeyValueModel{}
+					tfStateKeyValue := sharedmodels.KeyValueModel{}
 
 					if responseKeyValue.GetKey() != nil {
 						tfStateKeyValue.Key = types.StringValue(*responseKeyValue.GetKey())
@@ -2479,7 +2549,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.AddIns, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 	}
 	if responseApplication.GetApi() != nil {
-		tfStateApiApplication := applicationApiApplicationModel{}
+		tfStateApiApplication := sharedmodels.ApiApplicationModel{}
 		responseApiApplication := responseApplication.GetApi()
 
 		if responseApiApplication.GetAcceptMappedClaims() != nil {
@@ -2499,7 +2569,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		if len(responseApiApplication.GetOauth2PermissionScopes()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responsePermissionScope := range responseApiApplication.GetOauth2PermissionScopes() {
-				tfStatePermissionScope := applicationPermissionScopeModel{}
+				tfStatePermissionScope := sharedmodels.PermissionScopeModel{}
 
 				if responsePermissionScope.GetAdminConsentDescription() != nil {
 					tfStatePermissionScope.AdminConsentDescription = types.StringValue(*responsePermissionScope.GetAdminConsentDescription())
@@ -2554,7 +2624,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		if len(responseApiApplication.GetPreAuthorizedApplications()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responsePreAuthorizedApplication := range responseApiApplication.GetPreAuthorizedApplications() {
-				tfStatePreAuthorizedApplication := applicationPreAuthorizedApplicationModel{}
+				tfStatePreAuthorizedApplication := sharedmodels.PreAuthorizedApplicationModel{}
 
 				if responsePreAuthorizedApplication.GetAppId() != nil {
 					tfStatePreAuthorizedApplication.AppId = types.StringValue(*responsePreAuthorizedApplication.GetAppId())
@@ -2576,6 +2646,11 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 			}
 			tfStateApiApplication.PreAuthorizedApplications, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 		}
+		if responseApiApplication.GetRequestedAccessTokenVersion() != nil {
+			tfStateApiApplication.RequestedAccessTokenVersion = types.Int64Value(int64(*responseApiApplication.GetRequestedAccessTokenVersion()))
+		} else {
+			tfStateApiApplication.RequestedAccessTokenVersion = types.Int64Null()
+		}
 
 		tfStateApplication.Api, _ = types.ObjectValueFrom(ctx, tfStateApiApplication.AttributeTypes(), tfStateApiApplication)
 	}
@@ -2587,7 +2662,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	if len(responseApplication.GetAppRoles()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAppRole := range responseApplication.GetAppRoles() {
-			tfStateAppRole := applicationAppRoleModel{}
+			tfStateAppRole := sharedmodels.AppRoleModel{}
 
 			if len(responseAppRole.GetAllowedMemberTypes()) > 0 {
 				var valueArrayAllowedMemberTypes []attr.Value
@@ -2640,7 +2715,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.ApplicationTemplateId = types.StringNull()
 	}
 	if responseApplication.GetCertification() != nil {
-		tfStateCertification := applicationCertificationModel{}
+		tfStateCertification := sharedmodels.CertificationModel{}
 		responseCertification := responseApplication.GetCertification()
 
 		if responseCertification.GetCertificationDetailsUrl() != nil {
@@ -2722,7 +2797,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.IdentifierUris = types.ListNull(types.StringType)
 	}
 	if responseApplication.GetInfo() != nil {
-		tfStateInformationalUrl := applicationInformationalUrlModel{}
+		tfStateInformationalUrl := sharedmodels.InformationalUrlModel{}
 		responseInformationalUrl := responseApplication.GetInfo()
 
 		if responseInformationalUrl.GetLogoUrl() != nil {
@@ -2766,7 +2841,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	if len(responseApplication.GetKeyCredentials()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseKeyCredential := range responseApplication.GetKeyCredentials() {
-			tfStateKeyCredential := applicationKeyCredentialModel{}
+			tfStateKeyCredential := sharedmodels.KeyCredentialModel{}
 
 			if responseKeyCredential.GetCustomKeyIdentifier() != nil {
 				tfStateKeyCredential.CustomKeyIdentifier = types.StringValue(string(responseKeyCredential.GetCustomKeyIdentifier()[:]))
@@ -2834,13 +2909,13 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.Oauth2RequirePostResponse = types.BoolNull()
 	}
 	if responseApplication.GetOptionalClaims() != nil {
-		tfStateOptionalClaims := applicationOptionalClaimsModel{}
+		tfStateOptionalClaims := sharedmodels.OptionalClaimsModel{}
 		responseOptionalClaims := responseApplication.GetOptionalClaims()
 
 		if len(responseOptionalClaims.GetAccessToken()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responseOptionalClaim := range responseOptionalClaims.GetAccessToken() {
-				tfStateOptionalClaim := applicationOptionalClaimModel{}
+				tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
 
 				if len(responseOptionalClaim.GetAdditionalProperties()) > 0 {
 					var valueArrayAdditionalProperties []attr.Value
@@ -2875,7 +2950,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		if len(responseOptionalClaims.GetIdToken()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responseOptionalClaim := range responseOptionalClaims.GetIdToken() {
-				tfStateOptionalClaim := applicationOptionalClaimModel{}
+				tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
 
 				if len(responseOptionalClaim.GetAdditionalProperties()) > 0 {
 					var valueArrayAdditionalProperties []attr.Value
@@ -2910,7 +2985,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		if len(responseOptionalClaims.GetSaml2Token()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responseOptionalClaim := range responseOptionalClaims.GetSaml2Token() {
-				tfStateOptionalClaim := applicationOptionalClaimModel{}
+				tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
 
 				if len(responseOptionalClaim.GetAdditionalProperties()) > 0 {
 					var valueArrayAdditionalProperties []attr.Value
@@ -2946,7 +3021,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.OptionalClaims, _ = types.ObjectValueFrom(ctx, tfStateOptionalClaims.AttributeTypes(), tfStateOptionalClaims)
 	}
 	if responseApplication.GetParentalControlSettings() != nil {
-		tfStateParentalControlSettings := applicationParentalControlSettingsModel{}
+		tfStateParentalControlSettings := sharedmodels.ParentalControlSettingsModel{}
 		responseParentalControlSettings := responseApplication.GetParentalControlSettings()
 
 		if len(responseParentalControlSettings.GetCountriesBlockedForMinors()) > 0 {
@@ -2970,7 +3045,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	if len(responseApplication.GetPasswordCredentials()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responsePasswordCredential := range responseApplication.GetPasswordCredentials() {
-			tfStatePasswordCredential := applicationPasswordCredentialModel{}
+			tfStatePasswordCredential := sharedmodels.PasswordCredentialModel{}
 
 			if responsePasswordCredential.GetCustomKeyIdentifier() != nil {
 				tfStatePasswordCredential.CustomKeyIdentifier = types.StringValue(string(responsePasswordCredential.GetCustomKeyIdentifier()[:]))
@@ -3013,7 +3088,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.PasswordCredentials, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 	}
 	if responseApplication.GetPublicClient() != nil {
-		tfStatePublicClientApplication := applicationPublicClientApplicationModel{}
+		tfStatePublicClientApplication := sharedmodels.PublicClientApplicationModel{}
 		responsePublicClientApplication := responseApplication.GetPublicClient()
 
 		if len(responsePublicClientApplication.GetRedirectUris()) > 0 {
@@ -3035,7 +3110,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.PublisherDomain = types.StringNull()
 	}
 	if responseApplication.GetRequestSignatureVerification() != nil {
-		tfStateRequestSignatureVerification := applicationRequestSignatureVerificationModel{}
+		tfStateRequestSignatureVerification := sharedmodels.RequestSignatureVerificationModel{}
 		responseRequestSignatureVerification := responseApplication.GetRequestSignatureVerification()
 
 		if responseRequestSignatureVerification.GetAllowedWeakAlgorithms() != nil {
@@ -3054,12 +3129,12 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	if len(responseApplication.GetRequiredResourceAccess()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseRequiredResourceAccess := range responseApplication.GetRequiredResourceAccess() {
-			tfStateRequiredResourceAccess := applicationRequiredResourceAccessModel{}
+			tfStateRequiredResourceAccess := sharedmodels.RequiredResourceAccessModel{}
 
 			if len(responseRequiredResourceAccess.GetResourceAccess()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseResourceAccess := range responseRequiredResourceAccess.GetResourceAccess() {
-					tfStateResourceAccess := applicationResourceAccessModel{}
+					tfStateResourceAccess := sharedmodels.ResourceAccessModel{}
 
 					if responseResourceAccess.GetId() != nil {
 						tfStateResourceAccess.Id = types.StringValue(responseResourceAccess.GetId().String())
@@ -3097,7 +3172,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.ServiceManagementReference = types.StringNull()
 	}
 	if responseApplication.GetServicePrincipalLockConfiguration() != nil {
-		tfStateServicePrincipalLockConfiguration := applicationServicePrincipalLockConfigurationModel{}
+		tfStateServicePrincipalLockConfiguration := sharedmodels.ServicePrincipalLockConfigurationModel{}
 		responseServicePrincipalLockConfiguration := responseApplication.GetServicePrincipalLockConfiguration()
 
 		if responseServicePrincipalLockConfiguration.GetAllProperties() != nil {
@@ -3134,7 +3209,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.SignInAudience = types.StringNull()
 	}
 	if responseApplication.GetSpa() != nil {
-		tfStateSpaApplication := applicationSpaApplicationModel{}
+		tfStateSpaApplication := sharedmodels.SpaApplicationModel{}
 		responseSpaApplication := responseApplication.GetSpa()
 
 		if len(responseSpaApplication.GetRedirectUris()) > 0 {
@@ -3171,7 +3246,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.UniqueName = types.StringNull()
 	}
 	if responseApplication.GetVerifiedPublisher() != nil {
-		tfStateVerifiedPublisher := applicationVerifiedPublisherModel{}
+		tfStateVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		responseVerifiedPublisher := responseApplication.GetVerifiedPublisher()
 
 		if responseVerifiedPublisher.GetAddedDateTime() != nil {
@@ -3193,7 +3268,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.VerifiedPublisher, _ = types.ObjectValueFrom(ctx, tfStateVerifiedPublisher.AttributeTypes(), tfStateVerifiedPublisher)
 	}
 	if responseApplication.GetWeb() != nil {
-		tfStateWebApplication := applicationWebApplicationModel{}
+		tfStateWebApplication := sharedmodels.WebApplicationModel{}
 		responseWebApplication := responseApplication.GetWeb()
 
 		if responseWebApplication.GetHomePageUrl() != nil {
@@ -3202,7 +3277,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 			tfStateWebApplication.HomePageUrl = types.StringNull()
 		}
 		if responseWebApplication.GetImplicitGrantSettings() != nil {
-			tfStateImplicitGrantSettings := applicationImplicitGrantSettingsModel{}
+			tfStateImplicitGrantSettings := sharedmodels.ImplicitGrantSettingsModel{}
 			responseImplicitGrantSettings := responseWebApplication.GetImplicitGrantSettings()
 
 			if responseImplicitGrantSettings.GetEnableAccessTokenIssuance() != nil {
@@ -3226,8 +3301,13 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		if len(responseWebApplication.GetRedirectUriSettings()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responseRedirectUriSettings := range responseWebApplication.GetRedirectUriSettings() {
-				tfStateRedirectUriSettings := applicationRedirectUriSettingsModel{}
+				tfStateRedirectUriSettings := sharedmodels.RedirectUriSettingsModel{}
 
+				if responseRedirectUriSettings.GetIndex() != nil {
+					tfStateRedirectUriSettings.Index = types.Int64Value(int64(*responseRedirectUriSettings.GetIndex()))
+				} else {
+					tfStateRedirectUriSettings.Index = types.Int64Null()
+				}
 				if responseRedirectUriSettings.GetUri() != nil {
 					tfStateRedirectUriSettings.Uri = types.StringValue(*responseRedirectUriSettings.GetUri())
 				} else {
@@ -3252,6 +3332,14 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		tfStateApplication.Web, _ = types.ObjectValueFrom(ctx, tfStateWebApplication.AttributeTypes(), tfStateWebApplication)
 	}
 
+	// Not set when the resource is imported
+	if tfStateApplication.PreventDeletion.IsNull() {
+		tfStateApplication.PreventDeletion = types.BoolValue(false)
+	}
+	if tfStateApplication.PermanentlyDelete.IsNull() {
+		tfStateApplication.PermanentlyDelete = types.BoolValue(false)
+	}
+
 	// Overwrite items with refreshed state
 	diags := resp.State.Set(ctx, &tfStateApplication)
 	resp.Diagnostics.Append(diags...)
@@ -3264,7 +3352,7 @@ func (d *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *applicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from Terraform plan
-	var tfPlanApplication applicationModel
+	var tfPlanApplication applicationResourceModel
 	diags := req.Plan.Get(ctx, &tfPlanApplication)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -3272,7 +3360,7 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Get current Terraform state
-	var tfStateApplication applicationModel
+	var tfStateApplication applicationResourceModel
 	diags = req.State.Get(ctx, &tfStateApplication)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -3283,152 +3371,252 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	requestBodyApplication := models.NewApplication()
 
 	if !tfPlanApplication.AddIns.Equal(tfStateApplication.AddIns) {
-		var tfPlanAddIns []models.AddInable
-		for k, i := range tfPlanApplication.AddIns.Elements() {
+		var objectArrayAddIns []models.AddInable
+		objectValuesAddIns := []basetypes.ObjectValue{}
+		tfStateElementsAddIns := tfStateApplication.AddIns.Elements()
+		for n, i := range tfPlanApplication.AddIns.Elements() {
 			requestBodyAddIn := models.NewAddIn()
-			tfPlanAddIn := applicationAddInModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAddIn)
-			tfStateAddIn := applicationAddInModel{}
-			types.ListValueFrom(ctx, tfStateApplication.AddIns.Elements()[k].Type(ctx), &tfPlanAddIn)
+			tfPlanAddIn := sharedmodels.AddInModel{}
+			i.(types.Object).As(ctx, &tfPlanAddIn, basetypes.ObjectAsOptions{})
+			tfStateAddIn := sharedmodels.AddInModel{}
+			if n < len(tfStateElementsAddIns) {
+				tfStateElementsAddIns[n].(types.Object).As(ctx, &tfStateAddIn, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanAddIn.Id.Equal(tfStateAddIn.Id) {
+			if tfPlanAddIn.Id.IsUnknown() {
+				tfPlanAddIn.Id = tfStateAddIn.Id
+			}
+			if !tfPlanAddIn.Id.IsNull() {
 				tfPlanId := tfPlanAddIn.Id.ValueString()
 				u, _ := uuid.Parse(tfPlanId)
 				requestBodyAddIn.SetId(&u)
 			}
 
-			if !tfPlanAddIn.Properties.Equal(tfStateAddIn.Properties) {
-				var tfPlanProperties []models.KeyValueable
-				for k, i := range tfPlanAddIn.Properties.Elements() {
+			if tfPlanAddIn.Properties.IsUnknown() {
+				tfPlanAddIn.Properties = tfStateAddIn.Properties
+			}
+			if !tfPlanAddIn.Properties.IsNull() {
+				var objectArrayProperties []models.KeyValueable
+				objectValuesProperties := []basetypes.ObjectValue{}
+				tfStateElementsProperties := tfStateAddIn.Properties.Elements()
+				for n, i := range tfPlanAddIn.Properties.Elements() {
 					requestBodyKeyValue := models.NewKeyValue()
-					tfPlanKeyValue := applicationKeyValueModel{}
-					types.ListValueFrom(ctx, i.Type(ctx), &tfPlanKeyValue)
-					tfStateKeyValue := applicationKeyValueModel{}
-					types.ListValueFrom(ctx, tfStateAddIn.Properties.Elements()[k].Type(ctx), &tfPlanKeyValue)
+					tfPlanKeyValue := sharedmodels.KeyValueModel{}
+					i.(types.Object).As(ctx, &tfPlanKeyValue, basetypes.ObjectAsOptions{})
+					tfStateKeyValue := sharedmodels.KeyValueModel{}
+					if n < len(tfStateElementsProperties) {
+						tfStateElementsProperties[n].(types.Object).As(ctx, &tfStateKeyValue, basetypes.ObjectAsOptions{})
+					}
 
-					if !tfPlanKeyValue.Key.Equal(tfStateKeyValue.Key) {
+					if tfPlanKeyValue.Key.IsUnknown() {
+						tfPlanKeyValue.Key = tfStateKeyValue.Key
+					}
+					if !tfPlanKeyValue.Key.IsNull() {
 						tfPlanKey := tfPlanKeyValue.Key.ValueString()
 						requestBodyKeyValue.SetKey(&tfPlanKey)
 					}
 
-					if !tfPlanKeyValue.Value.Equal(tfStateKeyValue.Value) {
+					if tfPlanKeyValue.Value.IsUnknown() {
+						tfPlanKeyValue.Value = tfStateKeyValue.Value
+					}
+					if !tfPlanKeyValue.Value.IsNull() {
 						tfPlanValue := tfPlanKeyValue.Value.ValueString()
 						requestBodyKeyValue.SetValue(&tfPlanValue)
 					}
+					objectArrayProperties = append(objectArrayProperties, requestBodyKeyValue)
+					objectValue, _ := types.ObjectValueFrom(ctx, tfPlanKeyValue.AttributeTypes(), tfPlanKeyValue)
+					objectValuesProperties = append(objectValuesProperties, objectValue)
 				}
-				requestBodyAddIn.SetProperties(tfPlanProperties)
+				requestBodyAddIn.SetProperties(objectArrayProperties)
+				tfPlanAddIn.Properties, _ = types.ListValueFrom(ctx, tfPlanAddIn.Properties.ElementType(ctx), objectValuesProperties)
 			}
 
-			if !tfPlanAddIn.Type.Equal(tfStateAddIn.Type) {
+			if tfPlanAddIn.Type.IsUnknown() {
+				tfPlanAddIn.Type = tfStateAddIn.Type
+			}
+			if !tfPlanAddIn.Type.IsNull() {
 				tfPlanType := tfPlanAddIn.Type.ValueString()
 				requestBodyAddIn.SetTypeEscaped(&tfPlanType)
 			}
+			objectArrayAddIns = append(objectArrayAddIns, requestBodyAddIn)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanAddIn.AttributeTypes(), tfPlanAddIn)
+			objectValuesAddIns = append(objectValuesAddIns, objectValue)
 		}
-		requestBodyApplication.SetAddIns(tfPlanAddIns)
+		requestBodyApplication.SetAddIns(objectArrayAddIns)
+		tfPlanApplication.AddIns, _ = types.ListValueFrom(ctx, tfPlanApplication.AddIns.ElementType(ctx), objectValuesAddIns)
 	}
 
 	if !tfPlanApplication.Api.Equal(tfStateApplication.Api) {
 		requestBodyApiApplication := models.NewApiApplication()
-		tfPlanApiApplication := applicationApiApplicationModel{}
+		tfPlanApiApplication := sharedmodels.ApiApplicationModel{}
 		tfPlanApplication.Api.As(ctx, &tfPlanApiApplication, basetypes.ObjectAsOptions{})
-		tfStateApiApplication := applicationApiApplicationModel{}
+		tfStateApiApplication := sharedmodels.ApiApplicationModel{}
 		tfStateApplication.Api.As(ctx, &tfStateApiApplication, basetypes.ObjectAsOptions{})
 
-		if !tfPlanApiApplication.AcceptMappedClaims.Equal(tfStateApiApplication.AcceptMappedClaims) {
+		if tfPlanApiApplication.AcceptMappedClaims.IsUnknown() {
+			tfPlanApiApplication.AcceptMappedClaims = tfStateApiApplication.AcceptMappedClaims
+		}
+		if !tfPlanApiApplication.AcceptMappedClaims.IsNull() {
 			tfPlanAcceptMappedClaims := tfPlanApiApplication.AcceptMappedClaims.ValueBool()
 			requestBodyApiApplication.SetAcceptMappedClaims(&tfPlanAcceptMappedClaims)
 		}
 
-		if !tfPlanApiApplication.KnownClientApplications.Equal(tfStateApiApplication.KnownClientApplications) {
-			var KnownClientApplications []uuid.UUID
+		if tfPlanApiApplication.KnownClientApplications.IsUnknown() {
+			tfPlanApiApplication.KnownClientApplications = tfStateApiApplication.KnownClientApplications
+		}
+		if !tfPlanApiApplication.KnownClientApplications.IsNull() {
+			var uuidArrayKnownClientApplications []uuid.UUID
 			for _, i := range tfPlanApiApplication.KnownClientApplications.Elements() {
-				u, _ := uuid.Parse(i.String())
-				KnownClientApplications = append(KnownClientApplications, u)
+				u, _ := uuid.Parse(i.(types.String).ValueString())
+				uuidArrayKnownClientApplications = append(uuidArrayKnownClientApplications, u)
 			}
-			requestBodyApiApplication.SetKnownClientApplications(KnownClientApplications)
+			requestBodyApiApplication.SetKnownClientApplications(uuidArrayKnownClientApplications)
 		}
 
-		if !tfPlanApiApplication.Oauth2PermissionScopes.Equal(tfStateApiApplication.Oauth2PermissionScopes) {
-			var tfPlanOauth2PermissionScopes []models.PermissionScopeable
-			for k, i := range tfPlanApiApplication.Oauth2PermissionScopes.Elements() {
+		if tfPlanApiApplication.Oauth2PermissionScopes.IsUnknown() {
+			tfPlanApiApplication.Oauth2PermissionScopes = tfStateApiApplication.Oauth2PermissionScopes
+		}
+		if !tfPlanApiApplication.Oauth2PermissionScopes.IsNull() {
+			var objectArrayOauth2PermissionScopes []models.PermissionScopeable
+			objectValuesOauth2PermissionScopes := []basetypes.ObjectValue{}
+			tfStateElementsOauth2PermissionScopes := tfStateApiApplication.Oauth2PermissionScopes.Elements()
+			for n, i := range tfPlanApiApplication.Oauth2PermissionScopes.Elements() {
 				requestBodyPermissionScope := models.NewPermissionScope()
-				tfPlanPermissionScope := applicationPermissionScopeModel{}
-				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPermissionScope)
-				tfStatePermissionScope := applicationPermissionScopeModel{}
-				types.ListValueFrom(ctx, tfStateApiApplication.Oauth2PermissionScopes.Elements()[k].Type(ctx), &tfPlanPermissionScope)
+				tfPlanPermissionScope := sharedmodels.PermissionScopeModel{}
+				i.(types.Object).As(ctx, &tfPlanPermissionScope, basetypes.ObjectAsOptions{})
+				tfStatePermissionScope := sharedmodels.PermissionScopeModel{}
+				if n < len(tfStateElementsOauth2PermissionScopes) {
+					tfStateElementsOauth2PermissionScopes[n].(types.Object).As(ctx, &tfStatePermissionScope, basetypes.ObjectAsOptions{})
+				}
 
-				if !tfPlanPermissionScope.AdminConsentDescription.Equal(tfStatePermissionScope.AdminConsentDescription) {
+				if tfPlanPermissionScope.AdminConsentDescription.IsUnknown() {
+					tfPlanPermissionScope.AdminConsentDescription = tfStatePermissionScope.AdminConsentDescription
+				}
+				if !tfPlanPermissionScope.AdminConsentDescription.IsNull() {
 					tfPlanAdminConsentDescription := tfPlanPermissionScope.AdminConsentDescription.ValueString()
 					requestBodyPermissionScope.SetAdminConsentDescription(&tfPlanAdminConsentDescription)
 				}
 
-				if !tfPlanPermissionScope.AdminConsentDisplayName.Equal(tfStatePermissionScope.AdminConsentDisplayName) {
+				if tfPlanPermissionScope.AdminConsentDisplayName.IsUnknown() {
+					tfPlanPermissionScope.AdminConsentDisplayName = tfStatePermissionScope.AdminConsentDisplayName
+				}
+				if !tfPlanPermissionScope.AdminConsentDisplayName.IsNull() {
 					tfPlanAdminConsentDisplayName := tfPlanPermissionScope.AdminConsentDisplayName.ValueString()
 					requestBodyPermissionScope.SetAdminConsentDisplayName(&tfPlanAdminConsentDisplayName)
 				}
 
-				if !tfPlanPermissionScope.Id.Equal(tfStatePermissionScope.Id) {
+				if tfPlanPermissionScope.Id.IsUnknown() {
+					tfPlanPermissionScope.Id = tfStatePermissionScope.Id
+				}
+				if !tfPlanPermissionScope.Id.IsNull() {
 					tfPlanId := tfPlanPermissionScope.Id.ValueString()
 					u, _ := uuid.Parse(tfPlanId)
 					requestBodyPermissionScope.SetId(&u)
 				}
 
-				if !tfPlanPermissionScope.IsEnabled.Equal(tfStatePermissionScope.IsEnabled) {
+				if tfPlanPermissionScope.IsEnabled.IsUnknown() {
+					tfPlanPermissionScope.IsEnabled = tfStatePermissionScope.IsEnabled
+				}
+				if !tfPlanPermissionScope.IsEnabled.IsNull() {
 					tfPlanIsEnabled := tfPlanPermissionScope.IsEnabled.ValueBool()
 					requestBodyPermissionScope.SetIsEnabled(&tfPlanIsEnabled)
 				}
 
-				if !tfPlanPermissionScope.Origin.Equal(tfStatePermissionScope.Origin) {
+				if tfPlanPermissionScope.Origin.IsUnknown() {
+					tfPlanPermissionScope.Origin = tfStatePermissionScope.Origin
+				}
+				if !tfPlanPermissionScope.Origin.IsNull() {
 					tfPlanOrigin := tfPlanPermissionScope.Origin.ValueString()
 					requestBodyPermissionScope.SetOrigin(&tfPlanOrigin)
 				}
 
-				if !tfPlanPermissionScope.Type.Equal(tfStatePermissionScope.Type) {
+				if tfPlanPermissionScope.Type.IsUnknown() {
+					tfPlanPermissionScope.Type = tfStatePermissionScope.Type
+				}
+				if !tfPlanPermissionScope.Type.IsNull() {
 					tfPlanType := tfPlanPermissionScope.Type.ValueString()
 					requestBodyPermissionScope.SetTypeEscaped(&tfPlanType)
 				}
 
-				if !tfPlanPermissionScope.UserConsentDescription.Equal(tfStatePermissionScope.UserConsentDescription) {
+				if tfPlanPermissionScope.UserConsentDescription.IsUnknown() {
+					tfPlanPermissionScope.UserConsentDescription = tfStatePermissionScope.UserConsentDescription
+				}
+				if !tfPlanPermissionScope.UserConsentDescription.IsNull() {
 					tfPlanUserConsentDescription := tfPlanPermissionScope.UserConsentDescription.ValueString()
 					requestBodyPermissionScope.SetUserConsentDescription(&tfPlanUserConsentDescription)
 				}
 
-				if !tfPlanPermissionScope.UserConsentDisplayName.Equal(tfStatePermissionScope.UserConsentDisplayName) {
+				if tfPlanPermissionScope.UserConsentDisplayName.IsUnknown() {
+					tfPlanPermissionScope.UserConsentDisplayName = tfStatePermissionScope.UserConsentDisplayName
+				}
+				if !tfPlanPermissionScope.UserConsentDisplayName.IsNull() {
 					tfPlanUserConsentDisplayName := tfPlanPermissionScope.UserConsentDisplayName.ValueString()
 					requestBodyPermissionScope.SetUserConsentDisplayName(&tfPlanUserConsentDisplayName)
 				}
 
-				if !tfPlanPermissionScope.Value.Equal(tfStatePermissionScope.Value) {
+				if tfPlanPermissionScope.Value.IsUnknown() {
+					tfPlanPermissionScope.Value = tfStatePermissionScope.Value
+				}
+				if !tfPlanPermissionScope.Value.IsNull() {
 					tfPlanValue := tfPlanPermissionScope.Value.ValueString()
 					requestBodyPermissionScope.SetValue(&tfPlanValue)
 				}
+				objectArrayOauth2PermissionScopes = append(objectArrayOauth2PermissionScopes, requestBodyPermissionScope)
+				objectValue, _ := types.ObjectValueFrom(ctx, tfPlanPermissionScope.AttributeTypes(), tfPlanPermissionScope)
+				objectValuesOauth2PermissionScopes = append(objectValuesOauth2PermissionScopes, objectValue)
 			}
-			requestBodyApiApplication.SetOauth2PermissionScopes(tfPlanOauth2PermissionScopes)
+			requestBodyApiApplication.SetOauth2PermissionScopes(objectArrayOauth2PermissionScopes)
+			tfPlanApiApplication.Oauth2PermissionScopes, _ = types.ListValueFrom(ctx, tfPlanApiApplication.Oauth2PermissionScopes.ElementType(ctx), objectValuesOauth2PermissionScopes)
 		}
 
-		if !tfPlanApiApplication.PreAuthorizedApplications.Equal(tfStateApiApplication.PreAuthorizedApplications) {
-			var tfPlanPreAuthorizedApplications []models.PreAuthorizedApplicationable
-			for k, i := range tfPlanApiApplication.PreAuthorizedApplications.Elements() {
+		if tfPlanApiApplication.PreAuthorizedApplications.IsUnknown() {
+			tfPlanApiApplication.PreAuthorizedApplications = tfStateApiApplication.PreAuthorizedApplications
+		}
+		if !tfPlanApiApplication.PreAuthorizedApplications.IsNull() {
+			var objectArrayPreAuthorizedApplications []models.PreAuthorizedApplicationable
+			objectValuesPreAuthorizedApplications := []basetypes.ObjectValue{}
+			tfStateElementsPreAuthorizedApplications := tfStateApiApplication.PreAuthorizedApplications.Elements()
+			for n, i := range tfPlanApiApplication.PreAuthorizedApplications.Elements() {
 				requestBodyPreAuthorizedApplication := models.NewPreAuthorizedApplication()
-				tfPlanPreAuthorizedApplication := applicationPreAuthorizedApplicationModel{}
-				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPreAuthorizedApplication)
-				tfStatePreAuthorizedApplication := applicationPreAuthorizedApplicationModel{}
-				types.ListValueFrom(ctx, tfStateApiApplication.PreAuthorizedApplications.Elements()[k].Type(ctx), &tfPlanPreAuthorizedApplication)
+				tfPlanPreAuthorizedApplication := sharedmodels.PreAuthorizedApplicationModel{}
+				i.(types.Object).As(ctx, &tfPlanPreAuthorizedApplication, basetypes.ObjectAsOptions{})
+				tfStatePreAuthorizedApplication := sharedmodels.PreAuthorizedApplicationModel{}
+				if n < len(tfStateElementsPreAuthorizedApplications) {
+					tfStateElementsPreAuthorizedApplications[n].(types.Object).As(ctx, &tfStatePreAuthorizedApplication, basetypes.ObjectAsOptions{})
+				}
 
-				if !tfPlanPreAuthorizedApplication.AppId.Equal(tfStatePreAuthorizedApplication.AppId) {
+				if tfPlanPreAuthorizedApplication.AppId.IsUnknown() {
+					tfPlanPreAuthorizedApplication.AppId = tfStatePreAuthorizedApplication.AppId
+				}
+				if !tfPlanPreAuthorizedApplication.AppId.IsNull() {
 					tfPlanAppId := tfPlanPreAuthorizedApplication.AppId.ValueString()
 					requestBodyPreAuthorizedApplication.SetAppId(&tfPlanAppId)
 				}
 
-				if !tfPlanPreAuthorizedApplication.DelegatedPermissionIds.Equal(tfStatePreAuthorizedApplication.DelegatedPermissionIds) {
+				if tfPlanPreAuthorizedApplication.DelegatedPermissionIds.IsUnknown() {
+					tfPlanPreAuthorizedApplication.DelegatedPermissionIds = tfStatePreAuthorizedApplication.DelegatedPermissionIds
+				}
+				if !tfPlanPreAuthorizedApplication.DelegatedPermissionIds.IsNull() {
 					var stringArrayDelegatedPermissionIds []string
 					for _, i := range tfPlanPreAuthorizedApplication.DelegatedPermissionIds.Elements() {
-						stringArrayDelegatedPermissionIds = append(stringArrayDelegatedPermissionIds, i.String())
+						stringArrayDelegatedPermissionIds = append(stringArrayDelegatedPermissionIds, i.(types.String).ValueString())
 					}
 					requestBodyPreAuthorizedApplication.SetDelegatedPermissionIds(stringArrayDelegatedPermissionIds)
 				}
+				objectArrayPreAuthorizedApplications = append(objectArrayPreAuthorizedApplications, requestBodyPreAuthorizedApplication)
+				objectValue, _ := types.ObjectValueFrom(ctx, tfPlanPreAuthorizedApplication.AttributeTypes(), tfPlanPreAuthorizedApplication)
+				objectValuesPreAuthorizedApplications = append(objectValuesPreAuthorizedApplications, objectValue)
 			}
-			requestBodyApiApplication.SetPreAuthorizedApplications(tfPlanPreAuthorizedApplications)
+			requestBodyApiApplication.SetPreAuthorizedApplications(objectArrayPreAuthorizedApplications)
+			tfPlanApiApplication.PreAuthorizedApplications, _ = types.ListValueFrom(ctx, tfPlanApiApplication.PreAuthorizedApplications.ElementType(ctx), objectValuesPreAuthorizedApplications)
+		}
+
+		if tfPlanApiApplication.RequestedAccessTokenVersion.IsUnknown() {
+			tfPlanApiApplication.RequestedAccessTokenVersion = tfStateApiApplication.RequestedAccessTokenVersion
+		}
+		if !tfPlanApiApplication.RequestedAccessTokenVersion.IsNull() {
+			tfPlanRequestedAccessTokenVersion := int32(tfPlanApiApplication.RequestedAccessTokenVersion.ValueInt64())
+			requestBodyApiApplication.SetRequestedAccessTokenVersion(&tfPlanRequestedAccessTokenVersion)
 		}
 		requestBodyApplication.SetApi(requestBodyApiApplication)
 		tfPlanApplication.Api, _ = types.ObjectValueFrom(ctx, tfPlanApiApplication.AttributeTypes(), tfPlanApiApplication)
@@ -3440,54 +3628,83 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if !tfPlanApplication.AppRoles.Equal(tfStateApplication.AppRoles) {
-		var tfPlanAppRoles []models.AppRoleable
-		for k, i := range tfPlanApplication.AppRoles.Elements() {
+		var objectArrayAppRoles []models.AppRoleable
+		objectValuesAppRoles := []basetypes.ObjectValue{}
+		tfStateElementsAppRoles := tfStateApplication.AppRoles.Elements()
+		for n, i := range tfPlanApplication.AppRoles.Elements() {
 			requestBodyAppRole := models.NewAppRole()
-			tfPlanAppRole := applicationAppRoleModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAppRole)
-			tfStateAppRole := applicationAppRoleModel{}
-			types.ListValueFrom(ctx, tfStateApplication.AppRoles.Elements()[k].Type(ctx), &tfPlanAppRole)
+			tfPlanAppRole := sharedmodels.AppRoleModel{}
+			i.(types.Object).As(ctx, &tfPlanAppRole, basetypes.ObjectAsOptions{})
+			tfStateAppRole := sharedmodels.AppRoleModel{}
+			if n < len(tfStateElementsAppRoles) {
+				tfStateElementsAppRoles[n].(types.Object).As(ctx, &tfStateAppRole, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanAppRole.AllowedMemberTypes.Equal(tfStateAppRole.AllowedMemberTypes) {
+			if tfPlanAppRole.AllowedMemberTypes.IsUnknown() {
+				tfPlanAppRole.AllowedMemberTypes = tfStateAppRole.AllowedMemberTypes
+			}
+			if !tfPlanAppRole.AllowedMemberTypes.IsNull() {
 				var stringArrayAllowedMemberTypes []string
 				for _, i := range tfPlanAppRole.AllowedMemberTypes.Elements() {
-					stringArrayAllowedMemberTypes = append(stringArrayAllowedMemberTypes, i.String())
+					stringArrayAllowedMemberTypes = append(stringArrayAllowedMemberTypes, i.(types.String).ValueString())
 				}
 				requestBodyAppRole.SetAllowedMemberTypes(stringArrayAllowedMemberTypes)
 			}
 
-			if !tfPlanAppRole.Description.Equal(tfStateAppRole.Description) {
+			if tfPlanAppRole.Description.IsUnknown() {
+				tfPlanAppRole.Description = tfStateAppRole.Description
+			}
+			if !tfPlanAppRole.Description.IsNull() {
 				tfPlanDescription := tfPlanAppRole.Description.ValueString()
 				requestBodyAppRole.SetDescription(&tfPlanDescription)
 			}
 
-			if !tfPlanAppRole.DisplayName.Equal(tfStateAppRole.DisplayName) {
+			if tfPlanAppRole.DisplayName.IsUnknown() {
+				tfPlanAppRole.DisplayName = tfStateAppRole.DisplayName
+			}
+			if !tfPlanAppRole.DisplayName.IsNull() {
 				tfPlanDisplayName := tfPlanAppRole.DisplayName.ValueString()
 				requestBodyAppRole.SetDisplayName(&tfPlanDisplayName)
 			}
 
-			if !tfPlanAppRole.Id.Equal(tfStateAppRole.Id) {
+			if tfPlanAppRole.Id.IsUnknown() {
+				tfPlanAppRole.Id = tfStateAppRole.Id
+			}
+			if !tfPlanAppRole.Id.IsNull() {
 				tfPlanId := tfPlanAppRole.Id.ValueString()
 				u, _ := uuid.Parse(tfPlanId)
 				requestBodyAppRole.SetId(&u)
 			}
 
-			if !tfPlanAppRole.IsEnabled.Equal(tfStateAppRole.IsEnabled) {
+			if tfPlanAppRole.IsEnabled.IsUnknown() {
+				tfPlanAppRole.IsEnabled = tfStateAppRole.IsEnabled
+			}
+			if !tfPlanAppRole.IsEnabled.IsNull() {
 				tfPlanIsEnabled := tfPlanAppRole.IsEnabled.ValueBool()
 				requestBodyAppRole.SetIsEnabled(&tfPlanIsEnabled)
 			}
 
-			if !tfPlanAppRole.Origin.Equal(tfStateAppRole.Origin) {
+			if tfPlanAppRole.Origin.IsUnknown() {
+				tfPlanAppRole.Origin = tfStateAppRole.Origin
+			}
+			if !tfPlanAppRole.Origin.IsNull() {
 				tfPlanOrigin := tfPlanAppRole.Origin.ValueString()
 				requestBodyAppRole.SetOrigin(&tfPlanOrigin)
 			}
 
-			if !tfPlanAppRole.Value.Equal(tfStateAppRole.Value) {
+			if tfPlanAppRole.Value.IsUnknown() {
+				tfPlanAppRole.Value = tfStateAppRole.Value
+			}
+			if !tfPlanAppRole.Value.IsNull() {
 				tfPlanValue := tfPlanAppRole.Value.ValueString()
 				requestBodyAppRole.SetValue(&tfPlanValue)
 			}
+			objectArrayAppRoles = append(objectArrayAppRoles, requestBodyAppRole)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanAppRole.AttributeTypes(), tfPlanAppRole)
+			objectValuesAppRoles = append(objectValuesAppRoles, objectValue)
 		}
-		requestBodyApplication.SetAppRoles(tfPlanAppRoles)
+		requestBodyApplication.SetAppRoles(objectArrayAppRoles)
+		tfPlanApplication.AppRoles, _ = types.ListValueFrom(ctx, tfPlanApplication.AppRoles.ElementType(ctx), objectValuesAppRoles)
 	}
 
 	if !tfPlanApplication.ApplicationTemplateId.Equal(tfStateApplication.ApplicationTemplateId) {
@@ -3497,33 +3714,48 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !tfPlanApplication.Certification.Equal(tfStateApplication.Certification) {
 		requestBodyCertification := models.NewCertification()
-		tfPlanCertification := applicationCertificationModel{}
+		tfPlanCertification := sharedmodels.CertificationModel{}
 		tfPlanApplication.Certification.As(ctx, &tfPlanCertification, basetypes.ObjectAsOptions{})
-		tfStateCertification := applicationCertificationModel{}
+		tfStateCertification := sharedmodels.CertificationModel{}
 		tfStateApplication.Certification.As(ctx, &tfStateCertification, basetypes.ObjectAsOptions{})
 
-		if !tfPlanCertification.CertificationDetailsUrl.Equal(tfStateCertification.CertificationDetailsUrl) {
+		if tfPlanCertification.CertificationDetailsUrl.IsUnknown() {
+			tfPlanCertification.CertificationDetailsUrl = tfStateCertification.CertificationDetailsUrl
+		}
+		if !tfPlanCertification.CertificationDetailsUrl.IsNull() {
 			tfPlanCertificationDetailsUrl := tfPlanCertification.CertificationDetailsUrl.ValueString()
 			requestBodyCertification.SetCertificationDetailsUrl(&tfPlanCertificationDetailsUrl)
 		}
 
-		if !tfPlanCertification.CertificationExpirationDateTime.Equal(tfStateCertification.CertificationExpirationDateTime) {
+		if tfPlanCertification.CertificationExpirationDateTime.IsUnknown() {
+			tfPlanCertification.CertificationExpirationDateTime = tfStateCertification.CertificationExpirationDateTime
+		}
+		if !tfPlanCertification.CertificationExpirationDateTime.IsNull() {
 			tfPlanCertificationExpirationDateTime := tfPlanCertification.CertificationExpirationDateTime.ValueString()
 			t, _ := time.Parse(time.RFC3339, tfPlanCertificationExpirationDateTime)
 			requestBodyCertification.SetCertificationExpirationDateTime(&t)
 		}
 
-		if !tfPlanCertification.IsCertifiedByMicrosoft.Equal(tfStateCertification.IsCertifiedByMicrosoft) {
+		if tfPlanCertification.IsCertifiedByMicrosoft.IsUnknown() {
+			tfPlanCertification.IsCertifiedByMicrosoft = tfStateCertification.IsCertifiedByMicrosoft
+		}
+		if !tfPlanCertification.IsCertifiedByMicrosoft.IsNull() {
 			tfPlanIsCertifiedByMicrosoft := tfPlanCertification.IsCertifiedByMicrosoft.ValueBool()
 			requestBodyCertification.SetIsCertifiedByMicrosoft(&tfPlanIsCertifiedByMicrosoft)
 		}
 
-		if !tfPlanCertification.IsPublisherAttested.Equal(tfStateCertification.IsPublisherAttested) {
+		if tfPlanCertification.IsPublisherAttested.IsUnknown() {
+			tfPlanCertification.IsPublisherAttested = tfStateCertification.IsPublisherAttested
+		}
+		if !tfPlanCertification.IsPublisherAttested.IsNull() {
 			tfPlanIsPublisherAttested := tfPlanCertification.IsPublisherAttested.ValueBool()
 			requestBodyCertification.SetIsPublisherAttested(&tfPlanIsPublisherAttested)
 		}
 
-		if !tfPlanCertification.LastCertificationDateTime.Equal(tfStateCertification.LastCertificationDateTime) {
+		if tfPlanCertification.LastCertificationDateTime.IsUnknown() {
+			tfPlanCertification.LastCertificationDateTime = tfStateCertification.LastCertificationDateTime
+		}
+		if !tfPlanCertification.LastCertificationDateTime.IsNull() {
 			tfPlanLastCertificationDateTime := tfPlanCertification.LastCertificationDateTime.ValueString()
 			t, _ := time.Parse(time.RFC3339, tfPlanLastCertificationDateTime)
 			requestBodyCertification.SetLastCertificationDateTime(&t)
@@ -3577,39 +3809,54 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	if !tfPlanApplication.IdentifierUris.Equal(tfStateApplication.IdentifierUris) {
 		var stringArrayIdentifierUris []string
 		for _, i := range tfPlanApplication.IdentifierUris.Elements() {
-			stringArrayIdentifierUris = append(stringArrayIdentifierUris, i.String())
+			stringArrayIdentifierUris = append(stringArrayIdentifierUris, i.(types.String).ValueString())
 		}
 		requestBodyApplication.SetIdentifierUris(stringArrayIdentifierUris)
 	}
 
 	if !tfPlanApplication.Info.Equal(tfStateApplication.Info) {
 		requestBodyInformationalUrl := models.NewInformationalUrl()
-		tfPlanInformationalUrl := applicationInformationalUrlModel{}
+		tfPlanInformationalUrl := sharedmodels.InformationalUrlModel{}
 		tfPlanApplication.Info.As(ctx, &tfPlanInformationalUrl, basetypes.ObjectAsOptions{})
-		tfStateInformationalUrl := applicationInformationalUrlModel{}
+		tfStateInformationalUrl := sharedmodels.InformationalUrlModel{}
 		tfStateApplication.Info.As(ctx, &tfStateInformationalUrl, basetypes.ObjectAsOptions{})
 
-		if !tfPlanInformationalUrl.LogoUrl.Equal(tfStateInformationalUrl.LogoUrl) {
+		if tfPlanInformationalUrl.LogoUrl.IsUnknown() {
+			tfPlanInformationalUrl.LogoUrl = tfStateInformationalUrl.LogoUrl
+		}
+		if !tfPlanInformationalUrl.LogoUrl.IsNull() {
 			tfPlanLogoUrl := tfPlanInformationalUrl.LogoUrl.ValueString()
 			requestBodyInformationalUrl.SetLogoUrl(&tfPlanLogoUrl)
 		}
 
-		if !tfPlanInformationalUrl.MarketingUrl.Equal(tfStateInformationalUrl.MarketingUrl) {
+		if tfPlanInformationalUrl.MarketingUrl.IsUnknown() {
+			tfPlanInformationalUrl.MarketingUrl = tfStateInformationalUrl.MarketingUrl
+		}
+		if !tfPlanInformationalUrl.MarketingUrl.IsNull() {
 			tfPlanMarketingUrl := tfPlanInformationalUrl.MarketingUrl.ValueString()
 			requestBodyInformationalUrl.SetMarketingUrl(&tfPlanMarketingUrl)
 		}
 
-		if !tfPlanInformationalUrl.PrivacyStatementUrl.Equal(tfStateInformationalUrl.PrivacyStatementUrl) {
+		if tfPlanInformationalUrl.PrivacyStatementUrl.IsUnknown() {
+			tfPlanInformationalUrl.PrivacyStatementUrl = tfStateInformationalUrl.PrivacyStatementUrl
+		}
+		if !tfPlanInformationalUrl.PrivacyStatementUrl.IsNull() {
 			tfPlanPrivacyStatementUrl := tfPlanInformationalUrl.PrivacyStatementUrl.ValueString()
 			requestBodyInformationalUrl.SetPrivacyStatementUrl(&tfPlanPrivacyStatementUrl)
 		}
 
-		if !tfPlanInformationalUrl.SupportUrl.Equal(tfStateInformationalUrl.SupportUrl) {
+		if tfPlanInformationalUrl.SupportUrl.IsUnknown() {
+			tfPlanInformationalUrl.SupportUrl = tfStateInformationalUrl.SupportUrl
+		}
+		if !tfPlanInformationalUrl.SupportUrl.IsNull() {
 			tfPlanSupportUrl := tfPlanInformationalUrl.SupportUrl.ValueString()
 			requestBodyInformationalUrl.SetSupportUrl(&tfPlanSupportUrl)
 		}
 
-		if !tfPlanInformationalUrl.TermsOfServiceUrl.Equal(tfStateInformationalUrl.TermsOfServiceUrl) {
+		if tfPlanInformationalUrl.TermsOfServiceUrl.IsUnknown() {
+			tfPlanInformationalUrl.TermsOfServiceUrl = tfStateInformationalUrl.TermsOfServiceUrl
+		}
+		if !tfPlanInformationalUrl.TermsOfServiceUrl.IsNull() {
 			tfPlanTermsOfServiceUrl := tfPlanInformationalUrl.TermsOfServiceUrl.ValueString()
 			requestBodyInformationalUrl.SetTermsOfServiceUrl(&tfPlanTermsOfServiceUrl)
 		}
@@ -3628,58 +3875,90 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if !tfPlanApplication.KeyCredentials.Equal(tfStateApplication.KeyCredentials) {
-		var tfPlanKeyCredentials []models.KeyCredentialable
-		for k, i := range tfPlanApplication.KeyCredentials.Elements() {
+		var objectArrayKeyCredentials []models.KeyCredentialable
+		objectValuesKeyCredentials := []basetypes.ObjectValue{}
+		tfStateElementsKeyCredentials := tfStateApplication.KeyCredentials.Elements()
+		for n, i := range tfPlanApplication.KeyCredentials.Elements() {
 			requestBodyKeyCredential := models.NewKeyCredential()
-			tfPlanKeyCredential := applicationKeyCredentialModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanKeyCredential)
-			tfStateKeyCredential := applicationKeyCredentialModel{}
-			types.ListValueFrom(ctx, tfStateApplication.KeyCredentials.Elements()[k].Type(ctx), &tfPlanKeyCredential)
+			tfPlanKeyCredential := sharedmodels.KeyCredentialModel{}
+			i.(types.Object).As(ctx, &tfPlanKeyCredential, basetypes.ObjectAsOptions{})
+			tfStateKeyCredential := sharedmodels.KeyCredentialModel{}
+			if n < len(tfStateElementsKeyCredentials) {
+				tfStateElementsKeyCredentials[n].(types.Object).As(ctx, &tfStateKeyCredential, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanKeyCredential.CustomKeyIdentifier.Equal(tfStateKeyCredential.CustomKeyIdentifier) {
+			if tfPlanKeyCredential.CustomKeyIdentifier.IsUnknown() {
+				tfPlanKeyCredential.CustomKeyIdentifier = tfStateKeyCredential.CustomKeyIdentifier
+			}
+			if !tfPlanKeyCredential.CustomKeyIdentifier.IsNull() {
 				tfPlanCustomKeyIdentifier := tfPlanKeyCredential.CustomKeyIdentifier.ValueString()
 				requestBodyKeyCredential.SetCustomKeyIdentifier([]byte(tfPlanCustomKeyIdentifier))
 			}
 
-			if !tfPlanKeyCredential.DisplayName.Equal(tfStateKeyCredential.DisplayName) {
+			if tfPlanKeyCredential.DisplayName.IsUnknown() {
+				tfPlanKeyCredential.DisplayName = tfStateKeyCredential.DisplayName
+			}
+			if !tfPlanKeyCredential.DisplayName.IsNull() {
 				tfPlanDisplayName := tfPlanKeyCredential.DisplayName.ValueString()
 				requestBodyKeyCredential.SetDisplayName(&tfPlanDisplayName)
 			}
 
-			if !tfPlanKeyCredential.EndDateTime.Equal(tfStateKeyCredential.EndDateTime) {
+			if tfPlanKeyCredential.EndDateTime.IsUnknown() {
+				tfPlanKeyCredential.EndDateTime = tfStateKeyCredential.EndDateTime
+			}
+			if !tfPlanKeyCredential.EndDateTime.IsNull() {
 				tfPlanEndDateTime := tfPlanKeyCredential.EndDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanEndDateTime)
 				requestBodyKeyCredential.SetEndDateTime(&t)
 			}
 
-			if !tfPlanKeyCredential.Key.Equal(tfStateKeyCredential.Key) {
+			if tfPlanKeyCredential.Key.IsUnknown() {
+				tfPlanKeyCredential.Key = tfStateKeyCredential.Key
+			}
+			if !tfPlanKeyCredential.Key.IsNull() {
 				tfPlanKey := tfPlanKeyCredential.Key.ValueString()
 				requestBodyKeyCredential.SetKey([]byte(tfPlanKey))
 			}
 
-			if !tfPlanKeyCredential.KeyId.Equal(tfStateKeyCredential.KeyId) {
+			if tfPlanKeyCredential.KeyId.IsUnknown() {
+				tfPlanKeyCredential.KeyId = tfStateKeyCredential.KeyId
+			}
+			if !tfPlanKeyCredential.KeyId.IsNull() {
 				tfPlanKeyId := tfPlanKeyCredential.KeyId.ValueString()
 				u, _ := uuid.Parse(tfPlanKeyId)
 				requestBodyKeyCredential.SetKeyId(&u)
 			}
 
-			if !tfPlanKeyCredential.StartDateTime.Equal(tfStateKeyCredential.StartDateTime) {
+			if tfPlanKeyCredential.StartDateTime.IsUnknown() {
+				tfPlanKeyCredential.StartDateTime = tfStateKeyCredential.StartDateTime
+			}
+			if !tfPlanKeyCredential.StartDateTime.IsNull() {
 				tfPlanStartDateTime := tfPlanKeyCredential.StartDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanStartDateTime)
 				requestBodyKeyCredential.SetStartDateTime(&t)
 			}
 
-			if !tfPlanKeyCredential.Type.Equal(tfStateKeyCredential.Type) {
+			if tfPlanKeyCredential.Type.IsUnknown() {
+				tfPlanKeyCredential.Type = tfStateKeyCredential.Type
+			}
+			if !tfPlanKeyCredential.Type.IsNull() {
 				tfPlanType := tfPlanKeyCredential.Type.ValueString()
 				requestBodyKeyCredential.SetTypeEscaped(&tfPlanType)
 			}
 
-			if !tfPlanKeyCredential.Usage.Equal(tfStateKeyCredential.Usage) {
+			if tfPlanKeyCredential.Usage.IsUnknown() {
+				tfPlanKeyCredential.Usage = tfStateKeyCredential.Usage
+			}
+			if !tfPlanKeyCredential.Usage.IsNull() {
 				tfPlanUsage := tfPlanKeyCredential.Usage.ValueString()
 				requestBodyKeyCredential.SetUsage(&tfPlanUsage)
 			}
+			objectArrayKeyCredentials = append(objectArrayKeyCredentials, requestBodyKeyCredential)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanKeyCredential.AttributeTypes(), tfPlanKeyCredential)
+			objectValuesKeyCredentials = append(objectValuesKeyCredentials, objectValue)
 		}
-		requestBodyApplication.SetKeyCredentials(tfPlanKeyCredentials)
+		requestBodyApplication.SetKeyCredentials(objectArrayKeyCredentials)
+		tfPlanApplication.KeyCredentials, _ = types.ListValueFrom(ctx, tfPlanApplication.KeyCredentials.ElementType(ctx), objectValuesKeyCredentials)
 	}
 
 	if !tfPlanApplication.Logo.Equal(tfStateApplication.Logo) {
@@ -3706,114 +3985,183 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !tfPlanApplication.OptionalClaims.Equal(tfStateApplication.OptionalClaims) {
 		requestBodyOptionalClaims := models.NewOptionalClaims()
-		tfPlanOptionalClaims := applicationOptionalClaimsModel{}
+		tfPlanOptionalClaims := sharedmodels.OptionalClaimsModel{}
 		tfPlanApplication.OptionalClaims.As(ctx, &tfPlanOptionalClaims, basetypes.ObjectAsOptions{})
-		tfStateOptionalClaims := applicationOptionalClaimsModel{}
+		tfStateOptionalClaims := sharedmodels.OptionalClaimsModel{}
 		tfStateApplication.OptionalClaims.As(ctx, &tfStateOptionalClaims, basetypes.ObjectAsOptions{})
 
-		if !tfPlanOptionalClaims.AccessToken.Equal(tfStateOptionalClaims.AccessToken) {
-			var tfPlanAccessToken []models.OptionalClaimable
-			for k, i := range tfPlanOptionalClaims.AccessToken.Elements() {
+		if tfPlanOptionalClaims.AccessToken.IsUnknown() {
+			tfPlanOptionalClaims.AccessToken = tfStateOptionalClaims.AccessToken
+		}
+		if !tfPlanOptionalClaims.AccessToken.IsNull() {
+			var objectArrayAccessToken []models.OptionalClaimable
+			objectValuesAccessToken := []basetypes.ObjectValue{}
+			tfStateElementsAccessToken := tfStateOptionalClaims.AccessToken.Elements()
+			for n, i := range tfPlanOptionalClaims.AccessToken.Elements() {
 				requestBodyOptionalClaim := models.NewOptionalClaim()
-				tfPlanOptionalClaim := applicationOptionalClaimModel{}
-				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOptionalClaim)
-				tfStateOptionalClaim := applicationOptionalClaimModel{}
-				types.ListValueFrom(ctx, tfStateOptionalClaims.AccessToken.Elements()[k].Type(ctx), &tfPlanOptionalClaim)
+				tfPlanOptionalClaim := sharedmodels.OptionalClaimModel{}
+				i.(types.Object).As(ctx, &tfPlanOptionalClaim, basetypes.ObjectAsOptions{})
+				tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
+				if n < len(tfStateElementsAccessToken) {
+					tfStateElementsAccessToken[n].(types.Object).As(ctx, &tfStateOptionalClaim, basetypes.ObjectAsOptions{})
+				}
 
-				if !tfPlanOptionalClaim.AdditionalProperties.Equal(tfStateOptionalClaim.AdditionalProperties) {
+				if tfPlanOptionalClaim.AdditionalProperties.IsUnknown() {
+					tfPlanOptionalClaim.AdditionalProperties = tfStateOptionalClaim.AdditionalProperties
+				}
+				if !tfPlanOptionalClaim.AdditionalProperties.IsNull() {
 					var stringArrayAdditionalProperties []string
 					for _, i := range tfPlanOptionalClaim.AdditionalProperties.Elements() {
-						stringArrayAdditionalProperties = append(stringArrayAdditionalProperties, i.String())
+						stringArrayAdditionalProperties = append(stringArrayAdditionalProperties, i.(types.String).ValueString())
 					}
 					requestBodyOptionalClaim.SetAdditionalProperties(stringArrayAdditionalProperties)
 				}
 
-				if !tfPlanOptionalClaim.Essential.Equal(tfStateOptionalClaim.Essential) {
+				if tfPlanOptionalClaim.Essential.IsUnknown() {
+					tfPlanOptionalClaim.Essential = tfStateOptionalClaim.Essential
+				}
+				if !tfPlanOptionalClaim.Essential.IsNull() {
 					tfPlanEssential := tfPlanOptionalClaim.Essential.ValueBool()
 					requestBodyOptionalClaim.SetEssential(&tfPlanEssential)
 				}
 
-				if !tfPlanOptionalClaim.Name.Equal(tfStateOptionalClaim.Name) {
+				if tfPlanOptionalClaim.Name.IsUnknown() {
+					tfPlanOptionalClaim.Name = tfStateOptionalClaim.Name
+				}
+				if !tfPlanOptionalClaim.Name.IsNull() {
 					tfPlanName := tfPlanOptionalClaim.Name.ValueString()
 					requestBodyOptionalClaim.SetName(&tfPlanName)
 				}
 
-				if !tfPlanOptionalClaim.Source.Equal(tfStateOptionalClaim.Source) {
+				if tfPlanOptionalClaim.Source.IsUnknown() {
+					tfPlanOptionalClaim.Source = tfStateOptionalClaim.Source
+				}
+				if !tfPlanOptionalClaim.Source.IsNull() {
 					tfPlanSource := tfPlanOptionalClaim.Source.ValueString()
 					requestBodyOptionalClaim.SetSource(&tfPlanSource)
 				}
+				objectArrayAccessToken = append(objectArrayAccessToken, requestBodyOptionalClaim)
+				objectValue, _ := types.ObjectValueFrom(ctx, tfPlanOptionalClaim.AttributeTypes(), tfPlanOptionalClaim)
+				objectValuesAccessToken = append(objectValuesAccessToken, objectValue)
 			}
-			requestBodyOptionalClaims.SetAccessToken(tfPlanAccessToken)
+			requestBodyOptionalClaims.SetAccessToken(objectArrayAccessToken)
+			tfPlanOptionalClaims.AccessToken, _ = types.ListValueFrom(ctx, tfPlanOptionalClaims.AccessToken.ElementType(ctx), objectValuesAccessToken)
 		}
 
-		if !tfPlanOptionalClaims.IdToken.Equal(tfStateOptionalClaims.IdToken) {
-			var tfPlanIdToken []models.OptionalClaimable
-			for k, i := range tfPlanOptionalClaims.IdToken.Elements() {
+		if tfPlanOptionalClaims.IdToken.IsUnknown() {
+			tfPlanOptionalClaims.IdToken = tfStateOptionalClaims.IdToken
+		}
+		if !tfPlanOptionalClaims.IdToken.IsNull() {
+			var objectArrayIdToken []models.OptionalClaimable
+			objectValuesIdToken := []basetypes.ObjectValue{}
+			tfStateElementsIdToken := tfStateOptionalClaims.IdToken.Elements()
+			for n, i := range tfPlanOptionalClaims.IdToken.Elements() {
 				requestBodyOptionalClaim := models.NewOptionalClaim()
-				tfPlanOptionalClaim := applicationOptionalClaimModel{}
-				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOptionalClaim)
-				tfStateOptionalClaim := applicationOptionalClaimModel{}
-				types.ListValueFrom(ctx, tfStateOptionalClaims.IdToken.Elements()[k].Type(ctx), &tfPlanOptionalClaim)
+				tfPlanOptionalClaim := sharedmodels.OptionalClaimModel{}
+				i.(types.Object).As(ctx, &tfPlanOptionalClaim, basetypes.ObjectAsOptions{})
+				tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
+				if n < len(tfStateElementsIdToken) {
+					tfStateElementsIdToken[n].(types.Object).As(ctx, &tfStateOptionalClaim, basetypes.ObjectAsOptions{})
+				}
 
-				if !tfPlanOptionalClaim.AdditionalProperties.Equal(tfStateOptionalClaim.AdditionalProperties) {
+				if tfPlanOptionalClaim.AdditionalProperties.IsUnknown() {
+					tfPlanOptionalClaim.AdditionalProperties = tfStateOptionalClaim.AdditionalProperties
+				}
+				if !tfPlanOptionalClaim.AdditionalProperties.IsNull() {
 					var stringArrayAdditionalProperties []string
 					for _, i := range tfPlanOptionalClaim.AdditionalProperties.Elements() {
-						stringArrayAdditionalProperties = append(stringArrayAdditionalProperties, i.String())
+						stringArrayAdditionalProperties = append(stringArrayAdditionalProperties, i.(types.String).ValueString())
 					}
 					requestBodyOptionalClaim.SetAdditionalProperties(stringArrayAdditionalProperties)
 				}
 
-				if !tfPlanOptionalClaim.Essential.Equal(tfStateOptionalClaim.Essential) {
+				if tfPlanOptionalClaim.Essential.IsUnknown() {
+					tfPlanOptionalClaim.Essential = tfStateOptionalClaim.Essential
+				}
+				if !tfPlanOptionalClaim.Essential.IsNull() {
 					tfPlanEssential := tfPlanOptionalClaim.Essential.ValueBool()
 					requestBodyOptionalClaim.SetEssential(&tfPlanEssential)
 				}
 
-				if !tfPlanOptionalClaim.Name.Equal(tfStateOptionalClaim.Name) {
+				if tfPlanOptionalClaim.Name.IsUnknown() {
+					tfPlanOptionalClaim.Name = tfStateOptionalClaim.Name
+				}
+				if !tfPlanOptionalClaim.Name.IsNull() {
 					tfPlanName := tfPlanOptionalClaim.Name.ValueString()
 					requestBodyOptionalClaim.SetName(&tfPlanName)
 				}
 
-				if !tfPlanOptionalClaim.Source.Equal(tfStateOptionalClaim.Source) {
+				if tfPlanOptionalClaim.Source.IsUnknown() {
+					tfPlanOptionalClaim.Source = tfStateOptionalClaim.Source
+				}
+				if !tfPlanOptionalClaim.Source.IsNull() {
 					tfPlanSource := tfPlanOptionalClaim.Source.ValueString()
 					requestBodyOptionalClaim.SetSource(&tfPlanSource)
 				}
+				objectArrayIdToken = append(objectArrayIdToken, requestBodyOptionalClaim)
+				objectValue, _ := types.ObjectValueFrom(ctx, tfPlanOptionalClaim.AttributeTypes(), tfPlanOptionalClaim)
+				objectValuesIdToken = append(objectValuesIdToken, objectValue)
 			}
-			requestBodyOptionalClaims.SetIdToken(tfPlanIdToken)
+			requestBodyOptionalClaims.SetIdToken(objectArrayIdToken)
+			tfPlanOptionalClaims.IdToken, _ = types.ListValueFrom(ctx, tfPlanOptionalClaims.IdToken.ElementType(ctx), objectValuesIdToken)
 		}
 
-		if !tfPlanOptionalClaims.Saml2Token.Equal(tfStateOptionalClaims.Saml2Token) {
-			var tfPlanSaml2Token []models.OptionalClaimable
-			for k, i := range tfPlanOptionalClaims.Saml2Token.Elements() {
+		if tfPlanOptionalClaims.Saml2Token.IsUnknown() {
+			tfPlanOptionalClaims.Saml2Token = tfStateOptionalClaims.Saml2Token
+		}
+		if !tfPlanOptionalClaims.Saml2Token.IsNull() {
+			var objectArraySaml2Token []models.OptionalClaimable
+			objectValuesSaml2Token := []basetypes.ObjectValue{}
+			tfStateElementsSaml2Token := tfStateOptionalClaims.Saml2Token.Elements()
+			for n, i := range tfPlanOptionalClaims.Saml2Token.Elements() {
 				requestBodyOptionalClaim := models.NewOptionalClaim()
-				tfPlanOptionalClaim := applicationOptionalClaimModel{}
-				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOptionalClaim)
-				tfStateOptionalClaim := applicationOptionalClaimModel{}
-				types.ListValueFrom(ctx, tfStateOptionalClaims.Saml2Token.Elements()[k].Type(ctx), &tfPlanOptionalClaim)
+				tfPlanOptionalClaim := sharedmodels.OptionalClaimModel{}
+				i.(types.Object).As(ctx, &tfPlanOptionalClaim, basetypes.ObjectAsOptions{})
+				tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
+				if n < len(tfStateElementsSaml2Token) {
+					tfStateElementsSaml2Token[n].(types.Object).As(ctx, &tfStateOptionalClaim, basetypes.ObjectAsOptions{})
+				}
 
-				if !tfPlanOptionalClaim.AdditionalProperties.Equal(tfStateOptionalClaim.AdditionalProperties) {
+				if tfPlanOptionalClaim.AdditionalProperties.IsUnknown() {
+					tfPlanOptionalClaim.AdditionalProperties = tfStateOptionalClaim.AdditionalProperties
+				}
+				if !tfPlanOptionalClaim.AdditionalProperties.IsNull() {
 					var stringArrayAdditionalProperties []string
 					for _, i := range tfPlanOptionalClaim.AdditionalProperties.Elements() {
-						stringArrayAdditionalProperties = append(stringArrayAdditionalProperties, i.String())
+						stringArrayAdditionalProperties = append(stringArrayAdditionalProperties, i.(types.String).ValueString())
 					}
 					requestBodyOptionalClaim.SetAdditionalProperties(stringArrayAdditionalProperties)
 				}
 
-				if !tfPlanOptionalClaim.Essential.Equal(tfStateOptionalClaim.Essential) {
+				if tfPlanOptionalClaim.Essential.IsUnknown() {
+					tfPlanOptionalClaim.Essential = tfStateOptionalClaim.Essential
+				}
+				if !tfPlanOptionalClaim.Essential.IsNull() {
 					tfPlanEssential := tfPlanOptionalClaim.Essential.ValueBool()
 					requestBodyOptionalClaim.SetEssential(&tfPlanEssential)
 				}
 
-				if !tfPlanOptionalClaim.Name.Equal(tfStateOptionalClaim.Name) {
+				if tfPlanOptionalClaim.Name.IsUnknown() {
+					tfPlanOptionalClaim.Name = tfStateOptionalClaim.Name
+				}
+				if !tfPlanOptionalClaim.Name.IsNull() {
 					tfPlanName := tfPlanOptionalClaim.Name.ValueString()
 					requestBodyOptionalClaim.SetName(&tfPlanName)
 				}
 
-				if !tfPlanOptionalClaim.Source.Equal(tfStateOptionalClaim.Source) {
+				if tfPlanOptionalClaim.Source.IsUnknown() {
+					tfPlanOptionalClaim.Source = tfStateOptionalClaim.Source
+				}
+				if !tfPlanOptionalClaim.Source.IsNull() {
 					tfPlanSource := tfPlanOptionalClaim.Source.ValueString()
 					requestBodyOptionalClaim.SetSource(&tfPlanSource)
 				}
+				objectArraySaml2Token = append(objectArraySaml2Token, requestBodyOptionalClaim)
+				objectValue, _ := types.ObjectValueFrom(ctx, tfPlanOptionalClaim.AttributeTypes(), tfPlanOptionalClaim)
+				objectValuesSaml2Token = append(objectValuesSaml2Token, objectValue)
 			}
-			requestBodyOptionalClaims.SetSaml2Token(tfPlanSaml2Token)
+			requestBodyOptionalClaims.SetSaml2Token(objectArraySaml2Token)
+			tfPlanOptionalClaims.Saml2Token, _ = types.ListValueFrom(ctx, tfPlanOptionalClaims.Saml2Token.ElementType(ctx), objectValuesSaml2Token)
 		}
 		requestBodyApplication.SetOptionalClaims(requestBodyOptionalClaims)
 		tfPlanApplication.OptionalClaims, _ = types.ObjectValueFrom(ctx, tfPlanOptionalClaims.AttributeTypes(), tfPlanOptionalClaims)
@@ -3821,20 +4169,26 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !tfPlanApplication.ParentalControlSettings.Equal(tfStateApplication.ParentalControlSettings) {
 		requestBodyParentalControlSettings := models.NewParentalControlSettings()
-		tfPlanParentalControlSettings := applicationParentalControlSettingsModel{}
+		tfPlanParentalControlSettings := sharedmodels.ParentalControlSettingsModel{}
 		tfPlanApplication.ParentalControlSettings.As(ctx, &tfPlanParentalControlSettings, basetypes.ObjectAsOptions{})
-		tfStateParentalControlSettings := applicationParentalControlSettingsModel{}
+		tfStateParentalControlSettings := sharedmodels.ParentalControlSettingsModel{}
 		tfStateApplication.ParentalControlSettings.As(ctx, &tfStateParentalControlSettings, basetypes.ObjectAsOptions{})
 
-		if !tfPlanParentalControlSettings.CountriesBlockedForMinors.Equal(tfStateParentalControlSettings.CountriesBlockedForMinors) {
+		if tfPlanParentalControlSettings.CountriesBlockedForMinors.IsUnknown() {
+			tfPlanParentalControlSettings.CountriesBlockedForMinors = tfStateParentalControlSettings.CountriesBlockedForMinors
+		}
+		if !tfPlanParentalControlSettings.CountriesBlockedForMinors.IsNull() {
 			var stringArrayCountriesBlockedForMinors []string
 			for _, i := range tfPlanParentalControlSettings.CountriesBlockedForMinors.Elements() {
-				stringArrayCountriesBlockedForMinors = append(stringArrayCountriesBlockedForMinors, i.String())
+				stringArrayCountriesBlockedForMinors = append(stringArrayCountriesBlockedForMinors, i.(types.String).ValueString())
 			}
 			requestBodyParentalControlSettings.SetCountriesBlockedForMinors(stringArrayCountriesBlockedForMinors)
 		}
 
-		if !tfPlanParentalControlSettings.LegalAgeGroupRule.Equal(tfStateParentalControlSettings.LegalAgeGroupRule) {
+		if tfPlanParentalControlSettings.LegalAgeGroupRule.IsUnknown() {
+			tfPlanParentalControlSettings.LegalAgeGroupRule = tfStateParentalControlSettings.LegalAgeGroupRule
+		}
+		if !tfPlanParentalControlSettings.LegalAgeGroupRule.IsNull() {
 			tfPlanLegalAgeGroupRule := tfPlanParentalControlSettings.LegalAgeGroupRule.ValueString()
 			requestBodyParentalControlSettings.SetLegalAgeGroupRule(&tfPlanLegalAgeGroupRule)
 		}
@@ -3843,66 +4197,98 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if !tfPlanApplication.PasswordCredentials.Equal(tfStateApplication.PasswordCredentials) {
-		var tfPlanPasswordCredentials []models.PasswordCredentialable
-		for k, i := range tfPlanApplication.PasswordCredentials.Elements() {
+		var objectArrayPasswordCredentials []models.PasswordCredentialable
+		objectValuesPasswordCredentials := []basetypes.ObjectValue{}
+		tfStateElementsPasswordCredentials := tfStateApplication.PasswordCredentials.Elements()
+		for n, i := range tfPlanApplication.PasswordCredentials.Elements() {
 			requestBodyPasswordCredential := models.NewPasswordCredential()
-			tfPlanPasswordCredential := applicationPasswordCredentialModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPasswordCredential)
-			tfStatePasswordCredential := applicationPasswordCredentialModel{}
-			types.ListValueFrom(ctx, tfStateApplication.PasswordCredentials.Elements()[k].Type(ctx), &tfPlanPasswordCredential)
+			tfPlanPasswordCredential := sharedmodels.PasswordCredentialModel{}
+			i.(types.Object).As(ctx, &tfPlanPasswordCredential, basetypes.ObjectAsOptions{})
+			tfStatePasswordCredential := sharedmodels.PasswordCredentialModel{}
+			if n < len(tfStateElementsPasswordCredentials) {
+				tfStateElementsPasswordCredentials[n].(types.Object).As(ctx, &tfStatePasswordCredential, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanPasswordCredential.CustomKeyIdentifier.Equal(tfStatePasswordCredential.CustomKeyIdentifier) {
+			if tfPlanPasswordCredential.CustomKeyIdentifier.IsUnknown() {
+				tfPlanPasswordCredential.CustomKeyIdentifier = tfStatePasswordCredential.CustomKeyIdentifier
+			}
+			if !tfPlanPasswordCredential.CustomKeyIdentifier.IsNull() {
 				tfPlanCustomKeyIdentifier := tfPlanPasswordCredential.CustomKeyIdentifier.ValueString()
 				requestBodyPasswordCredential.SetCustomKeyIdentifier([]byte(tfPlanCustomKeyIdentifier))
 			}
 
-			if !tfPlanPasswordCredential.DisplayName.Equal(tfStatePasswordCredential.DisplayName) {
+			if tfPlanPasswordCredential.DisplayName.IsUnknown() {
+				tfPlanPasswordCredential.DisplayName = tfStatePasswordCredential.DisplayName
+			}
+			if !tfPlanPasswordCredential.DisplayName.IsNull() {
 				tfPlanDisplayName := tfPlanPasswordCredential.DisplayName.ValueString()
 				requestBodyPasswordCredential.SetDisplayName(&tfPlanDisplayName)
 			}
 
-			if !tfPlanPasswordCredential.EndDateTime.Equal(tfStatePasswordCredential.EndDateTime) {
+			if tfPlanPasswordCredential.EndDateTime.IsUnknown() {
+				tfPlanPasswordCredential.EndDateTime = tfStatePasswordCredential.EndDateTime
+			}
+			if !tfPlanPasswordCredential.EndDateTime.IsNull() {
 				tfPlanEndDateTime := tfPlanPasswordCredential.EndDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanEndDateTime)
 				requestBodyPasswordCredential.SetEndDateTime(&t)
 			}
 
-			if !tfPlanPasswordCredential.Hint.Equal(tfStatePasswordCredential.Hint) {
+			if tfPlanPasswordCredential.Hint.IsUnknown() {
+				tfPlanPasswordCredential.Hint = tfStatePasswordCredential.Hint
+			}
+			if !tfPlanPasswordCredential.Hint.IsNull() {
 				tfPlanHint := tfPlanPasswordCredential.Hint.ValueString()
 				requestBodyPasswordCredential.SetHint(&tfPlanHint)
 			}
 
-			if !tfPlanPasswordCredential.KeyId.Equal(tfStatePasswordCredential.KeyId) {
+			if tfPlanPasswordCredential.KeyId.IsUnknown() {
+				tfPlanPasswordCredential.KeyId = tfStatePasswordCredential.KeyId
+			}
+			if !tfPlanPasswordCredential.KeyId.IsNull() {
 				tfPlanKeyId := tfPlanPasswordCredential.KeyId.ValueString()
 				u, _ := uuid.Parse(tfPlanKeyId)
 				requestBodyPasswordCredential.SetKeyId(&u)
 			}
 
-			if !tfPlanPasswordCredential.SecretText.Equal(tfStatePasswordCredential.SecretText) {
+			if tfPlanPasswordCredential.SecretText.IsUnknown() {
+				tfPlanPasswordCredential.SecretText = tfStatePasswordCredential.SecretText
+			}
+			if !tfPlanPasswordCredential.SecretText.IsNull() {
 				tfPlanSecretText := tfPlanPasswordCredential.SecretText.ValueString()
 				requestBodyPasswordCredential.SetSecretText(&tfPlanSecretText)
 			}
 
-			if !tfPlanPasswordCredential.StartDateTime.Equal(tfStatePasswordCredential.StartDateTime) {
+			if tfPlanPasswordCredential.StartDateTime.IsUnknown() {
+				tfPlanPasswordCredential.StartDateTime = tfStatePasswordCredential.StartDateTime
+			}
+			if !tfPlanPasswordCredential.StartDateTime.IsNull() {
 				tfPlanStartDateTime := tfPlanPasswordCredential.StartDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanStartDateTime)
 				requestBodyPasswordCredential.SetStartDateTime(&t)
 			}
+			objectArrayPasswordCredentials = append(objectArrayPasswordCredentials, requestBodyPasswordCredential)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanPasswordCredential.AttributeTypes(), tfPlanPasswordCredential)
+			objectValuesPasswordCredentials = append(objectValuesPasswordCredentials, objectValue)
 		}
-		requestBodyApplication.SetPasswordCredentials(tfPlanPasswordCredentials)
+		requestBodyApplication.SetPasswordCredentials(objectArrayPasswordCredentials)
+		tfPlanApplication.PasswordCredentials, _ = types.ListValueFrom(ctx, tfPlanApplication.PasswordCredentials.ElementType(ctx), objectValuesPasswordCredentials)
 	}
 
 	if !tfPlanApplication.PublicClient.Equal(tfStateApplication.PublicClient) {
 		requestBodyPublicClientApplication := models.NewPublicClientApplication()
-		tfPlanPublicClientApplication := applicationPublicClientApplicationModel{}
+		tfPlanPublicClientApplication := sharedmodels.PublicClientApplicationModel{}
 		tfPlanApplication.PublicClient.As(ctx, &tfPlanPublicClientApplication, basetypes.ObjectAsOptions{})
-		tfStatePublicClientApplication := applicationPublicClientApplicationModel{}
+		tfStatePublicClientApplication := sharedmodels.PublicClientApplicationModel{}
 		tfStateApplication.PublicClient.As(ctx, &tfStatePublicClientApplication, basetypes.ObjectAsOptions{})
 
-		if !tfPlanPublicClientApplication.RedirectUris.Equal(tfStatePublicClientApplication.RedirectUris) {
+		if tfPlanPublicClientApplication.RedirectUris.IsUnknown() {
+			tfPlanPublicClientApplication.RedirectUris = tfStatePublicClientApplication.RedirectUris
+		}
+		if !tfPlanPublicClientApplication.RedirectUris.IsNull() {
 			var stringArrayRedirectUris []string
 			for _, i := range tfPlanPublicClientApplication.RedirectUris.Elements() {
-				stringArrayRedirectUris = append(stringArrayRedirectUris, i.String())
+				stringArrayRedirectUris = append(stringArrayRedirectUris, i.(types.String).ValueString())
 			}
 			requestBodyPublicClientApplication.SetRedirectUris(stringArrayRedirectUris)
 		}
@@ -3917,19 +4303,25 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !tfPlanApplication.RequestSignatureVerification.Equal(tfStateApplication.RequestSignatureVerification) {
 		requestBodyRequestSignatureVerification := models.NewRequestSignatureVerification()
-		tfPlanRequestSignatureVerification := applicationRequestSignatureVerificationModel{}
+		tfPlanRequestSignatureVerification := sharedmodels.RequestSignatureVerificationModel{}
 		tfPlanApplication.RequestSignatureVerification.As(ctx, &tfPlanRequestSignatureVerification, basetypes.ObjectAsOptions{})
-		tfStateRequestSignatureVerification := applicationRequestSignatureVerificationModel{}
+		tfStateRequestSignatureVerification := sharedmodels.RequestSignatureVerificationModel{}
 		tfStateApplication.RequestSignatureVerification.As(ctx, &tfStateRequestSignatureVerification, basetypes.ObjectAsOptions{})
 
-		if !tfPlanRequestSignatureVerification.AllowedWeakAlgorithms.Equal(tfStateRequestSignatureVerification.AllowedWeakAlgorithms) {
+		if tfPlanRequestSignatureVerification.AllowedWeakAlgorithms.IsUnknown() {
+			tfPlanRequestSignatureVerification.AllowedWeakAlgorithms = tfStateRequestSignatureVerification.AllowedWeakAlgorithms
+		}
+		if !tfPlanRequestSignatureVerification.AllowedWeakAlgorithms.IsNull() {
 			tfPlanAllowedWeakAlgorithms := tfPlanRequestSignatureVerification.AllowedWeakAlgorithms.ValueString()
 			parsedAllowedWeakAlgorithms, _ := models.ParseWeakAlgorithms(tfPlanAllowedWeakAlgorithms)
 			assertedAllowedWeakAlgorithms := parsedAllowedWeakAlgorithms.(models.WeakAlgorithms)
 			requestBodyRequestSignatureVerification.SetAllowedWeakAlgorithms(&assertedAllowedWeakAlgorithms)
 		}
 
-		if !tfPlanRequestSignatureVerification.IsSignedRequestRequired.Equal(tfStateRequestSignatureVerification.IsSignedRequestRequired) {
+		if tfPlanRequestSignatureVerification.IsSignedRequestRequired.IsUnknown() {
+			tfPlanRequestSignatureVerification.IsSignedRequestRequired = tfStateRequestSignatureVerification.IsSignedRequestRequired
+		}
+		if !tfPlanRequestSignatureVerification.IsSignedRequestRequired.IsNull() {
 			tfPlanIsSignedRequestRequired := tfPlanRequestSignatureVerification.IsSignedRequestRequired.ValueBool()
 			requestBodyRequestSignatureVerification.SetIsSignedRequestRequired(&tfPlanIsSignedRequestRequired)
 		}
@@ -3938,43 +4330,71 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if !tfPlanApplication.RequiredResourceAccess.Equal(tfStateApplication.RequiredResourceAccess) {
-		var tfPlanRequiredResourceAccess []models.RequiredResourceAccessable
-		for k, i := range tfPlanApplication.RequiredResourceAccess.Elements() {
+		var objectArrayRequiredResourceAccess []models.RequiredResourceAccessable
+		objectValuesRequiredResourceAccess := []basetypes.ObjectValue{}
+		tfStateElementsRequiredResourceAccess := tfStateApplication.RequiredResourceAccess.Elements()
+		for n, i := range tfPlanApplication.RequiredResourceAccess.Elements() {
 			requestBodyRequiredResourceAccess := models.NewRequiredResourceAccess()
-			tfPlanRequiredResourceAccess := applicationRequiredResourceAccessModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanRequiredResourceAccess)
-			tfStateRequiredResourceAccess := applicationRequiredResourceAccessModel{}
-			types.ListValueFrom(ctx, tfStateApplication.RequiredResourceAccess.Elements()[k].Type(ctx), &tfPlanRequiredResourceAccess)
+			tfPlanRequiredResourceAccess := sharedmodels.RequiredResourceAccessModel{}
+			i.(types.Object).As(ctx, &tfPlanRequiredResourceAccess, basetypes.ObjectAsOptions{})
+			tfStateRequiredResourceAccess := sharedmodels.RequiredResourceAccessModel{}
+			if n < len(tfStateElementsRequiredResourceAccess) {
+				tfStateElementsRequiredResourceAccess[n].(types.Object).As(ctx, &tfStateRequiredResourceAccess, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanRequiredResourceAccess.ResourceAccess.Equal(tfStateRequiredResourceAccess.ResourceAccess) {
-				var tfPlanResourceAccess []models.ResourceAccessable
-				for k, i := range tfPlanRequiredResourceAccess.ResourceAccess.Elements() {
+			if tfPlanRequiredResourceAccess.ResourceAccess.IsUnknown() {
+				tfPlanRequiredResourceAccess.ResourceAccess = tfStateRequiredResourceAccess.ResourceAccess
+			}
+			if !tfPlanRequiredResourceAccess.ResourceAccess.IsNull() {
+				var objectArrayResourceAccess []models.ResourceAccessable
+				objectValuesResourceAccess := []basetypes.ObjectValue{}
+				tfStateElementsResourceAccess := tfStateRequiredResourceAccess.ResourceAccess.Elements()
+				for n, i := range tfPlanRequiredResourceAccess.ResourceAccess.Elements() {
 					requestBodyResourceAccess := models.NewResourceAccess()
-					tfPlanResourceAccess := applicationResourceAccessModel{}
-					types.ListValueFrom(ctx, i.Type(ctx), &tfPlanResourceAccess)
-					tfStateResourceAccess := applicationResourceAccessModel{}
-					types.ListValueFrom(ctx, tfStateRequiredResourceAccess.ResourceAccess.Elements()[k].Type(ctx), &tfPlanResourceAccess)
+					tfPlanResourceAccess := sharedmodels.ResourceAccessModel{}
+					i.(types.Object).As(ctx, &tfPlanResourceAccess, basetypes.ObjectAsOptions{})
+					tfStateResourceAccess := sharedmodels.ResourceAccessModel{}
+					if n < len(tfStateElementsResourceAccess) {
+						tfStateElementsResourceAccess[n].(types.Object).As(ctx, &tfStateResourceAccess, basetypes.ObjectAsOptions{})
+					}
 
-					if !tfPlanResourceAccess.Id.Equal(tfStateResourceAccess.Id) {
+					if tfPlanResourceAccess.Id.IsUnknown() {
+						tfPlanResourceAccess.Id = tfStateResourceAccess.Id
+					}
+					if !tfPlanResourceAccess.Id.IsNull() {
 						tfPlanId := tfPlanResourceAccess.Id.ValueString()
 						u, _ := uuid.Parse(tfPlanId)
 						requestBodyResourceAccess.SetId(&u)
 					}
 
-					if !tfPlanResourceAccess.Type.Equal(tfStateResourceAccess.Type) {
+					if tfPlanResourceAccess.Type.IsUnknown() {
+						tfPlanResourceAccess.Type = tfStateResourceAccess.Type
+					}
+					if !tfPlanResourceAccess.Type.IsNull() {
 						tfPlanType := tfPlanResourceAccess.Type.ValueString()
 						requestBodyResourceAccess.SetTypeEscaped(&tfPlanType)
 					}
+					objectArrayResourceAccess = append(objectArrayResourceAccess, requestBodyResourceAccess)
+					objectValue, _ := types.ObjectValueFrom(ctx, tfPlanResourceAccess.AttributeTypes(), tfPlanResourceAccess)
+					objectValuesResourceAccess = append(objectValuesResourceAccess, objectValue)
 				}
-				requestBodyRequiredResourceAccess.SetResourceAccess(tfPlanResourceAccess)
+				requestBodyRequiredResourceAccess.SetResourceAccess(objectArrayResourceAccess)
+				tfPlanRequiredResourceAccess.ResourceAccess, _ = types.ListValueFrom(ctx, tfPlanRequiredResourceAccess.ResourceAccess.ElementType(ctx), objectValuesResourceAccess)
 			}
 
-			if !tfPlanRequiredResourceAccess.ResourceAppId.Equal(tfStateRequiredResourceAccess.ResourceAppId) {
+			if tfPlanRequiredResourceAccess.ResourceAppId.IsUnknown() {
+				tfPlanRequiredResourceAccess.ResourceAppId = tfStateRequiredResourceAccess.ResourceAppId
+			}
+			if !tfPlanRequiredResourceAccess.ResourceAppId.IsNull() {
 				tfPlanResourceAppId := tfPlanRequiredResourceAccess.ResourceAppId.ValueString()
 				requestBodyRequiredResourceAccess.SetResourceAppId(&tfPlanResourceAppId)
 			}
+			objectArrayRequiredResourceAccess = append(objectArrayRequiredResourceAccess, requestBodyRequiredResourceAccess)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanRequiredResourceAccess.AttributeTypes(), tfPlanRequiredResourceAccess)
+			objectValuesRequiredResourceAccess = append(objectValuesRequiredResourceAccess, objectValue)
 		}
-		requestBodyApplication.SetRequiredResourceAccess(tfPlanRequiredResourceAccess)
+		requestBodyApplication.SetRequiredResourceAccess(objectArrayRequiredResourceAccess)
+		tfPlanApplication.RequiredResourceAccess, _ = types.ListValueFrom(ctx, tfPlanApplication.RequiredResourceAccess.ElementType(ctx), objectValuesRequiredResourceAccess)
 	}
 
 	if !tfPlanApplication.SamlMetadataUrl.Equal(tfStateApplication.SamlMetadataUrl) {
@@ -3989,32 +4409,47 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !tfPlanApplication.ServicePrincipalLockConfiguration.Equal(tfStateApplication.ServicePrincipalLockConfiguration) {
 		requestBodyServicePrincipalLockConfiguration := models.NewServicePrincipalLockConfiguration()
-		tfPlanServicePrincipalLockConfiguration := applicationServicePrincipalLockConfigurationModel{}
+		tfPlanServicePrincipalLockConfiguration := sharedmodels.ServicePrincipalLockConfigurationModel{}
 		tfPlanApplication.ServicePrincipalLockConfiguration.As(ctx, &tfPlanServicePrincipalLockConfiguration, basetypes.ObjectAsOptions{})
-		tfStateServicePrincipalLockConfiguration := applicationServicePrincipalLockConfigurationModel{}
+		tfStateServicePrincipalLockConfiguration := sharedmodels.ServicePrincipalLockConfigurationModel{}
 		tfStateApplication.ServicePrincipalLockConfiguration.As(ctx, &tfStateServicePrincipalLockConfiguration, basetypes.ObjectAsOptions{})
 
-		if !tfPlanServicePrincipalLockConfiguration.AllProperties.Equal(tfStateServicePrincipalLockConfiguration.AllProperties) {
+		if tfPlanServicePrincipalLockConfiguration.AllProperties.IsUnknown() {
+			tfPlanServicePrincipalLockConfiguration.AllProperties = tfStateServicePrincipalLockConfiguration.AllProperties
+		}
+		if !tfPlanServicePrincipalLockConfiguration.AllProperties.IsNull() {
 			tfPlanAllProperties := tfPlanServicePrincipalLockConfiguration.AllProperties.ValueBool()
 			requestBodyServicePrincipalLockConfiguration.SetAllProperties(&tfPlanAllProperties)
 		}
 
-		if !tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageSign.Equal(tfStateServicePrincipalLockConfiguration.CredentialsWithUsageSign) {
+		if tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageSign.IsUnknown() {
+			tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageSign = tfStateServicePrincipalLockConfiguration.CredentialsWithUsageSign
+		}
+		if !tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageSign.IsNull() {
 			tfPlanCredentialsWithUsageSign := tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageSign.ValueBool()
 			requestBodyServicePrincipalLockConfiguration.SetCredentialsWithUsageSign(&tfPlanCredentialsWithUsageSign)
 		}
 
-		if !tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageVerify.Equal(tfStateServicePrincipalLockConfiguration.CredentialsWithUsageVerify) {
+		if tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageVerify.IsUnknown() {
+			tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageVerify = tfStateServicePrincipalLockConfiguration.CredentialsWithUsageVerify
+		}
+		if !tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageVerify.IsNull() {
 			tfPlanCredentialsWithUsageVerify := tfPlanServicePrincipalLockConfiguration.CredentialsWithUsageVerify.ValueBool()
 			requestBodyServicePrincipalLockConfiguration.SetCredentialsWithUsageVerify(&tfPlanCredentialsWithUsageVerify)
 		}
 
-		if !tfPlanServicePrincipalLockConfiguration.IsEnabled.Equal(tfStateServicePrincipalLockConfiguration.IsEnabled) {
+		if tfPlanServicePrincipalLockConfiguration.IsEnabled.IsUnknown() {
+			tfPlanServicePrincipalLockConfiguration.IsEnabled = tfStateServicePrincipalLockConfiguration.IsEnabled
+		}
+		if !tfPlanServicePrincipalLockConfiguration.IsEnabled.IsNull() {
 			tfPlanIsEnabled := tfPlanServicePrincipalLockConfiguration.IsEnabled.ValueBool()
 			requestBodyServicePrincipalLockConfiguration.SetIsEnabled(&tfPlanIsEnabled)
 		}
 
-		if !tfPlanServicePrincipalLockConfiguration.TokenEncryptionKeyId.Equal(tfStateServicePrincipalLockConfiguration.TokenEncryptionKeyId) {
+		if tfPlanServicePrincipalLockConfiguration.TokenEncryptionKeyId.IsUnknown() {
+			tfPlanServicePrincipalLockConfiguration.TokenEncryptionKeyId = tfStateServicePrincipalLockConfiguration.TokenEncryptionKeyId
+		}
+		if !tfPlanServicePrincipalLockConfiguration.TokenEncryptionKeyId.IsNull() {
 			tfPlanTokenEncryptionKeyId := tfPlanServicePrincipalLockConfiguration.TokenEncryptionKeyId.ValueBool()
 			requestBodyServicePrincipalLockConfiguration.SetTokenEncryptionKeyId(&tfPlanTokenEncryptionKeyId)
 		}
@@ -4029,15 +4464,18 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !tfPlanApplication.Spa.Equal(tfStateApplication.Spa) {
 		requestBodySpaApplication := models.NewSpaApplication()
-		tfPlanSpaApplication := applicationSpaApplicationModel{}
+		tfPlanSpaApplication := sharedmodels.SpaApplicationModel{}
 		tfPlanApplication.Spa.As(ctx, &tfPlanSpaApplication, basetypes.ObjectAsOptions{})
-		tfStateSpaApplication := applicationSpaApplicationModel{}
+		tfStateSpaApplication := sharedmodels.SpaApplicationModel{}
 		tfStateApplication.Spa.As(ctx, &tfStateSpaApplication, basetypes.ObjectAsOptions{})
 
-		if !tfPlanSpaApplication.RedirectUris.Equal(tfStateSpaApplication.RedirectUris) {
+		if tfPlanSpaApplication.RedirectUris.IsUnknown() {
+			tfPlanSpaApplication.RedirectUris = tfStateSpaApplication.RedirectUris
+		}
+		if !tfPlanSpaApplication.RedirectUris.IsNull() {
 			var stringArrayRedirectUris []string
 			for _, i := range tfPlanSpaApplication.RedirectUris.Elements() {
-				stringArrayRedirectUris = append(stringArrayRedirectUris, i.String())
+				stringArrayRedirectUris = append(stringArrayRedirectUris, i.(types.String).ValueString())
 			}
 			requestBodySpaApplication.SetRedirectUris(stringArrayRedirectUris)
 		}
@@ -4048,7 +4486,7 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	if !tfPlanApplication.Tags.Equal(tfStateApplication.Tags) {
 		var stringArrayTags []string
 		for _, i := range tfPlanApplication.Tags.Elements() {
-			stringArrayTags = append(stringArrayTags, i.String())
+			stringArrayTags = append(stringArrayTags, i.(types.String).ValueString())
 		}
 		requestBodyApplication.SetTags(stringArrayTags)
 	}
@@ -4066,23 +4504,32 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !tfPlanApplication.VerifiedPublisher.Equal(tfStateApplication.VerifiedPublisher) {
 		requestBodyVerifiedPublisher := models.NewVerifiedPublisher()
-		tfPlanVerifiedPublisher := applicationVerifiedPublisherModel{}
+		tfPlanVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		tfPlanApplication.VerifiedPublisher.As(ctx, &tfPlanVerifiedPublisher, basetypes.ObjectAsOptions{})
-		tfStateVerifiedPublisher := applicationVerifiedPublisherModel{}
+		tfStateVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		tfStateApplication.VerifiedPublisher.As(ctx, &tfStateVerifiedPublisher, basetypes.ObjectAsOptions{})
 
-		if !tfPlanVerifiedPublisher.AddedDateTime.Equal(tfStateVerifiedPublisher.AddedDateTime) {
+		if tfPlanVerifiedPublisher.AddedDateTime.IsUnknown() {
+			tfPlanVerifiedPublisher.AddedDateTime = tfStateVerifiedPublisher.AddedDateTime
+		}
+		if !tfPlanVerifiedPublisher.AddedDateTime.IsNull() {
 			tfPlanAddedDateTime := tfPlanVerifiedPublisher.AddedDateTime.ValueString()
 			t, _ := time.Parse(time.RFC3339, tfPlanAddedDateTime)
 			requestBodyVerifiedPublisher.SetAddedDateTime(&t)
 		}
 
-		if !tfPlanVerifiedPublisher.DisplayName.Equal(tfStateVerifiedPublisher.DisplayName) {
+		if tfPlanVerifiedPublisher.DisplayName.IsUnknown() {
+			tfPlanVerifiedPublisher.DisplayName = tfStateVerifiedPublisher.DisplayName
+		}
+		if !tfPlanVerifiedPublisher.DisplayName.IsNull() {
 			tfPlanDisplayName := tfPlanVerifiedPublisher.DisplayName.ValueString()
 			requestBodyVerifiedPublisher.SetDisplayName(&tfPlanDisplayName)
 		}
 
-		if !tfPlanVerifiedPublisher.VerifiedPublisherId.Equal(tfStateVerifiedPublisher.VerifiedPublisherId) {
+		if tfPlanVerifiedPublisher.VerifiedPublisherId.IsUnknown() {
+			tfPlanVerifiedPublisher.VerifiedPublisherId = tfStateVerifiedPublisher.VerifiedPublisherId
+		}
+		if !tfPlanVerifiedPublisher.VerifiedPublisherId.IsNull() {
 			tfPlanVerifiedPublisherId := tfPlanVerifiedPublisher.VerifiedPublisherId.ValueString()
 			requestBodyVerifiedPublisher.SetVerifiedPublisherId(&tfPlanVerifiedPublisherId)
 		}
@@ -4092,29 +4539,41 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !tfPlanApplication.Web.Equal(tfStateApplication.Web) {
 		requestBodyWebApplication := models.NewWebApplication()
-		tfPlanWebApplication := applicationWebApplicationModel{}
+		tfPlanWebApplication := sharedmodels.WebApplicationModel{}
 		tfPlanApplication.Web.As(ctx, &tfPlanWebApplication, basetypes.ObjectAsOptions{})
-		tfStateWebApplication := applicationWebApplicationModel{}
+		tfStateWebApplication := sharedmodels.WebApplicationModel{}
 		tfStateApplication.Web.As(ctx, &tfStateWebApplication, basetypes.ObjectAsOptions{})
 
-		if !tfPlanWebApplication.HomePageUrl.Equal(tfStateWebApplication.HomePageUrl) {
+		if tfPlanWebApplication.HomePageUrl.IsUnknown() {
+			tfPlanWebApplication.HomePageUrl = tfStateWebApplication.HomePageUrl
+		}
+		if !tfPlanWebApplication.HomePageUrl.IsNull() {
 			tfPlanHomePageUrl := tfPlanWebApplication.HomePageUrl.ValueString()
 			requestBodyWebApplication.SetHomePageUrl(&tfPlanHomePageUrl)
 		}
 
-		if !tfPlanWebApplication.ImplicitGrantSettings.Equal(tfStateWebApplication.ImplicitGrantSettings) {
+		if tfPlanWebApplication.ImplicitGrantSettings.IsUnknown() {
+			tfPlanWebApplication.ImplicitGrantSettings = tfStateWebApplication.ImplicitGrantSettings
+		}
+		if !tfPlanWebApplication.ImplicitGrantSettings.IsNull() {
 			requestBodyImplicitGrantSettings := models.NewImplicitGrantSettings()
-			tfPlanImplicitGrantSettings := applicationImplicitGrantSettingsModel{}
+			tfPlanImplicitGrantSettings := sharedmodels.ImplicitGrantSettingsModel{}
 			tfPlanWebApplication.ImplicitGrantSettings.As(ctx, &tfPlanImplicitGrantSettings, basetypes.ObjectAsOptions{})
-			tfStateImplicitGrantSettings := applicationImplicitGrantSettingsModel{}
+			tfStateImplicitGrantSettings := sharedmodels.ImplicitGrantSettingsModel{}
 			tfStateWebApplication.ImplicitGrantSettings.As(ctx, &tfStateImplicitGrantSettings, basetypes.ObjectAsOptions{})
 
-			if !tfPlanImplicitGrantSettings.EnableAccessTokenIssuance.Equal(tfStateImplicitGrantSettings.EnableAccessTokenIssuance) {
+			if tfPlanImplicitGrantSettings.EnableAccessTokenIssuance.IsUnknown() {
+				tfPlanImplicitGrantSettings.EnableAccessTokenIssuance = tfStateImplicitGrantSettings.EnableAccessTokenIssuance
+			}
+			if !tfPlanImplicitGrantSettings.EnableAccessTokenIssuance.IsNull() {
 				tfPlanEnableAccessTokenIssuance := tfPlanImplicitGrantSettings.EnableAccessTokenIssuance.ValueBool()
 				requestBodyImplicitGrantSettings.SetEnableAccessTokenIssuance(&tfPlanEnableAccessTokenIssuance)
 			}
 
-			if !tfPlanImplicitGrantSettings.EnableIdTokenIssuance.Equal(tfStateImplicitGrantSettings.EnableIdTokenIssuance) {
+			if tfPlanImplicitGrantSettings.EnableIdTokenIssuance.IsUnknown() {
+				tfPlanImplicitGrantSettings.EnableIdTokenIssuance = tfStateImplicitGrantSettings.EnableIdTokenIssuance
+			}
+			if !tfPlanImplicitGrantSettings.EnableIdTokenIssuance.IsNull() {
 				tfPlanEnableIdTokenIssuance := tfPlanImplicitGrantSettings.EnableIdTokenIssuance.ValueBool()
 				requestBodyImplicitGrantSettings.SetEnableIdTokenIssuance(&tfPlanEnableIdTokenIssuance)
 			}
@@ -4122,32 +4581,60 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 			tfPlanWebApplication.ImplicitGrantSettings, _ = types.ObjectValueFrom(ctx, tfPlanImplicitGrantSettings.AttributeTypes(), tfPlanImplicitGrantSettings)
 		}
 
-		if !tfPlanWebApplication.LogoutUrl.Equal(tfStateWebApplication.LogoutUrl) {
+		if tfPlanWebApplication.LogoutUrl.IsUnknown() {
+			tfPlanWebApplication.LogoutUrl = tfStateWebApplication.LogoutUrl
+		}
+		if !tfPlanWebApplication.LogoutUrl.IsNull() {
 			tfPlanLogoutUrl := tfPlanWebApplication.LogoutUrl.ValueString()
 			requestBodyWebApplication.SetLogoutUrl(&tfPlanLogoutUrl)
 		}
 
-		if !tfPlanWebApplication.RedirectUriSettings.Equal(tfStateWebApplication.RedirectUriSettings) {
-			var tfPlanRedirectUriSettings []models.RedirectUriSettingsable
-			for k, i := range tfPlanWebApplication.RedirectUriSettings.Elements() {
+		if tfPlanWebApplication.RedirectUriSettings.IsUnknown() {
+			tfPlanWebApplication.RedirectUriSettings = tfStateWebApplication.RedirectUriSettings
+		}
+		if !tfPlanWebApplication.RedirectUriSettings.IsNull() {
+			var objectArrayRedirectUriSettings []models.RedirectUriSettingsable
+			objectValuesRedirectUriSettings := []basetypes.ObjectValue{}
+			tfStateElementsRedirectUriSettings := tfStateWebApplication.RedirectUriSettings.Elements()
+			for n, i := range tfPlanWebApplication.RedirectUriSettings.Elements() {
 				requestBodyRedirectUriSettings := models.NewRedirectUriSettings()
-				tfPlanRedirectUriSettings := applicationRedirectUriSettingsModel{}
-				types.ListValueFrom(ctx, i.Type(ctx), &tfPlanRedirectUriSettings)
-				tfStateRedirectUriSettings := applicationRedirectUriSettingsModel{}
-				types.ListValueFrom(ctx, tfStateWebApplication.RedirectUriSettings.Elements()[k].Type(ctx), &tfPlanRedirectUriSettings)
+				tfPlanRedirectUriSettings := sharedmodels.RedirectUriSettingsModel{}
+				i.(types.Object).As(ctx, &tfPlanRedirectUriSettings, basetypes.ObjectAsOptions{})
+				tfStateRedirectUriSettings := sharedmodels.RedirectUriSettingsModel{}
+				if n < len(tfStateElementsRedirectUriSettings) {
+					tfStateElementsRedirectUriSettings[n].(types.Object).As(ctx, &tfStateRedirectUriSettings, basetypes.ObjectAsOptions{})
+				}
 
-				if !tfPlanRedirectUriSettings.Uri.Equal(tfStateRedirectUriSettings.Uri) {
+				if tfPlanRedirectUriSettings.Index.IsUnknown() {
+					tfPlanRedirectUriSettings.Index = tfStateRedirectUriSettings.Index
+				}
+				if !tfPlanRedirectUriSettings.Index.IsNull() {
+					tfPlanIndex := int32(tfPlanRedirectUriSettings.Index.ValueInt64())
+					requestBodyRedirectUriSettings.SetIndex(&tfPlanIndex)
+				}
+
+				if tfPlanRedirectUriSettings.Uri.IsUnknown() {
+					tfPlanRedirectUriSettings.Uri = tfStateRedirectUriSettings.Uri
+				}
+				if !tfPlanRedirectUriSettings.Uri.IsNull() {
 					tfPlanUri := tfPlanRedirectUriSettings.Uri.ValueString()
 					requestBodyRedirectUriSettings.SetUri(&tfPlanUri)
 				}
+				objectArrayRedirectUriSettings = append(objectArrayRedirectUriSettings, requestBodyRedirectUriSettings)
+				objectValue, _ := types.ObjectValueFrom(ctx, tfPlanRedirectUriSettings.AttributeTypes(), tfPlanRedirectUriSettings)
+				objectValuesRedirectUriSettings = append(objectValuesRedirectUriSettings, objectValue)
 			}
-			requestBodyWebApplication.SetRedirectUriSettings(tfPlanRedirectUriSettings)
+			requestBodyWebApplication.SetRedirectUriSettings(objectArrayRedirectUriSettings)
+			tfPlanWebApplication.RedirectUriSettings, _ = types.ListValueFrom(ctx, tfPlanWebApplication.RedirectUriSettings.ElementType(ctx), objectValuesRedirectUriSettings)
 		}
 
-		if !tfPlanWebApplication.RedirectUris.Equal(tfStateWebApplication.RedirectUris) {
+		if tfPlanWebApplication.RedirectUris.IsUnknown() {
+			tfPlanWebApplication.RedirectUris = tfStateWebApplication.RedirectUris
+		}
+		if !tfPlanWebApplication.RedirectUris.IsNull() {
 			var stringArrayRedirectUris []string
 			for _, i := range tfPlanWebApplication.RedirectUris.Elements() {
-				stringArrayRedirectUris = append(stringArrayRedirectUris, i.String())
+				stringArrayRedirectUris = append(stringArrayRedirectUris, i.(types.String).ValueString())
 			}
 			requestBodyWebApplication.SetRedirectUris(stringArrayRedirectUris)
 		}
@@ -4177,15 +4664,22 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from Terraform state
-	var tfStateApplication applicationModel
+	var tfStateApplication applicationResourceModel
 	diags := req.State.Get(ctx, &tfStateApplication)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// TODO: Delete application
-	err := r.client.Applications().ByApplicationId(tfStateApplication.Id.ValueString()).Delete(context.Background(), nil)
+	if tfStateApplication.PreventDeletion.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion of application prevented",
+			fmt.Sprintf("The application %s can't be deleted while prevent_deletion is true. Set it to false, and apply, before deleting it.", tfStateApplication.Id.ValueString()),
+		)
+		return
+	}
+
+	err := r.client.Applications().ByApplicationId(tfStateApplication.Id.ValueString()).Delete(ctx, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting application",
@@ -4194,8 +4688,195 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	// The object is kept in the deleted items of the directory, from which it can be restored, unless it's purged.
+	// It can take a few seconds to show up there. The object is deleted either way, so failing to purge it is only a warning,
+	// and the resource is still removed from the state
+	if tfStateApplication.PermanentlyDelete.ValueBool() {
+		for attempt := 1; ; attempt++ {
+			err = r.client.Directory().DeletedItems().ByDirectoryObjectId(tfStateApplication.Id.ValueString()).Delete(ctx, nil)
+			var odataErr *odataerrors.ODataError
+			if err == nil || !errors.As(err, &odataErr) || odataErr.ResponseStatusCode != 404 || attempt == 10 {
+				break
+			}
+			// Stop waiting when Terraform is interrupted
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+			case <-time.After(3 * time.Second):
+				continue
+			}
+			break
+		}
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Couldn't permanently delete application",
+				fmt.Sprintf("The application %s was deleted, but couldn't be purged from the deleted items of the directory, where it's kept for 30 days: %s", tfStateApplication.Id.ValueString(), err.Error()),
+			)
+		}
+	}
+
 }
 
 func (r *applicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Besides its ID, the object can be imported by 'appId:<app_id>', or 'displayName:<display_name>', which is looked up to find its ID
+	var filter string
+	prefix, value, _ := strings.Cut(req.ID, ":")
+	switch prefix {
+	case "appId":
+		filter = fmt.Sprintf("appId eq '%s'", strings.ReplaceAll(value, "'", "''"))
+	case "displayName":
+		filter = fmt.Sprintf("displayName eq '%s'", strings.ReplaceAll(value, "'", "''"))
+	default:
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	qparams := applications.ApplicationsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &applications.ApplicationsRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Select: []string{"id"},
+		},
+	}
+	result, err := r.client.Applications().Get(ctx, &qparams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing application",
+			err.Error(),
+		)
+		return
+	}
+	if len(result.GetValue()) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier %q to match a single application. Matched %d", req.ID, len(result.GetValue())),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *result.GetValue()[0].GetId())...)
+}
+
+// MoveState moves the state of resources of other providers to the resource, with a 'moved' block.
+// Only some of their attributes are moved. The others are set when the resource is next read,
+// as are empty strings and lists, which other providers store for optional attributes that aren't set
+func (r *applicationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "azuread_application" || !strings.HasSuffix(req.SourceProviderAddress, "/hashicorp/azuread") {
+					return
+				}
+
+				var source map[string]any
+				if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving state of azuread_application",
+						err.Error(),
+					)
+					return
+				}
+				if v, ok := source["application_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("app_id"), v)...)
+				}
+				if v, ok := source["client_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("app_id"), v)...)
+				}
+				if v, ok := source["description"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("description"), v)...)
+				}
+				if v, ok := source["device_only_auth_enabled"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("is_device_only_auth_supported"), v)...)
+				}
+				if v, ok := source["display_name"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("display_name"), v)...)
+				}
+				if v, ok := source["fallback_public_client_enabled"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("is_fallback_public_client"), v)...)
+				}
+				if v, ok := source["identifier_uris"].([]any); ok && len(v) > 0 {
+					var values []string
+					for _, i := range v {
+						if s, ok := i.(string); ok {
+							values = append(values, s)
+						}
+					}
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("identifier_uris"), values)...)
+				}
+				if v, ok := source["notes"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("notes"), v)...)
+				}
+				if v, ok := source["oauth2_post_response_required"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("oauth_2_require_post_response"), v)...)
+				}
+				if v, ok := source["object_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), v)...)
+				}
+				if v, ok := source["service_management_reference"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("service_management_reference"), v)...)
+				}
+				if v, ok := source["sign_in_audience"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("sign_in_audience"), v)...)
+				}
+				if v, ok := source["tags"].([]any); ok && len(v) > 0 {
+					var values []string
+					for _, i := range v {
+						if s, ok := i.(string); ok {
+							values = append(values, s)
+						}
+					}
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("tags"), values)...)
+				}
+			},
+		},
+	}
+}
+
+// ListIds returns the IDs of the objects matching filter, an OData $filter expression, or of every object when it's empty.
+// It's used to export the existing objects of a tenant
+func (r *applicationResource) ListIds(ctx context.Context, filter string) ([]string, error) {
+
+	qparams := applications.ApplicationsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &applications.ApplicationsRequestBuilderGetQueryParameters{
+			Select: []string{"id"},
+		},
+	}
+	if filter != "" {
+		qparams.QueryParameters.Filter = &filter
+	}
+
+	result, err := r.client.Applications().Get(ctx, &qparams)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for {
+		for _, object := range result.GetValue() {
+			ids = append(ids, *object.GetId())
+		}
+		nextLink := result.GetOdataNextLink()
+		if nextLink == nil {
+			return ids, nil
+		}
+		result, err = r.client.Applications().WithUrl(*nextLink).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+}
+
+// ReadOnlyAttributes returns the paths of the attributes the API sets, which exports leave out of the configuration they write
+func (r *applicationResource) ReadOnlyAttributes() []string {
+	return []string{
+		"app_id",
+		"app_roles.origin",
+		"application_template_id",
+		"created_date_time",
+		"id",
+		"info.logo_url",
+		"password_credentials.hint",
+		"password_credentials.secret_text",
+		"publisher_domain",
+		"unique_name",
+	}
 }
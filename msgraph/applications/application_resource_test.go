@@ -0,0 +1,70 @@
+package applications_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+const applicationResourceConfig = `
+resource "msgraph_application" "test" {
+  display_name = "Example"
+}
+`
+
+const applicationResourceUpdateConfig = `
+resource "msgraph_application" "test" {
+  display_name = "Example updated"
+}
+`
+
+func TestAccApplicationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: applicationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("msgraph_application.test", "id"),
+					resource.TestCheckResourceAttr("msgraph_application.test", "display_name", "Example"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "msgraph_application.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import by app_id
+			{
+				ResourceName:      "msgraph_application.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "appId:" + s.RootModule().Resources["msgraph_application.test"].Primary.Attributes["app_id"], nil
+				},
+			},
+			// Import by display_name
+			{
+				ResourceName:      "msgraph_application.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "displayName:" + s.RootModule().Resources["msgraph_application.test"].Primary.Attributes["display_name"], nil
+				},
+			},
+			// Update and Read
+			{
+				Config: applicationResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msgraph_application.test", "display_name", "Example updated"),
+				),
+			},
+			// Delete is tested once the last step is done
+		},
+	})
+}
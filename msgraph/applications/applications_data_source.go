@@ -10,6 +10,9 @@ import (
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/applications"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,12 +37,12 @@ func (d *applicationsDataSource) Metadata(_ context.Context, req datasource.Meta
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *applicationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *applicationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -158,6 +161,10 @@ func (d *applicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 										},
 									},
 								},
+								"requested_access_token_version": schema.Int64Attribute{
+									Description: "Specifies the access token version expected by this resource. This changes the version and format of the JWT produced independent of the endpoint or client used to request the access token. The endpoint used, v1.0 or v2.0, is chosen by the client and only impacts the version of id_tokens. Resources need to explicitly configure requestedAccessTokenVersion to indicate the supported access token format. Possible values for requestedAccessTokenVersion are 1, 2, or null. If the value is null, this defaults to 1, which corresponds to the v1.0 endpoint. If signInAudience on the application is configured as AzureADandPersonalMicrosoftAccount or PersonalMicrosoftAccount, the value for this property must be 2.",
+									Computed:    true,
+								},
 							},
 						},
 						"app_id": schema.StringAttribute{
@@ -658,6 +665,10 @@ func (d *applicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 									Computed:    true,
 									NestedObject: schema.NestedAttributeObject{
 										Attributes: map[string]schema.Attribute{
+											"index": schema.Int64Attribute{
+												Description: "",
+												Computed:    true,
+											},
 											"uri": schema.StringAttribute{
 												Description: "",
 												Computed:    true,
@@ -713,7 +724,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 			if len(responseApplication.GetAddIns()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseAddIn := range responseApplication.GetAddIns() {
-					tfStateAddIn := applicationsAddInModel{}
+					tfStateAddIn := sharedmodels.AddInModel{}
 
 					if responseAddIn.GetId() != nil {
 						tfStateAddIn.Id = types.StringValue(responseAddIn.GetId().String())
@@ -723,7 +734,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 					if len(responseAddIn.GetProperties()) > 0 {
 						objectValues := []basetypes.ObjectValue{}
 						for _, responseKeyValue := range responseAddIn.GetProperties() {
-							tfStateKeyValue := applicationsKeyValueModel{}
+							tfStateKeyValue := sharedmodels.KeyValueModel{}
 
 							if responseKeyValue.GetKey() != nil {
 								tfStateKeyValue.Key = types.StringValue(*responseKeyValue.GetKey())
@@ -751,7 +762,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.AddIns, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 			}
 			if responseApplication.GetApi() != nil {
-				tfStateApiApplication := applicationsApiApplicationModel{}
+				tfStateApiApplication := sharedmodels.ApiApplicationModel{}
 				responseApiApplication := responseApplication.GetApi()
 
 				if responseApiApplication.GetAcceptMappedClaims() != nil {
@@ -771,7 +782,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				if len(responseApiApplication.GetOauth2PermissionScopes()) > 0 {
 					objectValues := []basetypes.ObjectValue{}
 					for _, responsePermissionScope := range responseApiApplication.GetOauth2PermissionScopes() {
-						tfStatePermissionScope := applicationsPermissionScopeModel{}
+						tfStatePermissionScope := sharedmodels.PermissionScopeModel{}
 
 						if responsePermissionScope.GetAdminConsentDescription() != nil {
 							tfStatePermissionScope.AdminConsentDescription = types.StringValue(*responsePermissionScope.GetAdminConsentDescription())
@@ -826,7 +837,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				if len(responseApiApplication.GetPreAuthorizedApplications()) > 0 {
 					objectValues := []basetypes.ObjectValue{}
 					for _, responsePreAuthorizedApplication := range responseApiApplication.GetPreAuthorizedApplications() {
-						tfStatePreAuthorizedApplication := applicationsPreAuthorizedApplicationModel{}
+						tfStatePreAuthorizedApplication := sharedmodels.PreAuthorizedApplicationModel{}
 
 						if responsePreAuthorizedApplication.GetAppId() != nil {
 							tfStatePreAuthorizedApplication.AppId = types.StringValue(*responsePreAuthorizedApplication.GetAppId())
@@ -848,6 +859,11 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 					}
 					tfStateApiApplication.PreAuthorizedApplications, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 				}
+				if responseApiApplication.GetRequestedAccessTokenVersion() != nil {
+					tfStateApiApplication.RequestedAccessTokenVersion = types.Int64Value(int64(*responseApiApplication.GetRequestedAccessTokenVersion()))
+				} else {
+					tfStateApiApplication.RequestedAccessTokenVersion = types.Int64Null()
+				}
 
 				tfStateApplication.Api, _ = types.ObjectValueFrom(ctx, tfStateApiApplication.AttributeTypes(), tfStateApiApplication)
 			}
@@ -859,7 +875,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 			if len(responseApplication.GetAppRoles()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseAppRole := range responseApplication.GetAppRoles() {
-					tfStateAppRole := applicationsAppRoleModel{}
+					tfStateAppRole := sharedmodels.AppRoleModel{}
 
 					if len(responseAppRole.GetAllowedMemberTypes()) > 0 {
 						var valueArrayAllowedMemberTypes []attr.Value
@@ -912,7 +928,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.ApplicationTemplateId = types.StringNull()
 			}
 			if responseApplication.GetCertification() != nil {
-				tfStateCertification := applicationsCertificationModel{}
+				tfStateCertification := sharedmodels.CertificationModel{}
 				responseCertification := responseApplication.GetCertification()
 
 				if responseCertification.GetCertificationDetailsUrl() != nil {
@@ -994,7 +1010,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.IdentifierUris = types.ListNull(types.StringType)
 			}
 			if responseApplication.GetInfo() != nil {
-				tfStateInformationalUrl := applicationsInformationalUrlModel{}
+				tfStateInformationalUrl := sharedmodels.InformationalUrlModel{}
 				responseInformationalUrl := responseApplication.GetInfo()
 
 				if responseInformationalUrl.GetLogoUrl() != nil {
@@ -1038,7 +1054,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 			if len(responseApplication.GetKeyCredentials()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseKeyCredential := range responseApplication.GetKeyCredentials() {
-					tfStateKeyCredential := applicationsKeyCredentialModel{}
+					tfStateKeyCredential := sharedmodels.KeyCredentialModel{}
 
 					if responseKeyCredential.GetCustomKeyIdentifier() != nil {
 						tfStateKeyCredential.CustomKeyIdentifier = types.StringValue(string(responseKeyCredential.GetCustomKeyIdentifier()[:]))
@@ -1106,13 +1122,13 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.Oauth2RequirePostResponse = types.BoolNull()
 			}
 			if responseApplication.GetOptionalClaims() != nil {
-				tfStateOptionalClaims := applicationsOptionalClaimsModel{}
+				tfStateOptionalClaims := sharedmodels.OptionalClaimsModel{}
 				responseOptionalClaims := responseApplication.GetOptionalClaims()
 
 				if len(responseOptionalClaims.GetAccessToken()) > 0 {
 					objectValues := []basetypes.ObjectValue{}
 					for _, responseOptionalClaim := range responseOptionalClaims.GetAccessToken() {
-						tfStateOptionalClaim := applicationsOptionalClaimModel{}
+						tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
 
 						if len(responseOptionalClaim.GetAdditionalProperties()) > 0 {
 							var valueArrayAdditionalProperties []attr.Value
@@ -1147,7 +1163,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				if len(responseOptionalClaims.GetIdToken()) > 0 {
 					objectValues := []basetypes.ObjectValue{}
 					for _, responseOptionalClaim := range responseOptionalClaims.GetIdToken() {
-						tfStateOptionalClaim := applicationsOptionalClaimModel{}
+						tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
 
 						if len(responseOptionalClaim.GetAdditionalProperties()) > 0 {
 							var valueArrayAdditionalProperties []attr.Value
@@ -1182,7 +1198,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				if len(responseOptionalClaims.GetSaml2Token()) > 0 {
 					objectValues := []basetypes.ObjectValue{}
 					for _, responseOptionalClaim := range responseOptionalClaims.GetSaml2Token() {
-						tfStateOptionalClaim := applicationsOptionalClaimModel{}
+						tfStateOptionalClaim := sharedmodels.OptionalClaimModel{}
 
 						if len(responseOptionalClaim.GetAdditionalProperties()) > 0 {
 							var valueArrayAdditionalProperties []attr.Value
@@ -1218,7 +1234,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.OptionalClaims, _ = types.ObjectValueFrom(ctx, tfStateOptionalClaims.AttributeTypes(), tfStateOptionalClaims)
 			}
 			if responseApplication.GetParentalControlSettings() != nil {
-				tfStateParentalControlSettings := applicationsParentalControlSettingsModel{}
+				tfStateParentalControlSettings := sharedmodels.ParentalControlSettingsModel{}
 				responseParentalControlSettings := responseApplication.GetParentalControlSettings()
 
 				if len(responseParentalControlSettings.GetCountriesBlockedForMinors()) > 0 {
@@ -1242,7 +1258,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 			if len(responseApplication.GetPasswordCredentials()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responsePasswordCredential := range responseApplication.GetPasswordCredentials() {
-					tfStatePasswordCredential := applicationsPasswordCredentialModel{}
+					tfStatePasswordCredential := sharedmodels.PasswordCredentialModel{}
 
 					if responsePasswordCredential.GetCustomKeyIdentifier() != nil {
 						tfStatePasswordCredential.CustomKeyIdentifier = types.StringValue(string(responsePasswordCredential.GetCustomKeyIdentifier()[:]))
@@ -1285,7 +1301,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.PasswordCredentials, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 			}
 			if responseApplication.GetPublicClient() != nil {
-				tfStatePublicClientApplication := applicationsPublicClientApplicationModel{}
+				tfStatePublicClientApplication := sharedmodels.PublicClientApplicationModel{}
 				responsePublicClientApplication := responseApplication.GetPublicClient()
 
 				if len(responsePublicClientApplication.GetRedirectUris()) > 0 {
@@ -1307,7 +1323,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.PublisherDomain = types.StringNull()
 			}
 			if responseApplication.GetRequestSignatureVerification() != nil {
-				tfStateRequestSignatureVerification := applicationsRequestSignatureVerificationModel{}
+				tfStateRequestSignatureVerification := sharedmodels.RequestSignatureVerificationModel{}
 				responseRequestSignatureVerification := responseApplication.GetRequestSignatureVerification()
 
 				if responseRequestSignatureVerification.GetAllowedWeakAlgorithms() != nil {
@@ -1326,12 +1342,12 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 			if len(responseApplication.GetRequiredResourceAccess()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseRequiredResourceAccess := range responseApplication.GetRequiredResourceAccess() {
-					tfStateRequiredResourceAccess := applicationsRequiredResourceAccessModel{}
+					tfStateRequiredResourceAccess := sharedmodels.RequiredResourceAccessModel{}
 
 					if len(responseRequiredResourceAccess.GetResourceAccess()) > 0 {
 						objectValues := []basetypes.ObjectValue{}
 						for _, responseResourceAccess := range responseRequiredResourceAccess.GetResourceAccess() {
-							tfStateResourceAccess := applicationsResourceAccessModel{}
+							tfStateResourceAccess := sharedmodels.ResourceAccessModel{}
 
 							if responseResourceAccess.GetId() != nil {
 								tfStateResourceAccess.Id = types.StringValue(responseResourceAccess.GetId().String())
@@ -1369,7 +1385,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.ServiceManagementReference = types.StringNull()
 			}
 			if responseApplication.GetServicePrincipalLockConfiguration() != nil {
-				tfStateServicePrincipalLockConfiguration := applicationsServicePrincipalLockConfigurationModel{}
+				tfStateServicePrincipalLockConfiguration := sharedmodels.ServicePrincipalLockConfigurationModel{}
 				responseServicePrincipalLockConfiguration := responseApplication.GetServicePrincipalLockConfiguration()
 
 				if responseServicePrincipalLockConfiguration.GetAllProperties() != nil {
@@ -1406,7 +1422,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.SignInAudience = types.StringNull()
 			}
 			if responseApplication.GetSpa() != nil {
-				tfStateSpaApplication := applicationsSpaApplicationModel{}
+				tfStateSpaApplication := sharedmodels.SpaApplicationModel{}
 				responseSpaApplication := responseApplication.GetSpa()
 
 				if len(responseSpaApplication.GetRedirectUris()) > 0 {
@@ -1443,7 +1459,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.UniqueName = types.StringNull()
 			}
 			if responseApplication.GetVerifiedPublisher() != nil {
-				tfStateVerifiedPublisher := applicationsVerifiedPublisherModel{}
+				tfStateVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 				responseVerifiedPublisher := responseApplication.GetVerifiedPublisher()
 
 				if responseVerifiedPublisher.GetAddedDateTime() != nil {
@@ -1465,7 +1481,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				tfStateApplication.VerifiedPublisher, _ = types.ObjectValueFrom(ctx, tfStateVerifiedPublisher.AttributeTypes(), tfStateVerifiedPublisher)
 			}
 			if responseApplication.GetWeb() != nil {
-				tfStateWebApplication := applicationsWebApplicationModel{}
+				tfStateWebApplication := sharedmodels.WebApplicationModel{}
 				responseWebApplication := responseApplication.GetWeb()
 
 				if responseWebApplication.GetHomePageUrl() != nil {
@@ -1474,7 +1490,7 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 					tfStateWebApplication.HomePageUrl = types.StringNull()
 				}
 				if responseWebApplication.GetImplicitGrantSettings() != nil {
-					tfStateImplicitGrantSettings := applicationsImplicitGrantSettingsModel{}
+					tfStateImplicitGrantSettings := sharedmodels.ImplicitGrantSettingsModel{}
 					responseImplicitGrantSettings := responseWebApplication.GetImplicitGrantSettings()
 
 					if responseImplicitGrantSettings.GetEnableAccessTokenIssuance() != nil {
@@ -1498,8 +1514,13 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 				if len(responseWebApplication.GetRedirectUriSettings()) > 0 {
 					objectValues := []basetypes.ObjectValue{}
 					for _, responseRedirectUriSettings := range responseWebApplication.GetRedirectUriSettings() {
-						tfStateRedirectUriSettings := applicationsRedirectUriSettingsModel{}
+						tfStateRedirectUriSettings := sharedmodels.RedirectUriSettingsModel{}
 
+						if responseRedirectUriSettings.GetIndex() != nil {
+							tfStateRedirectUriSettings.Index = types.Int64Value(int64(*responseRedirectUriSettings.GetIndex()))
+						} else {
+							tfStateRedirectUriSettings.Index = types.Int64Null()
+						}
 						if responseRedirectUriSettings.GetUri() != nil {
 							tfStateRedirectUriSettings.Uri = types.StringValue(*responseRedirectUriSettings.GetUri())
 						} else {
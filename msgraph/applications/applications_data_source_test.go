@@ -0,0 +1,27 @@
+package applications_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+func TestAccApplicationsDataSource(t *testing.T) {
+	config := `
+data "msgraph_applications" "test" {}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.msgraph_applications.test", "value.#"),
+				),
+			},
+		},
+	})
+}
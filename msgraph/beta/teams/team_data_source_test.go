@@ -0,0 +1,29 @@
+package teams_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+func TestAccTeamDataSource(t *testing.T) {
+	config := teamResourceConfig + `
+data "msgraph_beta_team" "test" {
+  id = msgraph_beta_team.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.msgraph_beta_team.test", "id", "msgraph_beta_team.test", "id"),
+				),
+			},
+		},
+	})
+}
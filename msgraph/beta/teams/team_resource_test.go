@@ -0,0 +1,51 @@
+package teams_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+const teamResourceConfig = `
+resource "msgraph_beta_team" "test" {
+  display_name = "Example"
+}
+`
+
+const teamResourceUpdateConfig = `
+resource "msgraph_beta_team" "test" {
+  display_name = "Example updated"
+}
+`
+
+func TestAccTeamResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: teamResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("msgraph_beta_team.test", "id"),
+					resource.TestCheckResourceAttr("msgraph_beta_team.test", "display_name", "Example"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "msgraph_beta_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read
+			{
+				Config: teamResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msgraph_beta_team.test", "display_name", "Example updated"),
+				),
+			},
+			// Delete is tested once the last step is done
+		},
+	})
+}
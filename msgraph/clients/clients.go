@@ -0,0 +1,59 @@
+package clients
+
+import (
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	azauth "github.com/microsoft/kiota-authentication-azure-go"
+	msgraphbetasdk "github.com/microsoftgraph/msgraph-beta-sdk-go"
+	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
+	msgraphgocore "github.com/microsoftgraph/msgraph-sdk-go-core"
+)
+
+// Clients is passed by the provider to every resource and data source, as their ProviderData
+type Clients struct {
+	// Client for the MS Graph v1.0 API, used by msgraph_* resources and data sources
+	V1 *msgraphsdk.GraphServiceClient
+
+	// Request adapter for the MS Graph beta API. msgraph_beta_* resources and data sources create their client from it with msgraph-beta-sdk-go,
+	// so they send requests with the same credential and transport as the v1.0 client
+	Beta abstractions.RequestAdapter
+}
+
+// The hosts of the national clouds the MS Graph API is served from, which the SDK sends tokens to
+var validHosts = []string{"graph.microsoft.com", "graph.microsoft.us", "dod-graph.microsoft.us", "graph.microsoft.de", "microsoftgraph.chinacloudapi.cn", "canary.graph.microsoft.com"}
+
+// New returns clients for the MS Graph v1.0 and beta APIs, authenticated with the credential.
+// wrapTransport, when not nil, wraps the transport the clients send requests with, e.g. to record or replay them
+func New(credential azcore.TokenCredential, scopes []string, wrapTransport func(http.RoundTripper) http.RoundTripper) (*Clients, error) {
+
+	auth, err := azauth.NewAzureIdentityAuthenticationProviderWithScopesAndValidHosts(credential, scopes, validHosts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both APIs are called through the same HTTP client, so there's a single transport to wrap
+	options := msgraphsdk.GetDefaultClientOptions()
+	httpClient := msgraphgocore.GetDefaultClient(&options)
+	if wrapTransport != nil {
+		httpClient.Transport = wrapTransport(httpClient.Transport)
+	}
+
+	v1Adapter, err := msgraphsdk.NewGraphRequestAdapterWithParseNodeFactoryAndSerializationWriterFactoryAndHttpClient(auth, nil, nil, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	// The beta clients set the base URL of the adapter to the beta API when they're created from it
+	betaAdapter, err := msgraphbetasdk.NewGraphRequestAdapterWithParseNodeFactoryAndSerializationWriterFactoryAndHttpClient(auth, nil, nil, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Clients{
+		V1:   msgraphsdk.NewGraphServiceClient(v1Adapter),
+		Beta: betaAdapter,
+	}, nil
+
+}
@@ -0,0 +1,49 @@
+package clients
+
+import (
+	"errors"
+	"regexp"
+
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	kiotahttp "github.com/microsoft/kiota-http-go"
+)
+
+// The ID in the location of an object, e.g. '/teams('00000000-0000-0000-0000-000000000000')'
+var locationIdRegexp = regexp.MustCompile(`\('([^']+)'\)`)
+
+// CreatedObject finds the ID of an object created by a POST request it's an option of.
+// Some objects are created asynchronously, e.g. teams, in which case the API responds with 202 Accepted and no body,
+// and only gives the location of the object, and of the operation creating it, in the headers of the response
+type CreatedObject struct {
+	headers *kiotahttp.HeadersInspectionOptions
+}
+
+func NewCreatedObject() *CreatedObject {
+	headers := kiotahttp.NewHeadersInspectionOptions()
+	headers.InspectResponseHeaders = true
+	return &CreatedObject{headers: headers}
+}
+
+// Options returns the request options to send the POST request with
+func (c *CreatedObject) Options() []abstractions.RequestOption {
+	return []abstractions.RequestOption{c.headers}
+}
+
+// Id returns the ID of the created object, from the response to the request when there was one, or otherwise from its location
+func (c *CreatedObject) Id(result interface{ GetId() *string }) (string, error) {
+
+	if result != nil && result.GetId() != nil {
+		return *result.GetId(), nil
+	}
+
+	// Content-Location is the location of the object, and Location the one of the operation creating it, under the object
+	for _, header := range []string{"Content-Location", "Location"} {
+		for _, location := range c.headers.GetResponseHeaders().Get(header) {
+			if match := locationIdRegexp.FindStringSubmatch(location); match != nil {
+				return match[1], nil
+			}
+		}
+	}
+
+	return "", errors.New("the response has neither an ID nor the location of the created object")
+}
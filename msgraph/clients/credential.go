@@ -0,0 +1,19 @@
+package clients
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// StaticTokenCredential gives the same token for every request, for tests calling something other than a tenant,
+// e.g. a fake MS Graph API or recorded responses
+type StaticTokenCredential struct {
+	Token string
+}
+
+func (c StaticTokenCredential) GetToken(ctx context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: c.Token, ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
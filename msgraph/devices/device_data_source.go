@@ -11,6 +11,9 @@ import (
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/devices"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -35,12 +38,12 @@ func (d *deviceDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *deviceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *deviceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -65,6 +68,10 @@ func (d *deviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 							Description: "For internal use only.",
 							Computed:    true,
 						},
+						"type": schema.Int64Attribute{
+							Description: "For internal use only.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -96,6 +103,10 @@ func (d *deviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: "Ownership of the device. Intune sets this property. Possible values are: unknown, company, personal.",
 				Computed:    true,
 			},
+			"device_version": schema.Int64Attribute{
+				Description: "For internal use only.",
+				Computed:    true,
+			},
 			"display_name": schema.StringAttribute{
 				Description: "The display name for the device. Required. Supports $filter (eq, ne, not, ge, le, in, startsWith, and eq on null values), $search, and $orderby.",
 				Computed:    true,
@@ -267,7 +278,7 @@ func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if len(responseDevice.GetAlternativeSecurityIds()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAlternativeSecurityId := range responseDevice.GetAlternativeSecurityIds() {
-			tfStateAlternativeSecurityId := deviceAlternativeSecurityIdModel{}
+			tfStateAlternativeSecurityId := sharedmodels.AlternativeSecurityIdModel{}
 
 			if responseAlternativeSecurityId.GetIdentityProvider() != nil {
 				tfStateAlternativeSecurityId.IdentityProvider = types.StringValue(*responseAlternativeSecurityId.GetIdentityProvider())
@@ -279,6 +290,11 @@ func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			} else {
 				tfStateAlternativeSecurityId.Key = types.StringNull()
 			}
+			if responseAlternativeSecurityId.GetTypeEscaped() != nil {
+				tfStateAlternativeSecurityId.Type = types.Int64Value(int64(*responseAlternativeSecurityId.GetTypeEscaped()))
+			} else {
+				tfStateAlternativeSecurityId.Type = types.Int64Null()
+			}
 			objectValue, _ := types.ObjectValueFrom(ctx, tfStateAlternativeSecurityId.AttributeTypes(), tfStateAlternativeSecurityId)
 			objectValues = append(objectValues, objectValue)
 		}
@@ -319,6 +335,11 @@ func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	} else {
 		tfStateDevice.DeviceOwnership = types.StringNull()
 	}
+	if responseDevice.GetDeviceVersion() != nil {
+		tfStateDevice.DeviceVersion = types.Int64Value(int64(*responseDevice.GetDeviceVersion()))
+	} else {
+		tfStateDevice.DeviceVersion = types.Int64Null()
+	}
 	if responseDevice.GetDisplayName() != nil {
 		tfStateDevice.DisplayName = types.StringValue(*responseDevice.GetDisplayName())
 	} else {
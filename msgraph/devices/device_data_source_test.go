@@ -0,0 +1,29 @@
+package devices_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+func TestAccDeviceDataSource(t *testing.T) {
+	config := deviceResourceConfig + `
+data "msgraph_device" "test" {
+  id = msgraph_device.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.msgraph_device.test", "id", "msgraph_device.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -3,6 +3,8 @@ package devices
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 type deviceModel struct {
@@ -43,7 +45,7 @@ type deviceModel struct {
 func (m deviceModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"account_enabled":                    types.BoolType,
-		"alternative_security_ids":           types.ListType{ElemType: types.ObjectType{AttrTypes: deviceAlternativeSecurityIdModel{}.AttributeTypes()}},
+		"alternative_security_ids":           types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.AlternativeSecurityIdModel{}.AttributeTypes()}},
 		"approximate_last_sign_in_date_time": types.StringType,
 		"compliance_expiration_date_time":    types.StringType,
 		"deleted_date_time":                  types.StringType,
@@ -76,17 +78,3 @@ func (m deviceModel) AttributeTypes() map[string]attr.Type {
 		"trust_type":                         types.StringType,
 	}
 }
-
-type deviceAlternativeSecurityIdModel struct {
-	IdentityProvider types.String `tfsdk:"identity_provider"`
-	Key              types.String `tfsdk:"key"`
-	Type             types.Int64  `tfsdk:"type"`
-}
-
-func (m deviceAlternativeSecurityIdModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"identity_provider": types.StringType,
-		"key":               types.StringType,
-		"type":              types.Int64Type,
-	}
-}
@@ -2,6 +2,7 @@ package devices
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -9,13 +10,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"strings"
 	"time"
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/devices"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers"
+	"terraform-provider-msgraph/planmodifiers/int64planmodifiers"
 	"terraform-provider-msgraph/planmodifiers/listplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/stringplanmodifiers"
 )
@@ -42,12 +47,12 @@ func (d *deviceResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // Configure adds the provider configured client to the resource.
-func (d *deviceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (d *deviceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the resource.
@@ -88,6 +93,14 @@ func (d *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 								stringplanmodifiers.UseStateForUnconfigured(),
 							},
 						},
+						"type": schema.Int64Attribute{
+							Description: "For internal use only.",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifiers.UseStateForUnconfigured(),
+							},
+						},
 					},
 				},
 			},
@@ -147,6 +160,14 @@ func (d *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringplanmodifiers.UseStateForUnconfigured(),
 				},
 			},
+			"device_version": schema.Int64Attribute{
+				Description: "For internal use only.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifiers.UseStateForUnconfigured(),
+				},
+			},
 			"display_name": schema.StringAttribute{
 				Description: "The display name for the device. Required. Supports $filter (eq, ne, not, ge, le, in, startsWith, and eq on null values), $search, and $orderby.",
 				Optional:    true,
@@ -352,7 +373,7 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		var requestBodyAlternativeSecurityIds []models.AlternativeSecurityIdable
 		for _, i := range tfPlanDevice.AlternativeSecurityIds.Elements() {
 			requestBodyAlternativeSecurityId := models.NewAlternativeSecurityId()
-			tfPlanAlternativeSecurityId := deviceAlternativeSecurityIdModel{}
+			tfPlanAlternativeSecurityId := sharedmodels.AlternativeSecurityIdModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAlternativeSecurityId)
 
 			if !tfPlanAlternativeSecurityId.IdentityProvider.IsUnknown() {
@@ -369,6 +390,13 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 				tfPlanAlternativeSecurityId.Key = types.StringNull()
 			}
 
+			if !tfPlanAlternativeSecurityId.Type.IsUnknown() {
+				tfPlanType := int32(tfPlanAlternativeSecurityId.Type.ValueInt64())
+				requestBodyAlternativeSecurityId.SetTypeEscaped(&tfPlanType)
+			} else {
+				tfPlanAlternativeSecurityId.Type = types.Int64Null()
+			}
+
 		}
 		requestBodyDevice.SetAlternativeSecurityIds(requestBodyAlternativeSecurityIds)
 	} else {
@@ -427,6 +455,13 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		tfPlanDevice.DeviceOwnership = types.StringNull()
 	}
 
+	if !tfPlanDevice.DeviceVersion.IsUnknown() {
+		tfPlanDeviceVersion := int32(tfPlanDevice.DeviceVersion.ValueInt64())
+		requestBodyDevice.SetDeviceVersion(&tfPlanDeviceVersion)
+	} else {
+		tfPlanDevice.DeviceVersion = types.Int64Null()
+	}
+
 	if !tfPlanDevice.DisplayName.IsUnknown() {
 		tfPlanDisplayName := tfPlanDevice.DisplayName.ValueString()
 		requestBodyDevice.SetDisplayName(&tfPlanDisplayName)
@@ -590,7 +625,10 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create new Device
-	result, err := r.client.Devices().Post(context.Background(), requestBodyDevice, nil)
+	created := clients.NewCreatedObject()
+	result, err := r.client.Devices().Post(ctx, requestBodyDevice, &devices.DevicesRequestBuilderPostRequestConfiguration{
+		Options: created.Options(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Device",
@@ -601,7 +639,15 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Map response body to schema and populate Computed attribute value
 	// TODO: Add support for other Computed values
-	tfPlanDevice.Id = types.StringValue(*result.GetId())
+	id, err := created.Id(result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Device",
+			err.Error(),
+		)
+		return
+	}
+	tfPlanDevice.Id = types.StringValue(id)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, tfPlanDevice)
@@ -688,7 +734,7 @@ func (d *deviceResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if len(responseDevice.GetAlternativeSecurityIds()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAlternativeSecurityId := range responseDevice.GetAlternativeSecurityIds() {
-			tfStateAlternativeSecurityId := deviceAlternativeSecurityIdModel{}
+			tfStateAlternativeSecurityId := sharedmodels.AlternativeSecurityIdModel{}
 
 			if responseAlternativeSecurityId.GetIdentityProvider() != nil {
 				tfStateAlternativeSecurityId.IdentityProvider = types.StringValue(*responseAlternativeSecurityId.GetIdentityProvider())
@@ -700,6 +746,11 @@ func (d *deviceResource) Read(ctx context.Context, req resource.ReadRequest, res
 			} else {
 				tfStateAlternativeSecurityId.Key = types.StringNull()
 			}
+			if responseAlternativeSecurityId.GetTypeEscaped() != nil {
+				tfStateAlternativeSecurityId.Type = types.Int64Value(int64(*responseAlternativeSecurityId.GetTypeEscaped()))
+			} else {
+				tfStateAlternativeSecurityId.Type = types.Int64Null()
+			}
 			objectValue, _ := types.ObjectValueFrom(ctx, tfStateAlternativeSecurityId.AttributeTypes(), tfStateAlternativeSecurityId)
 			objectValues = append(objectValues, objectValue)
 		}
@@ -740,6 +791,11 @@ func (d *deviceResource) Read(ctx context.Context, req resource.ReadRequest, res
 	} else {
 		tfStateDevice.DeviceOwnership = types.StringNull()
 	}
+	if responseDevice.GetDeviceVersion() != nil {
+		tfStateDevice.DeviceVersion = types.Int64Value(int64(*responseDevice.GetDeviceVersion()))
+	} else {
+		tfStateDevice.DeviceVersion = types.Int64Null()
+	}
 	if responseDevice.GetDisplayName() != nil {
 		tfStateDevice.DisplayName = types.StringValue(*responseDevice.GetDisplayName())
 	} else {
@@ -897,25 +953,47 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if !tfPlanDevice.AlternativeSecurityIds.Equal(tfStateDevice.AlternativeSecurityIds) {
-		var tfPlanAlternativeSecurityIds []models.AlternativeSecurityIdable
-		for k, i := range tfPlanDevice.AlternativeSecurityIds.Elements() {
+		var objectArrayAlternativeSecurityIds []models.AlternativeSecurityIdable
+		objectValuesAlternativeSecurityIds := []basetypes.ObjectValue{}
+		tfStateElementsAlternativeSecurityIds := tfStateDevice.AlternativeSecurityIds.Elements()
+		for n, i := range tfPlanDevice.AlternativeSecurityIds.Elements() {
 			requestBodyAlternativeSecurityId := models.NewAlternativeSecurityId()
-			tfPlanAlternativeSecurityId := deviceAlternativeSecurityIdModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAlternativeSecurityId)
-			tfStateAlternativeSecurityId := deviceAlternativeSecurityIdModel{}
-			types.ListValueFrom(ctx, tfStateDevice.AlternativeSecurityIds.Elements()[k].Type(ctx), &tfPlanAlternativeSecurityId)
+			tfPlanAlternativeSecurityId := sharedmodels.AlternativeSecurityIdModel{}
+			i.(types.Object).As(ctx, &tfPlanAlternativeSecurityId, basetypes.ObjectAsOptions{})
+			tfStateAlternativeSecurityId := sharedmodels.AlternativeSecurityIdModel{}
+			if n < len(tfStateElementsAlternativeSecurityIds) {
+				tfStateElementsAlternativeSecurityIds[n].(types.Object).As(ctx, &tfStateAlternativeSecurityId, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanAlternativeSecurityId.IdentityProvider.Equal(tfStateAlternativeSecurityId.IdentityProvider) {
+			if tfPlanAlternativeSecurityId.IdentityProvider.IsUnknown() {
+				tfPlanAlternativeSecurityId.IdentityProvider = tfStateAlternativeSecurityId.IdentityProvider
+			}
+			if !tfPlanAlternativeSecurityId.IdentityProvider.IsNull() {
 				tfPlanIdentityProvider := tfPlanAlternativeSecurityId.IdentityProvider.ValueString()
 				requestBodyAlternativeSecurityId.SetIdentityProvider(&tfPlanIdentityProvider)
 			}
 
-			if !tfPlanAlternativeSecurityId.Key.Equal(tfStateAlternativeSecurityId.Key) {
+			if tfPlanAlternativeSecurityId.Key.IsUnknown() {
+				tfPlanAlternativeSecurityId.Key = tfStateAlternativeSecurityId.Key
+			}
+			if !tfPlanAlternativeSecurityId.Key.IsNull() {
 				tfPlanKey := tfPlanAlternativeSecurityId.Key.ValueString()
 				requestBodyAlternativeSecurityId.SetKey([]byte(tfPlanKey))
 			}
+
+			if tfPlanAlternativeSecurityId.Type.IsUnknown() {
+				tfPlanAlternativeSecurityId.Type = tfStateAlternativeSecurityId.Type
+			}
+			if !tfPlanAlternativeSecurityId.Type.IsNull() {
+				tfPlanType := int32(tfPlanAlternativeSecurityId.Type.ValueInt64())
+				requestBodyAlternativeSecurityId.SetTypeEscaped(&tfPlanType)
+			}
+			objectArrayAlternativeSecurityIds = append(objectArrayAlternativeSecurityIds, requestBodyAlternativeSecurityId)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanAlternativeSecurityId.AttributeTypes(), tfPlanAlternativeSecurityId)
+			objectValuesAlternativeSecurityIds = append(objectValuesAlternativeSecurityIds, objectValue)
 		}
-		requestBodyDevice.SetAlternativeSecurityIds(tfPlanAlternativeSecurityIds)
+		requestBodyDevice.SetAlternativeSecurityIds(objectArrayAlternativeSecurityIds)
+		tfPlanDevice.AlternativeSecurityIds, _ = types.ListValueFrom(ctx, tfPlanDevice.AlternativeSecurityIds.ElementType(ctx), objectValuesAlternativeSecurityIds)
 	}
 
 	if !tfPlanDevice.ApproximateLastSignInDateTime.Equal(tfStateDevice.ApproximateLastSignInDateTime) {
@@ -956,6 +1034,11 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		requestBodyDevice.SetDeviceOwnership(&tfPlanDeviceOwnership)
 	}
 
+	if !tfPlanDevice.DeviceVersion.Equal(tfStateDevice.DeviceVersion) {
+		tfPlanDeviceVersion := int32(tfPlanDevice.DeviceVersion.ValueInt64())
+		requestBodyDevice.SetDeviceVersion(&tfPlanDeviceVersion)
+	}
+
 	if !tfPlanDevice.DisplayName.Equal(tfStateDevice.DisplayName) {
 		tfPlanDisplayName := tfPlanDevice.DisplayName.ValueString()
 		requestBodyDevice.SetDisplayName(&tfPlanDisplayName)
@@ -1045,7 +1128,7 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if !tfPlanDevice.PhysicalIds.Equal(tfStateDevice.PhysicalIds) {
 		var stringArrayPhysicalIds []string
 		for _, i := range tfPlanDevice.PhysicalIds.Elements() {
-			stringArrayPhysicalIds = append(stringArrayPhysicalIds, i.String())
+			stringArrayPhysicalIds = append(stringArrayPhysicalIds, i.(types.String).ValueString())
 		}
 		requestBodyDevice.SetPhysicalIds(stringArrayPhysicalIds)
 	}
@@ -1064,7 +1147,7 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if !tfPlanDevice.SystemLabels.Equal(tfStateDevice.SystemLabels) {
 		var stringArraySystemLabels []string
 		for _, i := range tfPlanDevice.SystemLabels.Elements() {
-			stringArraySystemLabels = append(stringArraySystemLabels, i.String())
+			stringArraySystemLabels = append(stringArraySystemLabels, i.(types.String).ValueString())
 		}
 		requestBodyDevice.SetSystemLabels(stringArraySystemLabels)
 	}
@@ -1103,8 +1186,7 @@ func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// TODO: Delete device
-	err := r.client.Devices().ByDeviceId(tfStateDevice.Id.ValueString()).Delete(context.Background(), nil)
+	err := r.client.Devices().ByDeviceId(tfStateDevice.Id.ValueString()).Delete(ctx, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting device",
@@ -1116,5 +1198,90 @@ func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *deviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Besides its ID, the object can be imported by 'deviceId:<device_id>', which is looked up to find its ID
+	var filter string
+	prefix, value, _ := strings.Cut(req.ID, ":")
+	switch prefix {
+	case "deviceId":
+		filter = fmt.Sprintf("deviceId eq '%s'", strings.ReplaceAll(value, "'", "''"))
+	default:
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	qparams := devices.DevicesRequestBuilderGetRequestConfiguration{
+		QueryParameters: &devices.DevicesRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Select: []string{"id"},
+		},
+	}
+	result, err := r.client.Devices().Get(ctx, &qparams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing device",
+			err.Error(),
+		)
+		return
+	}
+	if len(result.GetValue()) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier %q to match a single device. Matched %d", req.ID, len(result.GetValue())),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *result.GetValue()[0].GetId())...)
+}
+
+// ListIds returns the IDs of the objects matching filter, an OData $filter expression, or of every object when it's empty.
+// It's used to export the existing objects of a tenant
+func (r *deviceResource) ListIds(ctx context.Context, filter string) ([]string, error) {
+
+	qparams := devices.DevicesRequestBuilderGetRequestConfiguration{
+		QueryParameters: &devices.DevicesRequestBuilderGetQueryParameters{
+			Select: []string{"id"},
+		},
+	}
+	if filter != "" {
+		qparams.QueryParameters.Filter = &filter
+	}
+
+	result, err := r.client.Devices().Get(ctx, &qparams)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for {
+		for _, object := range result.GetValue() {
+			ids = append(ids, *object.GetId())
+		}
+		nextLink := result.GetOdataNextLink()
+		if nextLink == nil {
+			return ids, nil
+		}
+		result, err = r.client.Devices().WithUrl(*nextLink).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+}
+
+// ReadOnlyAttributes returns the paths of the attributes the API sets, which exports leave out of the configuration they write
+func (r *deviceResource) ReadOnlyAttributes() []string {
+	return []string{
+		"approximate_last_sign_in_date_time",
+		"compliance_expiration_date_time",
+		"id",
+		"is_compliant",
+		"manufacturer",
+		"mdm_app_id",
+		"model",
+		"on_premises_last_sync_date_time",
+		"on_premises_security_identifier",
+		"on_premises_sync_enabled",
+		"registration_date_time",
+		"trust_type",
+	}
 }
@@ -0,0 +1,61 @@
+package devices_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+const deviceResourceConfig = `
+resource "msgraph_device" "test" {
+  display_name = "Example"
+}
+`
+
+const deviceResourceUpdateConfig = `
+resource "msgraph_device" "test" {
+  display_name = "Example updated"
+}
+`
+
+func TestAccDeviceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: deviceResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("msgraph_device.test", "id"),
+					resource.TestCheckResourceAttr("msgraph_device.test", "display_name", "Example"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "msgraph_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import by device_id
+			{
+				ResourceName:      "msgraph_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "deviceId:" + s.RootModule().Resources["msgraph_device.test"].Primary.Attributes["device_id"], nil
+				},
+			},
+			// Update and Read
+			{
+				Config: deviceResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msgraph_device.test", "display_name", "Example updated"),
+				),
+			},
+			// Delete is tested once the last step is done
+		},
+	})
+}
@@ -10,6 +10,9 @@ import (
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/devices"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,12 +37,12 @@ func (d *devicesDataSource) Metadata(_ context.Context, req datasource.MetadataR
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *devicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *devicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -69,6 +72,10 @@ func (d *devicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 										Description: "For internal use only.",
 										Computed:    true,
 									},
+									"type": schema.Int64Attribute{
+										Description: "For internal use only.",
+										Computed:    true,
+									},
 								},
 							},
 						},
@@ -100,6 +107,10 @@ func (d *devicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 							Description: "Ownership of the device. Intune sets this property. Possible values are: unknown, company, personal.",
 							Computed:    true,
 						},
+						"device_version": schema.Int64Attribute{
+							Description: "For internal use only.",
+							Computed:    true,
+						},
 						"display_name": schema.StringAttribute{
 							Description: "The display name for the device. Required. Supports $filter (eq, ne, not, ge, le, in, startsWith, and eq on null values), $search, and $orderby.",
 							Computed:    true,
@@ -236,7 +247,7 @@ func (d *devicesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			if len(responseDevice.GetAlternativeSecurityIds()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseAlternativeSecurityId := range responseDevice.GetAlternativeSecurityIds() {
-					tfStateAlternativeSecurityId := devicesAlternativeSecurityIdModel{}
+					tfStateAlternativeSecurityId := sharedmodels.AlternativeSecurityIdModel{}
 
 					if responseAlternativeSecurityId.GetIdentityProvider() != nil {
 						tfStateAlternativeSecurityId.IdentityProvider = types.StringValue(*responseAlternativeSecurityId.GetIdentityProvider())
@@ -248,6 +259,11 @@ func (d *devicesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 					} else {
 						tfStateAlternativeSecurityId.Key = types.StringNull()
 					}
+					if responseAlternativeSecurityId.GetTypeEscaped() != nil {
+						tfStateAlternativeSecurityId.Type = types.Int64Value(int64(*responseAlternativeSecurityId.GetTypeEscaped()))
+					} else {
+						tfStateAlternativeSecurityId.Type = types.Int64Null()
+					}
 					objectValue, _ := types.ObjectValueFrom(ctx, tfStateAlternativeSecurityId.AttributeTypes(), tfStateAlternativeSecurityId)
 					objectValues = append(objectValues, objectValue)
 				}
@@ -288,6 +304,11 @@ func (d *devicesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			} else {
 				tfStateDevice.DeviceOwnership = types.StringNull()
 			}
+			if responseDevice.GetDeviceVersion() != nil {
+				tfStateDevice.DeviceVersion = types.Int64Value(int64(*responseDevice.GetDeviceVersion()))
+			} else {
+				tfStateDevice.DeviceVersion = types.Int64Null()
+			}
 			if responseDevice.GetDisplayName() != nil {
 				tfStateDevice.DisplayName = types.StringValue(*responseDevice.GetDisplayName())
 			} else {
@@ -3,6 +3,8 @@ package devices
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 type devicesModel struct {
@@ -53,7 +55,7 @@ type devicesDeviceModel struct {
 func (m devicesDeviceModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"account_enabled":                    types.BoolType,
-		"alternative_security_ids":           types.ListType{ElemType: types.ObjectType{AttrTypes: devicesAlternativeSecurityIdModel{}.AttributeTypes()}},
+		"alternative_security_ids":           types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.AlternativeSecurityIdModel{}.AttributeTypes()}},
 		"approximate_last_sign_in_date_time": types.StringType,
 		"compliance_expiration_date_time":    types.StringType,
 		"deleted_date_time":                  types.StringType,
@@ -86,17 +88,3 @@ func (m devicesDeviceModel) AttributeTypes() map[string]attr.Type {
 		"trust_type":                         types.StringType,
 	}
 }
-
-type devicesAlternativeSecurityIdModel struct {
-	IdentityProvider types.String `tfsdk:"identity_provider"`
-	Key              types.String `tfsdk:"key"`
-	Type             types.Int64  `tfsdk:"type"`
-}
-
-func (m devicesAlternativeSecurityIdModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"identity_provider": types.StringType,
-		"key":               types.StringType,
-		"type":              types.Int64Type,
-	}
-}
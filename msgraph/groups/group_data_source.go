@@ -11,6 +11,9 @@ import (
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/groups"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -35,12 +38,12 @@ func (d *groupDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *groupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *groupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -336,7 +339,7 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if len(responseGroup.GetAssignedLabels()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAssignedLabel := range responseGroup.GetAssignedLabels() {
-			tfStateAssignedLabel := groupAssignedLabelModel{}
+			tfStateAssignedLabel := sharedmodels.AssignedLabelModel{}
 
 			if responseAssignedLabel.GetDisplayName() != nil {
 				tfStateAssignedLabel.DisplayName = types.StringValue(*responseAssignedLabel.GetDisplayName())
@@ -356,7 +359,7 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if len(responseGroup.GetAssignedLicenses()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAssignedLicense := range responseGroup.GetAssignedLicenses() {
-			tfStateAssignedLicense := groupAssignedLicenseModel{}
+			tfStateAssignedLicense := sharedmodels.AssignedLicenseModel{}
 
 			if len(responseAssignedLicense.GetDisabledPlans()) > 0 {
 				var valueArrayDisabledPlans []attr.Value
@@ -433,7 +436,7 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		tfStateGroup.IsManagementRestricted = types.BoolNull()
 	}
 	if responseGroup.GetLicenseProcessingState() != nil {
-		tfStateLicenseProcessingState := groupLicenseProcessingStateModel{}
+		tfStateLicenseProcessingState := sharedmodels.LicenseProcessingStateModel{}
 		responseLicenseProcessingState := responseGroup.GetLicenseProcessingState()
 
 		if responseLicenseProcessingState.GetState() != nil {
@@ -487,7 +490,7 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if len(responseGroup.GetOnPremisesProvisioningErrors()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseOnPremisesProvisioningError := range responseGroup.GetOnPremisesProvisioningErrors() {
-			tfStateOnPremisesProvisioningError := groupOnPremisesProvisioningErrorModel{}
+			tfStateOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
 
 			if responseOnPremisesProvisioningError.GetCategory() != nil {
 				tfStateOnPremisesProvisioningError.Category = types.StringValue(*responseOnPremisesProvisioningError.GetCategory())
@@ -567,7 +570,7 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if len(responseGroup.GetServiceProvisioningErrors()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseServiceProvisioningError := range responseGroup.GetServiceProvisioningErrors() {
-			tfStateServiceProvisioningError := groupServiceProvisioningErrorModel{}
+			tfStateServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
 
 			if responseServiceProvisioningError.GetCreatedDateTime() != nil {
 				tfStateServiceProvisioningError.CreatedDateTime = types.StringValue(responseServiceProvisioningError.GetCreatedDateTime().String())
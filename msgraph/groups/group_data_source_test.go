@@ -0,0 +1,29 @@
+package groups_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+func TestAccGroupDataSource(t *testing.T) {
+	config := groupResourceConfig + `
+data "msgraph_group" "test" {
+  id = msgraph_group.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.msgraph_group.test", "id", "msgraph_group.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -2,20 +2,29 @@ package groups
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"strings"
 	"time"
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/groups"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/models/odataerrors"
 
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/listplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/objectplanmodifiers"
@@ -26,6 +35,7 @@ import (
 var (
 	_ resource.Resource              = &groupResource{}
 	_ resource.ResourceWithConfigure = &groupResource{}
+	_ resource.ResourceWithMoveState = &groupResource{}
 )
 
 // NewGroupResource is a helper function to simplify the provider implementation.
@@ -38,18 +48,25 @@ type groupResource struct {
 	client *msgraphsdk.GraphServiceClient
 }
 
+// groupResourceModel is the model of the resource, which has the attributes controlling how it's deleted besides those of the group
+type groupResourceModel struct {
+	groupModel
+	PreventDeletion   types.Bool `tfsdk:"prevent_deletion"`
+	PermanentlyDelete types.Bool `tfsdk:"permanently_delete"`
+}
+
 // Metadata returns the resource type name.
 func (d *groupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_group"
 }
 
 // Configure adds the provider configured client to the resource.
-func (d *groupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (d *groupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the resource.
@@ -450,9 +467,20 @@ func (d *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Specifies the group join policy and group content visibility for groups. Possible values are: Private, Public, or HiddenMembership. HiddenMembership can be set only for Microsoft 365 groups when the groups are created. It can't be updated later. Other values of visibility can be updated after group creation. If visibility value isn't specified during group creation on Microsoft Graph, a security group is created as Private by default, and the Microsoft 365 group is Public. Groups assignable to roles are always Private. To learn more, see group visibility options. Returned by default. Nullable.",
 				Optional:    true,
 				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifiers.UseStateForUnconfigured(),
-				},
+				Default:     stringdefault.StaticString("Private"),
+			},
+
+			"prevent_deletion": schema.BoolAttribute{
+				Description: "Whether deleting the resource fails, to protect the object from being deleted by Terraform. Set it to `false`, and apply, before the resource can be deleted. Not sent to the API. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"permanently_delete": schema.BoolAttribute{
+				Description: "Whether the object is also purged from the deleted items of the directory when the resource is deleted, instead of being kept there for 30 days to be restored. Not sent to the API. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -461,7 +489,7 @@ func (d *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 // Create creates the resource and sets the initial Terraform state.
 func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from Terraform plan
-	var tfPlanGroup groupModel
+	var tfPlanGroup groupResourceModel
 	diags := req.Plan.Get(ctx, &tfPlanGroup)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -474,7 +502,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		var requestBodyAssignedLabels []models.AssignedLabelable
 		for _, i := range tfPlanGroup.AssignedLabels.Elements() {
 			requestBodyAssignedLabel := models.NewAssignedLabel()
-			tfPlanAssignedLabel := groupAssignedLabelModel{}
+			tfPlanAssignedLabel := sharedmodels.AssignedLabelModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAssignedLabel)
 
 			if !tfPlanAssignedLabel.DisplayName.IsUnknown() {
@@ -501,7 +529,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		var requestBodyAssignedLicenses []models.AssignedLicenseable
 		for _, i := range tfPlanGroup.AssignedLicenses.Elements() {
 			requestBodyAssignedLicense := models.NewAssignedLicense()
-			tfPlanAssignedLicense := groupAssignedLicenseModel{}
+			tfPlanAssignedLicense := sharedmodels.AssignedLicenseModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAssignedLicense)
 
 			if len(tfPlanAssignedLicense.DisabledPlans.Elements()) > 0 {
@@ -607,7 +635,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	if !tfPlanGroup.LicenseProcessingState.IsUnknown() {
 		requestBodyLicenseProcessingState := models.NewLicenseProcessingState()
-		tfPlanLicenseProcessingState := groupLicenseProcessingStateModel{}
+		tfPlanLicenseProcessingState := sharedmodels.LicenseProcessingStateModel{}
 		tfPlanGroup.LicenseProcessingState.As(ctx, &tfPlanLicenseProcessingState, basetypes.ObjectAsOptions{})
 
 		if !tfPlanLicenseProcessingState.State.IsUnknown() {
@@ -684,7 +712,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		var requestBodyOnPremisesProvisioningErrors []models.OnPremisesProvisioningErrorable
 		for _, i := range tfPlanGroup.OnPremisesProvisioningErrors.Elements() {
 			requestBodyOnPremisesProvisioningError := models.NewOnPremisesProvisioningError()
-			tfPlanOnPremisesProvisioningError := groupOnPremisesProvisioningErrorModel{}
+			tfPlanOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOnPremisesProvisioningError)
 
 			if !tfPlanOnPremisesProvisioningError.Category.IsUnknown() {
@@ -793,7 +821,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		var requestBodyServiceProvisioningErrors []models.ServiceProvisioningErrorable
 		for _, i := range tfPlanGroup.ServiceProvisioningErrors.Elements() {
 			requestBodyServiceProvisioningError := models.NewServiceProvisioningError()
-			tfPlanServiceProvisioningError := groupServiceProvisioningErrorModel{}
+			tfPlanServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanServiceProvisioningError)
 
 			if !tfPlanServiceProvisioningError.CreatedDateTime.IsUnknown() {
@@ -846,7 +874,10 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create new Group
-	result, err := r.client.Groups().Post(context.Background(), requestBodyGroup, nil)
+	created := clients.NewCreatedObject()
+	result, err := r.client.Groups().Post(ctx, requestBodyGroup, &groups.GroupsRequestBuilderPostRequestConfiguration{
+		Options: created.Options(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Group",
@@ -857,7 +888,15 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Map response body to schema and populate Computed attribute value
 	// TODO: Add support for other Computed values
-	tfPlanGroup.Id = types.StringValue(*result.GetId())
+	id, err := created.Id(result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Group",
+			err.Error(),
+		)
+		return
+	}
+	tfPlanGroup.Id = types.StringValue(id)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, tfPlanGroup)
@@ -870,7 +909,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (d *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var tfStateGroup groupModel
+	var tfStateGroup groupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &tfStateGroup)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -942,7 +981,7 @@ func (d *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseGroup.GetAssignedLabels()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAssignedLabel := range responseGroup.GetAssignedLabels() {
-			tfStateAssignedLabel := groupAssignedLabelModel{}
+			tfStateAssignedLabel := sharedmodels.AssignedLabelModel{}
 
 			if responseAssignedLabel.GetDisplayName() != nil {
 				tfStateAssignedLabel.DisplayName = types.StringValue(*responseAssignedLabel.GetDisplayName())
@@ -962,7 +1001,7 @@ func (d *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseGroup.GetAssignedLicenses()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAssignedLicense := range responseGroup.GetAssignedLicenses() {
-			tfStateAssignedLicense := groupAssignedLicenseModel{}
+			tfStateAssignedLicense := sharedmodels.AssignedLicenseModel{}
 
 			if len(responseAssignedLicense.GetDisabledPlans()) > 0 {
 				var valueArrayDisabledPlans []attr.Value
@@ -1039,7 +1078,7 @@ func (d *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		tfStateGroup.IsManagementRestricted = types.BoolNull()
 	}
 	if responseGroup.GetLicenseProcessingState() != nil {
-		tfStateLicenseProcessingState := groupLicenseProcessingStateModel{}
+		tfStateLicenseProcessingState := sharedmodels.LicenseProcessingStateModel{}
 		responseLicenseProcessingState := responseGroup.GetLicenseProcessingState()
 
 		if responseLicenseProcessingState.GetState() != nil {
@@ -1093,7 +1132,7 @@ func (d *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseGroup.GetOnPremisesProvisioningErrors()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseOnPremisesProvisioningError := range responseGroup.GetOnPremisesProvisioningErrors() {
-			tfStateOnPremisesProvisioningError := groupOnPremisesProvisioningErrorModel{}
+			tfStateOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
 
 			if responseOnPremisesProvisioningError.GetCategory() != nil {
 				tfStateOnPremisesProvisioningError.Category = types.StringValue(*responseOnPremisesProvisioningError.GetCategory())
@@ -1173,7 +1212,7 @@ func (d *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseGroup.GetServiceProvisioningErrors()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseServiceProvisioningError := range responseGroup.GetServiceProvisioningErrors() {
-			tfStateServiceProvisioningError := groupServiceProvisioningErrorModel{}
+			tfStateServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
 
 			if responseServiceProvisioningError.GetCreatedDateTime() != nil {
 				tfStateServiceProvisioningError.CreatedDateTime = types.StringValue(responseServiceProvisioningError.GetCreatedDateTime().String())
@@ -1211,6 +1250,14 @@ func (d *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		tfStateGroup.Visibility = types.StringNull()
 	}
 
+	// Not set when the resource is imported
+	if tfStateGroup.PreventDeletion.IsNull() {
+		tfStateGroup.PreventDeletion = types.BoolValue(false)
+	}
+	if tfStateGroup.PermanentlyDelete.IsNull() {
+		tfStateGroup.PermanentlyDelete = types.BoolValue(false)
+	}
+
 	// Overwrite items with refreshed state
 	diags := resp.State.Set(ctx, &tfStateGroup)
 	resp.Diagnostics.Append(diags...)
@@ -1223,7 +1270,7 @@ func (d *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from Terraform plan
-	var tfPlanGroup groupModel
+	var tfPlanGroup groupResourceModel
 	diags := req.Plan.Get(ctx, &tfPlanGroup)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1231,7 +1278,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Get current Terraform state
-	var tfStateGroup groupModel
+	var tfStateGroup groupResourceModel
 	diags = req.State.Get(ctx, &tfStateGroup)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1242,52 +1289,80 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	requestBodyGroup := models.NewGroup()
 
 	if !tfPlanGroup.AssignedLabels.Equal(tfStateGroup.AssignedLabels) {
-		var tfPlanAssignedLabels []models.AssignedLabelable
-		for k, i := range tfPlanGroup.AssignedLabels.Elements() {
+		var objectArrayAssignedLabels []models.AssignedLabelable
+		objectValuesAssignedLabels := []basetypes.ObjectValue{}
+		tfStateElementsAssignedLabels := tfStateGroup.AssignedLabels.Elements()
+		for n, i := range tfPlanGroup.AssignedLabels.Elements() {
 			requestBodyAssignedLabel := models.NewAssignedLabel()
-			tfPlanAssignedLabel := groupAssignedLabelModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAssignedLabel)
-			tfStateAssignedLabel := groupAssignedLabelModel{}
-			types.ListValueFrom(ctx, tfStateGroup.AssignedLabels.Elements()[k].Type(ctx), &tfPlanAssignedLabel)
+			tfPlanAssignedLabel := sharedmodels.AssignedLabelModel{}
+			i.(types.Object).As(ctx, &tfPlanAssignedLabel, basetypes.ObjectAsOptions{})
+			tfStateAssignedLabel := sharedmodels.AssignedLabelModel{}
+			if n < len(tfStateElementsAssignedLabels) {
+				tfStateElementsAssignedLabels[n].(types.Object).As(ctx, &tfStateAssignedLabel, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanAssignedLabel.DisplayName.Equal(tfStateAssignedLabel.DisplayName) {
+			if tfPlanAssignedLabel.DisplayName.IsUnknown() {
+				tfPlanAssignedLabel.DisplayName = tfStateAssignedLabel.DisplayName
+			}
+			if !tfPlanAssignedLabel.DisplayName.IsNull() {
 				tfPlanDisplayName := tfPlanAssignedLabel.DisplayName.ValueString()
 				requestBodyAssignedLabel.SetDisplayName(&tfPlanDisplayName)
 			}
 
-			if !tfPlanAssignedLabel.LabelId.Equal(tfStateAssignedLabel.LabelId) {
+			if tfPlanAssignedLabel.LabelId.IsUnknown() {
+				tfPlanAssignedLabel.LabelId = tfStateAssignedLabel.LabelId
+			}
+			if !tfPlanAssignedLabel.LabelId.IsNull() {
 				tfPlanLabelId := tfPlanAssignedLabel.LabelId.ValueString()
 				requestBodyAssignedLabel.SetLabelId(&tfPlanLabelId)
 			}
+			objectArrayAssignedLabels = append(objectArrayAssignedLabels, requestBodyAssignedLabel)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanAssignedLabel.AttributeTypes(), tfPlanAssignedLabel)
+			objectValuesAssignedLabels = append(objectValuesAssignedLabels, objectValue)
 		}
-		requestBodyGroup.SetAssignedLabels(tfPlanAssignedLabels)
+		requestBodyGroup.SetAssignedLabels(objectArrayAssignedLabels)
+		tfPlanGroup.AssignedLabels, _ = types.ListValueFrom(ctx, tfPlanGroup.AssignedLabels.ElementType(ctx), objectValuesAssignedLabels)
 	}
 
 	if !tfPlanGroup.AssignedLicenses.Equal(tfStateGroup.AssignedLicenses) {
-		var tfPlanAssignedLicenses []models.AssignedLicenseable
-		for k, i := range tfPlanGroup.AssignedLicenses.Elements() {
+		var objectArrayAssignedLicenses []models.AssignedLicenseable
+		objectValuesAssignedLicenses := []basetypes.ObjectValue{}
+		tfStateElementsAssignedLicenses := tfStateGroup.AssignedLicenses.Elements()
+		for n, i := range tfPlanGroup.AssignedLicenses.Elements() {
 			requestBodyAssignedLicense := models.NewAssignedLicense()
-			tfPlanAssignedLicense := groupAssignedLicenseModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAssignedLicense)
-			tfStateAssignedLicense := groupAssignedLicenseModel{}
-			types.ListValueFrom(ctx, tfStateGroup.AssignedLicenses.Elements()[k].Type(ctx), &tfPlanAssignedLicense)
+			tfPlanAssignedLicense := sharedmodels.AssignedLicenseModel{}
+			i.(types.Object).As(ctx, &tfPlanAssignedLicense, basetypes.ObjectAsOptions{})
+			tfStateAssignedLicense := sharedmodels.AssignedLicenseModel{}
+			if n < len(tfStateElementsAssignedLicenses) {
+				tfStateElementsAssignedLicenses[n].(types.Object).As(ctx, &tfStateAssignedLicense, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanAssignedLicense.DisabledPlans.Equal(tfStateAssignedLicense.DisabledPlans) {
-				var DisabledPlans []uuid.UUID
+			if tfPlanAssignedLicense.DisabledPlans.IsUnknown() {
+				tfPlanAssignedLicense.DisabledPlans = tfStateAssignedLicense.DisabledPlans
+			}
+			if !tfPlanAssignedLicense.DisabledPlans.IsNull() {
+				var uuidArrayDisabledPlans []uuid.UUID
 				for _, i := range tfPlanAssignedLicense.DisabledPlans.Elements() {
-					u, _ := uuid.Parse(i.String())
-					DisabledPlans = append(DisabledPlans, u)
+					u, _ := uuid.Parse(i.(types.String).ValueString())
+					uuidArrayDisabledPlans = append(uuidArrayDisabledPlans, u)
 				}
-				requestBodyAssignedLicense.SetDisabledPlans(DisabledPlans)
+				requestBodyAssignedLicense.SetDisabledPlans(uuidArrayDisabledPlans)
 			}
 
-			if !tfPlanAssignedLicense.SkuId.Equal(tfStateAssignedLicense.SkuId) {
+			if tfPlanAssignedLicense.SkuId.IsUnknown() {
+				tfPlanAssignedLicense.SkuId = tfStateAssignedLicense.SkuId
+			}
+			if !tfPlanAssignedLicense.SkuId.IsNull() {
 				tfPlanSkuId := tfPlanAssignedLicense.SkuId.ValueString()
 				u, _ := uuid.Parse(tfPlanSkuId)
 				requestBodyAssignedLicense.SetSkuId(&u)
 			}
+			objectArrayAssignedLicenses = append(objectArrayAssignedLicenses, requestBodyAssignedLicense)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanAssignedLicense.AttributeTypes(), tfPlanAssignedLicense)
+			objectValuesAssignedLicenses = append(objectValuesAssignedLicenses, objectValue)
 		}
-		requestBodyGroup.SetAssignedLicenses(tfPlanAssignedLicenses)
+		requestBodyGroup.SetAssignedLicenses(objectArrayAssignedLicenses)
+		tfPlanGroup.AssignedLicenses, _ = types.ListValueFrom(ctx, tfPlanGroup.AssignedLicenses.ElementType(ctx), objectValuesAssignedLicenses)
 	}
 
 	if !tfPlanGroup.Classification.Equal(tfStateGroup.Classification) {
@@ -1326,7 +1401,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if !tfPlanGroup.GroupTypes.Equal(tfStateGroup.GroupTypes) {
 		var stringArrayGroupTypes []string
 		for _, i := range tfPlanGroup.GroupTypes.Elements() {
-			stringArrayGroupTypes = append(stringArrayGroupTypes, i.String())
+			stringArrayGroupTypes = append(stringArrayGroupTypes, i.(types.String).ValueString())
 		}
 		requestBodyGroup.SetGroupTypes(stringArrayGroupTypes)
 	}
@@ -1348,12 +1423,15 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	if !tfPlanGroup.LicenseProcessingState.Equal(tfStateGroup.LicenseProcessingState) {
 		requestBodyLicenseProcessingState := models.NewLicenseProcessingState()
-		tfPlanLicenseProcessingState := groupLicenseProcessingStateModel{}
+		tfPlanLicenseProcessingState := sharedmodels.LicenseProcessingStateModel{}
 		tfPlanGroup.LicenseProcessingState.As(ctx, &tfPlanLicenseProcessingState, basetypes.ObjectAsOptions{})
-		tfStateLicenseProcessingState := groupLicenseProcessingStateModel{}
+		tfStateLicenseProcessingState := sharedmodels.LicenseProcessingStateModel{}
 		tfStateGroup.LicenseProcessingState.As(ctx, &tfStateLicenseProcessingState, basetypes.ObjectAsOptions{})
 
-		if !tfPlanLicenseProcessingState.State.Equal(tfStateLicenseProcessingState.State) {
+		if tfPlanLicenseProcessingState.State.IsUnknown() {
+			tfPlanLicenseProcessingState.State = tfStateLicenseProcessingState.State
+		}
+		if !tfPlanLicenseProcessingState.State.IsNull() {
 			tfPlanState := tfPlanLicenseProcessingState.State.ValueString()
 			requestBodyLicenseProcessingState.SetState(&tfPlanState)
 		}
@@ -1403,36 +1481,56 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if !tfPlanGroup.OnPremisesProvisioningErrors.Equal(tfStateGroup.OnPremisesProvisioningErrors) {
-		var tfPlanOnPremisesProvisioningErrors []models.OnPremisesProvisioningErrorable
-		for k, i := range tfPlanGroup.OnPremisesProvisioningErrors.Elements() {
+		var objectArrayOnPremisesProvisioningErrors []models.OnPremisesProvisioningErrorable
+		objectValuesOnPremisesProvisioningErrors := []basetypes.ObjectValue{}
+		tfStateElementsOnPremisesProvisioningErrors := tfStateGroup.OnPremisesProvisioningErrors.Elements()
+		for n, i := range tfPlanGroup.OnPremisesProvisioningErrors.Elements() {
 			requestBodyOnPremisesProvisioningError := models.NewOnPremisesProvisioningError()
-			tfPlanOnPremisesProvisioningError := groupOnPremisesProvisioningErrorModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOnPremisesProvisioningError)
-			tfStateOnPremisesProvisioningError := groupOnPremisesProvisioningErrorModel{}
-			types.ListValueFrom(ctx, tfStateGroup.OnPremisesProvisioningErrors.Elements()[k].Type(ctx), &tfPlanOnPremisesProvisioningError)
+			tfPlanOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
+			i.(types.Object).As(ctx, &tfPlanOnPremisesProvisioningError, basetypes.ObjectAsOptions{})
+			tfStateOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
+			if n < len(tfStateElementsOnPremisesProvisioningErrors) {
+				tfStateElementsOnPremisesProvisioningErrors[n].(types.Object).As(ctx, &tfStateOnPremisesProvisioningError, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanOnPremisesProvisioningError.Category.Equal(tfStateOnPremisesProvisioningError.Category) {
+			if tfPlanOnPremisesProvisioningError.Category.IsUnknown() {
+				tfPlanOnPremisesProvisioningError.Category = tfStateOnPremisesProvisioningError.Category
+			}
+			if !tfPlanOnPremisesProvisioningError.Category.IsNull() {
 				tfPlanCategory := tfPlanOnPremisesProvisioningError.Category.ValueString()
 				requestBodyOnPremisesProvisioningError.SetCategory(&tfPlanCategory)
 			}
 
-			if !tfPlanOnPremisesProvisioningError.OccurredDateTime.Equal(tfStateOnPremisesProvisioningError.OccurredDateTime) {
+			if tfPlanOnPremisesProvisioningError.OccurredDateTime.IsUnknown() {
+				tfPlanOnPremisesProvisioningError.OccurredDateTime = tfStateOnPremisesProvisioningError.OccurredDateTime
+			}
+			if !tfPlanOnPremisesProvisioningError.OccurredDateTime.IsNull() {
 				tfPlanOccurredDateTime := tfPlanOnPremisesProvisioningError.OccurredDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanOccurredDateTime)
 				requestBodyOnPremisesProvisioningError.SetOccurredDateTime(&t)
 			}
 
-			if !tfPlanOnPremisesProvisioningError.PropertyCausingError.Equal(tfStateOnPremisesProvisioningError.PropertyCausingError) {
+			if tfPlanOnPremisesProvisioningError.PropertyCausingError.IsUnknown() {
+				tfPlanOnPremisesProvisioningError.PropertyCausingError = tfStateOnPremisesProvisioningError.PropertyCausingError
+			}
+			if !tfPlanOnPremisesProvisioningError.PropertyCausingError.IsNull() {
 				tfPlanPropertyCausingError := tfPlanOnPremisesProvisioningError.PropertyCausingError.ValueString()
 				requestBodyOnPremisesProvisioningError.SetPropertyCausingError(&tfPlanPropertyCausingError)
 			}
 
-			if !tfPlanOnPremisesProvisioningError.Value.Equal(tfStateOnPremisesProvisioningError.Value) {
+			if tfPlanOnPremisesProvisioningError.Value.IsUnknown() {
+				tfPlanOnPremisesProvisioningError.Value = tfStateOnPremisesProvisioningError.Value
+			}
+			if !tfPlanOnPremisesProvisioningError.Value.IsNull() {
 				tfPlanValue := tfPlanOnPremisesProvisioningError.Value.ValueString()
 				requestBodyOnPremisesProvisioningError.SetValue(&tfPlanValue)
 			}
+			objectArrayOnPremisesProvisioningErrors = append(objectArrayOnPremisesProvisioningErrors, requestBodyOnPremisesProvisioningError)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanOnPremisesProvisioningError.AttributeTypes(), tfPlanOnPremisesProvisioningError)
+			objectValuesOnPremisesProvisioningErrors = append(objectValuesOnPremisesProvisioningErrors, objectValue)
 		}
-		requestBodyGroup.SetOnPremisesProvisioningErrors(tfPlanOnPremisesProvisioningErrors)
+		requestBodyGroup.SetOnPremisesProvisioningErrors(objectArrayOnPremisesProvisioningErrors)
+		tfPlanGroup.OnPremisesProvisioningErrors, _ = types.ListValueFrom(ctx, tfPlanGroup.OnPremisesProvisioningErrors.ElementType(ctx), objectValuesOnPremisesProvisioningErrors)
 	}
 
 	if !tfPlanGroup.OnPremisesSamAccountName.Equal(tfStateGroup.OnPremisesSamAccountName) {
@@ -1463,7 +1561,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if !tfPlanGroup.ProxyAddresses.Equal(tfStateGroup.ProxyAddresses) {
 		var stringArrayProxyAddresses []string
 		for _, i := range tfPlanGroup.ProxyAddresses.Elements() {
-			stringArrayProxyAddresses = append(stringArrayProxyAddresses, i.String())
+			stringArrayProxyAddresses = append(stringArrayProxyAddresses, i.(types.String).ValueString())
 		}
 		requestBodyGroup.SetProxyAddresses(stringArrayProxyAddresses)
 	}
@@ -1485,31 +1583,48 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if !tfPlanGroup.ServiceProvisioningErrors.Equal(tfStateGroup.ServiceProvisioningErrors) {
-		var tfPlanServiceProvisioningErrors []models.ServiceProvisioningErrorable
-		for k, i := range tfPlanGroup.ServiceProvisioningErrors.Elements() {
+		var objectArrayServiceProvisioningErrors []models.ServiceProvisioningErrorable
+		objectValuesServiceProvisioningErrors := []basetypes.ObjectValue{}
+		tfStateElementsServiceProvisioningErrors := tfStateGroup.ServiceProvisioningErrors.Elements()
+		for n, i := range tfPlanGroup.ServiceProvisioningErrors.Elements() {
 			requestBodyServiceProvisioningError := models.NewServiceProvisioningError()
-			tfPlanServiceProvisioningError := groupServiceProvisioningErrorModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanServiceProvisioningError)
-			tfStateServiceProvisioningError := groupServiceProvisioningErrorModel{}
-			types.ListValueFrom(ctx, tfStateGroup.ServiceProvisioningErrors.Elements()[k].Type(ctx), &tfPlanServiceProvisioningError)
+			tfPlanServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
+			i.(types.Object).As(ctx, &tfPlanServiceProvisioningError, basetypes.ObjectAsOptions{})
+			tfStateServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
+			if n < len(tfStateElementsServiceProvisioningErrors) {
+				tfStateElementsServiceProvisioningErrors[n].(types.Object).As(ctx, &tfStateServiceProvisioningError, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanServiceProvisioningError.CreatedDateTime.Equal(tfStateServiceProvisioningError.CreatedDateTime) {
+			if tfPlanServiceProvisioningError.CreatedDateTime.IsUnknown() {
+				tfPlanServiceProvisioningError.CreatedDateTime = tfStateServiceProvisioningError.CreatedDateTime
+			}
+			if !tfPlanServiceProvisioningError.CreatedDateTime.IsNull() {
 				tfPlanCreatedDateTime := tfPlanServiceProvisioningError.CreatedDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanCreatedDateTime)
 				requestBodyServiceProvisioningError.SetCreatedDateTime(&t)
 			}
 
-			if !tfPlanServiceProvisioningError.IsResolved.Equal(tfStateServiceProvisioningError.IsResolved) {
+			if tfPlanServiceProvisioningError.IsResolved.IsUnknown() {
+				tfPlanServiceProvisioningError.IsResolved = tfStateServiceProvisioningError.IsResolved
+			}
+			if !tfPlanServiceProvisioningError.IsResolved.IsNull() {
 				tfPlanIsResolved := tfPlanServiceProvisioningError.IsResolved.ValueBool()
 				requestBodyServiceProvisioningError.SetIsResolved(&tfPlanIsResolved)
 			}
 
-			if !tfPlanServiceProvisioningError.ServiceInstance.Equal(tfStateServiceProvisioningError.ServiceInstance) {
+			if tfPlanServiceProvisioningError.ServiceInstance.IsUnknown() {
+				tfPlanServiceProvisioningError.ServiceInstance = tfStateServiceProvisioningError.ServiceInstance
+			}
+			if !tfPlanServiceProvisioningError.ServiceInstance.IsNull() {
 				tfPlanServiceInstance := tfPlanServiceProvisioningError.ServiceInstance.ValueString()
 				requestBodyServiceProvisioningError.SetServiceInstance(&tfPlanServiceInstance)
 			}
+			objectArrayServiceProvisioningErrors = append(objectArrayServiceProvisioningErrors, requestBodyServiceProvisioningError)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanServiceProvisioningError.AttributeTypes(), tfPlanServiceProvisioningError)
+			objectValuesServiceProvisioningErrors = append(objectValuesServiceProvisioningErrors, objectValue)
 		}
-		requestBodyGroup.SetServiceProvisioningErrors(tfPlanServiceProvisioningErrors)
+		requestBodyGroup.SetServiceProvisioningErrors(objectArrayServiceProvisioningErrors)
+		tfPlanGroup.ServiceProvisioningErrors, _ = types.ListValueFrom(ctx, tfPlanGroup.ServiceProvisioningErrors.ElementType(ctx), objectValuesServiceProvisioningErrors)
 	}
 
 	if !tfPlanGroup.Theme.Equal(tfStateGroup.Theme) {
@@ -1549,15 +1664,22 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from Terraform state
-	var tfStateGroup groupModel
+	var tfStateGroup groupResourceModel
 	diags := req.State.Get(ctx, &tfStateGroup)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// TODO: Delete group
-	err := r.client.Groups().ByGroupId(tfStateGroup.Id.ValueString()).Delete(context.Background(), nil)
+	if tfStateGroup.PreventDeletion.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion of group prevented",
+			fmt.Sprintf("The group %s can't be deleted while prevent_deletion is true. Set it to false, and apply, before deleting it.", tfStateGroup.Id.ValueString()),
+		)
+		return
+	}
+
+	err := r.client.Groups().ByGroupId(tfStateGroup.Id.ValueString()).Delete(ctx, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting group",
@@ -1566,8 +1688,185 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// The object is kept in the deleted items of the directory, from which it can be restored, unless it's purged.
+	// It can take a few seconds to show up there. The object is deleted either way, so failing to purge it is only a warning,
+	// and the resource is still removed from the state
+	if tfStateGroup.PermanentlyDelete.ValueBool() {
+		for attempt := 1; ; attempt++ {
+			err = r.client.Directory().DeletedItems().ByDirectoryObjectId(tfStateGroup.Id.ValueString()).Delete(ctx, nil)
+			var odataErr *odataerrors.ODataError
+			if err == nil || !errors.As(err, &odataErr) || odataErr.ResponseStatusCode != 404 || attempt == 10 {
+				break
+			}
+			// Stop waiting when Terraform is interrupted
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+			case <-time.After(3 * time.Second):
+				continue
+			}
+			break
+		}
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Couldn't permanently delete group",
+				fmt.Sprintf("The group %s was deleted, but couldn't be purged from the deleted items of the directory, where it's kept for 30 days: %s", tfStateGroup.Id.ValueString(), err.Error()),
+			)
+		}
+	}
+
 }
 
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Besides its ID, the object can be imported by 'mailNickname:<mail_nickname>', which is looked up to find its ID
+	var filter string
+	prefix, value, _ := strings.Cut(req.ID, ":")
+	switch prefix {
+	case "mailNickname":
+		filter = fmt.Sprintf("mailNickname eq '%s'", strings.ReplaceAll(value, "'", "''"))
+	default:
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	qparams := groups.GroupsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &groups.GroupsRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Select: []string{"id"},
+		},
+	}
+	result, err := r.client.Groups().Get(ctx, &qparams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing group",
+			err.Error(),
+		)
+		return
+	}
+	if len(result.GetValue()) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier %q to match a single group. Matched %d", req.ID, len(result.GetValue())),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *result.GetValue()[0].GetId())...)
+}
+
+// MoveState moves the state of resources of other providers to the resource, with a 'moved' block.
+// Only some of their attributes are moved. The others are set when the resource is next read,
+// as are empty strings and lists, which other providers store for optional attributes that aren't set
+func (r *groupResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "azuread_group" || !strings.HasSuffix(req.SourceProviderAddress, "/hashicorp/azuread") {
+					return
+				}
+
+				var source map[string]any
+				if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving state of azuread_group",
+						err.Error(),
+					)
+					return
+				}
+				if v, ok := source["assignable_to_role"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("is_assignable_to_role"), v)...)
+				}
+				if v, ok := source["description"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("description"), v)...)
+				}
+				if v, ok := source["display_name"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("display_name"), v)...)
+				}
+				if v, ok := source["mail_enabled"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("mail_enabled"), v)...)
+				}
+				if v, ok := source["mail_nickname"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("mail_nickname"), v)...)
+				}
+				if v, ok := source["object_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), v)...)
+				}
+				if v, ok := source["security_enabled"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("security_enabled"), v)...)
+				}
+				if v, ok := source["theme"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("theme"), v)...)
+				}
+				if v, ok := source["types"].([]any); ok && len(v) > 0 {
+					var values []string
+					for _, i := range v {
+						if s, ok := i.(string); ok {
+							values = append(values, s)
+						}
+					}
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("group_types"), values)...)
+				}
+				if v, ok := source["visibility"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("visibility"), v)...)
+				}
+			},
+		},
+	}
+}
+
+// ListIds returns the IDs of the objects matching filter, an OData $filter expression, or of every object when it's empty.
+// It's used to export the existing objects of a tenant
+func (r *groupResource) ListIds(ctx context.Context, filter string) ([]string, error) {
+
+	qparams := groups.GroupsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &groups.GroupsRequestBuilderGetQueryParameters{
+			Select: []string{"id"},
+		},
+	}
+	if filter != "" {
+		qparams.QueryParameters.Filter = &filter
+	}
+
+	result, err := r.client.Groups().Get(ctx, &qparams)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for {
+		for _, object := range result.GetValue() {
+			ids = append(ids, *object.GetId())
+		}
+		nextLink := result.GetOdataNextLink()
+		if nextLink == nil {
+			return ids, nil
+		}
+		result, err = r.client.Groups().WithUrl(*nextLink).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+}
+
+// ReadOnlyAttributes returns the paths of the attributes the API sets, which exports leave out of the configuration they write
+func (r *groupResource) ReadOnlyAttributes() []string {
+	return []string{
+		"assigned_labels.display_name",
+		"assigned_licenses",
+		"created_date_time",
+		"expiration_date_time",
+		"id",
+		"license_processing_state",
+		"mail",
+		"on_premises_domain_name",
+		"on_premises_last_sync_date_time",
+		"on_premises_net_bios_name",
+		"on_premises_sam_account_name",
+		"on_premises_security_identifier",
+		"on_premises_sync_enabled",
+		"proxy_addresses",
+		"renewed_date_time",
+		"security_identifier",
+		"unique_name",
+	}
 }
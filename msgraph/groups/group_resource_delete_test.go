@@ -0,0 +1,104 @@
+package groups_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-msgraph/internal/graphfake"
+	"terraform-provider-msgraph/msgraph/groups"
+)
+
+func TestGroupResourceDelete(t *testing.T) {
+
+	ctx := context.Background()
+
+	server := graphfake.NewServer()
+	defer server.Close()
+
+	clients, err := server.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := groups.NewGroupResource().(resource.ResourceWithConfigure)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clients}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	remove := func(ctx context.Context, id string, preventDeletion bool, permanentlyDelete bool) resource.DeleteResponse {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		var resp resource.DeleteResponse
+		resp.Diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), id)...)
+		resp.Diagnostics.Append(state.SetAttribute(ctx, path.Root("prevent_deletion"), preventDeletion)...)
+		resp.Diagnostics.Append(state.SetAttribute(ctx, path.Root("permanently_delete"), permanentlyDelete)...)
+		if resp.Diagnostics.HasError() {
+			t.Fatal(resp.Diagnostics)
+		}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+		return resp
+	}
+
+	// A group can't be deleted while prevent_deletion is true
+	id := server.Add("groups", map[string]any{"displayName": "Protected"})
+	if resp := remove(ctx, id, true, false); !resp.Diagnostics.HasError() {
+		t.Error("deleted a group with prevent_deletion, want an error")
+	}
+	if _, ok := server.Get("groups/" + id); !ok {
+		t.Error("group with prevent_deletion was deleted")
+	}
+
+	// Otherwise it's kept in the deleted items, so it can be restored
+	if resp := remove(ctx, id, false, false); resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if _, ok := server.Get("groups/" + id); ok {
+		t.Error("group still exists after it was deleted")
+	}
+	if _, ok := server.Get("directory/deletedItems/" + id); !ok {
+		t.Error("deleted group isn't in the deleted items")
+	}
+
+	// Unless it's permanently deleted
+	id = server.Add("groups", map[string]any{"displayName": "Temporary"})
+	if resp := remove(ctx, id, false, true); resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if _, ok := server.Get("directory/deletedItems/" + id); ok {
+		t.Error("permanently deleted group is in the deleted items")
+	}
+
+	// Nothing is deleted once Terraform is interrupted
+	id = server.Add("groups", map[string]any{"displayName": "Interrupted"})
+	interrupted, cancel := context.WithCancel(ctx)
+	cancel()
+	if resp := remove(interrupted, id, false, true); !resp.Diagnostics.HasError() {
+		t.Error("deleted a group after being interrupted, want an error")
+	}
+	if _, ok := server.Get("groups/" + id); !ok {
+		t.Error("group was deleted after being interrupted")
+	}
+
+	// A group that's deleted, but not purged, is still removed from the state with a warning
+	server.DeletedItemsDelay = time.Minute
+	id = server.Add("groups", map[string]any{"displayName": "Unpurged"})
+	timeout, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	resp := remove(timeout, id, false, true)
+	if resp.Diagnostics.HasError() {
+		t.Error(resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() == 0 {
+		t.Error("no warning after the group couldn't be purged")
+	}
+	if _, ok := server.Get("groups/" + id); ok {
+		t.Error("group still exists after it was deleted")
+	}
+
+}
@@ -0,0 +1,84 @@
+package groups_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-msgraph/msgraph/groups"
+)
+
+const azureadGroupState = `{
+  "id": "00000000-0000-0000-0000-000000000001",
+  "object_id": "00000000-0000-0000-0000-000000000001",
+  "display_name": "Example",
+  "description": "",
+  "mail_enabled": false,
+  "mail_nickname": "example",
+  "security_enabled": true,
+  "types": ["Unified"],
+  "owners": ["00000000-0000-0000-0000-000000000002"]
+}`
+
+func TestGroupResourceMoveState(t *testing.T) {
+
+	ctx := context.Background()
+	r := groups.NewGroupResource().(resource.ResourceWithMoveState)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	move := func(providerAddress string, typeName string) resource.MoveStateResponse {
+		req := resource.MoveStateRequest{
+			SourceProviderAddress: providerAddress,
+			SourceTypeName:        typeName,
+			SourceRawState:        &tfprotov6.RawState{JSON: []byte(azureadGroupState)},
+		}
+		resp := resource.MoveStateResponse{
+			TargetState: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		}
+		for _, mover := range r.MoveState(ctx) {
+			mover.StateMover(ctx, req, &resp)
+		}
+		return resp
+	}
+
+	resp := move("registry.terraform.io/hashicorp/azuread", "azuread_group")
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var id, displayName, description types.String
+	var securityEnabled types.Bool
+	var groupTypes []string
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("display_name"), &displayName)...)
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("description"), &description)...)
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("security_enabled"), &securityEnabled)...)
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("group_types"), &groupTypes)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	if id.ValueString() != "00000000-0000-0000-0000-000000000001" || displayName.ValueString() != "Example" || !securityEnabled.ValueBool() {
+		t.Errorf("got id %s, display_name %s and security_enabled %s, want them moved from object_id, display_name and security_enabled", id, displayName, securityEnabled)
+	}
+	if len(groupTypes) != 1 || groupTypes[0] != "Unified" {
+		t.Errorf("got group_types %v, want them moved from types", groupTypes)
+	}
+	if !description.IsNull() {
+		t.Errorf("got description %s, want it left to the next read, as it's empty", description)
+	}
+
+	// Resources of other providers are skipped, leaving the state unset
+	if resp := move("registry.terraform.io/hashicorp/azurerm", "azuread_group"); !resp.TargetState.Raw.IsNull() {
+		t.Errorf("got state %v moving from another provider, want it left unset", resp.TargetState.Raw)
+	}
+
+}
@@ -0,0 +1,73 @@
+package groups_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+const groupResourceConfig = `
+resource "msgraph_group" "test" {
+  display_name     = "Example"
+  mail_enabled     = false
+  mail_nickname    = "example"
+  security_enabled = true
+}
+`
+
+const groupResourceUpdateConfig = `
+resource "msgraph_group" "test" {
+  display_name     = "Example updated"
+  mail_enabled     = false
+  mail_nickname    = "example"
+  security_enabled = true
+}
+`
+
+func TestAccGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: groupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("msgraph_group.test", "id"),
+					resource.TestCheckResourceAttr("msgraph_group.test", "display_name", "Example"),
+					resource.TestCheckResourceAttr("msgraph_group.test", "mail_enabled", "false"),
+					resource.TestCheckResourceAttr("msgraph_group.test", "mail_nickname", "example"),
+					resource.TestCheckResourceAttr("msgraph_group.test", "security_enabled", "true"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "msgraph_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import by mail_nickname
+			{
+				ResourceName:      "msgraph_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "mailNickname:" + s.RootModule().Resources["msgraph_group.test"].Primary.Attributes["mail_nickname"], nil
+				},
+			},
+			// Update and Read
+			{
+				Config: groupResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msgraph_group.test", "display_name", "Example updated"),
+					resource.TestCheckResourceAttr("msgraph_group.test", "mail_enabled", "false"),
+					resource.TestCheckResourceAttr("msgraph_group.test", "mail_nickname", "example"),
+					resource.TestCheckResourceAttr("msgraph_group.test", "security_enabled", "true"),
+				),
+			},
+			// Delete is tested once the last step is done
+		},
+	})
+}
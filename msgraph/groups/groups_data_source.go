@@ -10,6 +10,9 @@ import (
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/groups"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,12 +37,12 @@ func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *groupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *groupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -302,7 +305,7 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseGroup.GetAssignedLabels()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseAssignedLabel := range responseGroup.GetAssignedLabels() {
-					tfStateAssignedLabel := groupsAssignedLabelModel{}
+					tfStateAssignedLabel := sharedmodels.AssignedLabelModel{}
 
 					if responseAssignedLabel.GetDisplayName() != nil {
 						tfStateAssignedLabel.DisplayName = types.StringValue(*responseAssignedLabel.GetDisplayName())
@@ -322,7 +325,7 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseGroup.GetAssignedLicenses()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseAssignedLicense := range responseGroup.GetAssignedLicenses() {
-					tfStateAssignedLicense := groupsAssignedLicenseModel{}
+					tfStateAssignedLicense := sharedmodels.AssignedLicenseModel{}
 
 					if len(responseAssignedLicense.GetDisabledPlans()) > 0 {
 						var valueArrayDisabledPlans []attr.Value
@@ -399,7 +402,7 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateGroup.IsManagementRestricted = types.BoolNull()
 			}
 			if responseGroup.GetLicenseProcessingState() != nil {
-				tfStateLicenseProcessingState := groupsLicenseProcessingStateModel{}
+				tfStateLicenseProcessingState := sharedmodels.LicenseProcessingStateModel{}
 				responseLicenseProcessingState := responseGroup.GetLicenseProcessingState()
 
 				if responseLicenseProcessingState.GetState() != nil {
@@ -453,7 +456,7 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseGroup.GetOnPremisesProvisioningErrors()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseOnPremisesProvisioningError := range responseGroup.GetOnPremisesProvisioningErrors() {
-					tfStateOnPremisesProvisioningError := groupsOnPremisesProvisioningErrorModel{}
+					tfStateOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
 
 					if responseOnPremisesProvisioningError.GetCategory() != nil {
 						tfStateOnPremisesProvisioningError.Category = types.StringValue(*responseOnPremisesProvisioningError.GetCategory())
@@ -533,7 +536,7 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseGroup.GetServiceProvisioningErrors()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseServiceProvisioningError := range responseGroup.GetServiceProvisioningErrors() {
-					tfStateServiceProvisioningError := groupsServiceProvisioningErrorModel{}
+					tfStateServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
 
 					if responseServiceProvisioningError.GetCreatedDateTime() != nil {
 						tfStateServiceProvisioningError.CreatedDateTime = types.StringValue(responseServiceProvisioningError.GetCreatedDateTime().String())
@@ -3,6 +3,8 @@ package groups
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 type groupsModel struct {
@@ -55,8 +57,8 @@ type groupsGroupModel struct {
 
 func (m groupsGroupModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"assigned_labels":                  types.ListType{ElemType: types.ObjectType{AttrTypes: groupsAssignedLabelModel{}.AttributeTypes()}},
-		"assigned_licenses":                types.ListType{ElemType: types.ObjectType{AttrTypes: groupsAssignedLicenseModel{}.AttributeTypes()}},
+		"assigned_labels":                  types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.AssignedLabelModel{}.AttributeTypes()}},
+		"assigned_licenses":                types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.AssignedLicenseModel{}.AttributeTypes()}},
 		"classification":                   types.StringType,
 		"created_date_time":                types.StringType,
 		"deleted_date_time":                types.StringType,
@@ -67,7 +69,7 @@ func (m groupsGroupModel) AttributeTypes() map[string]attr.Type {
 		"id":                               types.StringType,
 		"is_assignable_to_role":            types.BoolType,
 		"is_management_restricted":         types.BoolType,
-		"license_processing_state":         types.ObjectType{AttrTypes: groupsLicenseProcessingStateModel{}.AttributeTypes()},
+		"license_processing_state":         types.ObjectType{AttrTypes: sharedmodels.LicenseProcessingStateModel{}.AttributeTypes()},
 		"mail":                             types.StringType,
 		"mail_enabled":                     types.BoolType,
 		"mail_nickname":                    types.StringType,
@@ -76,7 +78,7 @@ func (m groupsGroupModel) AttributeTypes() map[string]attr.Type {
 		"on_premises_domain_name":          types.StringType,
 		"on_premises_last_sync_date_time":  types.StringType,
 		"on_premises_net_bios_name":        types.StringType,
-		"on_premises_provisioning_errors":  types.ListType{ElemType: types.ObjectType{AttrTypes: groupsOnPremisesProvisioningErrorModel{}.AttributeTypes()}},
+		"on_premises_provisioning_errors":  types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.OnPremisesProvisioningErrorModel{}.AttributeTypes()}},
 		"on_premises_sam_account_name":     types.StringType,
 		"on_premises_security_identifier":  types.StringType,
 		"on_premises_sync_enabled":         types.BoolType,
@@ -86,73 +88,9 @@ func (m groupsGroupModel) AttributeTypes() map[string]attr.Type {
 		"renewed_date_time":                types.StringType,
 		"security_enabled":                 types.BoolType,
 		"security_identifier":              types.StringType,
-		"service_provisioning_errors":      types.ListType{ElemType: types.ObjectType{AttrTypes: groupsServiceProvisioningErrorModel{}.AttributeTypes()}},
+		"service_provisioning_errors":      types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.ServiceProvisioningErrorModel{}.AttributeTypes()}},
 		"theme":                            types.StringType,
 		"unique_name":                      types.StringType,
 		"visibility":                       types.StringType,
 	}
 }
-
-type groupsAssignedLabelModel struct {
-	DisplayName types.String `tfsdk:"display_name"`
-	LabelId     types.String `tfsdk:"label_id"`
-}
-
-func (m groupsAssignedLabelModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"display_name": types.StringType,
-		"label_id":     types.StringType,
-	}
-}
-
-type groupsAssignedLicenseModel struct {
-	DisabledPlans types.List   `tfsdk:"disabled_plans"`
-	SkuId         types.String `tfsdk:"sku_id"`
-}
-
-func (m groupsAssignedLicenseModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"disabled_plans": types.ListType{ElemType: types.StringType},
-		"sku_id":         types.StringType,
-	}
-}
-
-type groupsLicenseProcessingStateModel struct {
-	State types.String `tfsdk:"state"`
-}
-
-func (m groupsLicenseProcessingStateModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"state": types.StringType,
-	}
-}
-
-type groupsOnPremisesProvisioningErrorModel struct {
-	Category             types.String `tfsdk:"category"`
-	OccurredDateTime     types.String `tfsdk:"occurred_date_time"`
-	PropertyCausingError types.String `tfsdk:"property_causing_error"`
-	Value                types.String `tfsdk:"value"`
-}
-
-func (m groupsOnPremisesProvisioningErrorModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"category":               types.StringType,
-		"occurred_date_time":     types.StringType,
-		"property_causing_error": types.StringType,
-		"value":                  types.StringType,
-	}
-}
-
-type groupsServiceProvisioningErrorModel struct {
-	CreatedDateTime types.String `tfsdk:"created_date_time"`
-	IsResolved      types.Bool   `tfsdk:"is_resolved"`
-	ServiceInstance types.String `tfsdk:"service_instance"`
-}
-
-func (m groupsServiceProvisioningErrorModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"created_date_time": types.StringType,
-		"is_resolved":       types.BoolType,
-		"service_instance":  types.StringType,
-	}
-}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"os"
 
 	"terraform-provider-msgraph/msgraph/applications"
+	betateams "terraform-provider-msgraph/msgraph/beta/teams"
+	"terraform-provider-msgraph/msgraph/clients"
 	"terraform-provider-msgraph/msgraph/devices"
 	"terraform-provider-msgraph/msgraph/groups"
 	"terraform-provider-msgraph/msgraph/serviceprincipals"
@@ -16,7 +19,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
-	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -36,6 +38,12 @@ type MsGraphProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// clients are used instead of those the provider configures, when set by NewWithClients
+	clients *clients.Clients
+
+	// transport wraps the transport of the client the provider configures, when set by NewWithTransport
+	transport func(http.RoundTripper) http.RoundTripper
 }
 
 // msgraphProviderModel describes the provider data model.
@@ -95,6 +103,12 @@ func (p *MsGraphProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	if p.clients != nil {
+		resp.DataSourceData = p.clients
+		resp.ResourceData = p.clients
+		return
+	}
+
 	tenant_id := os.Getenv("MSGRAPH_TENANT_ID")
 	client_id := os.Getenv("MSGRAPH_CLIENT_ID")
 	client_secret := os.Getenv("MSGRAPH_CLIENT_SECRET")
@@ -168,7 +182,8 @@ func (p *MsGraphProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
-	client, err := msgraphsdk.NewGraphServiceClientWithCredentials(cred, []string{"https://graph.microsoft.com/.default"})
+	scopes := []string{"https://graph.microsoft.com/.default"}
+	providerClients, err := clients.New(cred, scopes, p.transport)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting client",
@@ -181,8 +196,8 @@ func (p *MsGraphProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = providerClients
+	resp.ResourceData = providerClients
 
 }
 
@@ -191,6 +206,7 @@ func (p *MsGraphProvider) DataSources(ctx context.Context) []func() datasource.D
 		// Provider specific implementation
 		applications.NewApplicationDataSource,
 		applications.NewApplicationsDataSource,
+		betateams.NewTeamDataSource,
 		devices.NewDeviceDataSource,
 		devices.NewDevicesDataSource,
 		groups.NewGroupDataSource,
@@ -209,6 +225,7 @@ func (p *MsGraphProvider) Resources(ctx context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		// Provider specific implementation
 		applications.NewApplicationResource,
+		betateams.NewTeamResource,
 		devices.NewDeviceResource,
 		groups.NewGroupResource,
 		serviceprincipals.NewServicePrincipalResource,
@@ -224,3 +241,25 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// NewWithClients returns a provider that uses the given clients instead of configuring them from its arguments,
+// e.g. to run acceptance tests against the fake MS Graph API of internal/graphfake
+func NewWithClients(version string, providerClients *clients.Clients) func() provider.Provider {
+	return func() provider.Provider {
+		return &MsGraphProvider{
+			version: version,
+			clients: providerClients,
+		}
+	}
+}
+
+// NewWithTransport returns a provider whose client sends requests with the transport returned by wrapTransport, given the client's own,
+// e.g. to record the requests of acceptance tests with internal/cassette
+func NewWithTransport(version string, wrapTransport func(http.RoundTripper) http.RoundTripper) func() provider.Provider {
+	return func() provider.Provider {
+		return &MsGraphProvider{
+			version:   version,
+			transport: wrapTransport,
+		}
+	}
+}
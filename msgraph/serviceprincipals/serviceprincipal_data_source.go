@@ -11,6 +11,9 @@ import (
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/serviceprincipals"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -35,12 +38,12 @@ func (d *servicePrincipalDataSource) Metadata(_ context.Context, req datasource.
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *servicePrincipalDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *servicePrincipalDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -516,7 +519,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 	if len(responseServicePrincipal.GetAddIns()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAddIn := range responseServicePrincipal.GetAddIns() {
-			tfStateAddIn := servicePrincipalAddInModel{}
+			tfStateAddIn := sharedmodels.AddInModel{}
 
 			if responseAddIn.GetId() != nil {
 				tfStateAddIn.Id = types.StringValue(responseAddIn.GetId().String())
@@ -526,7 +529,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 			if len(responseAddIn.GetProperties()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseKeyValue := range responseAddIn.GetProperties() {
-					tfStateKeyValue := servicePrincipalKeyValueModel{}
+					tfStateKeyValue := sharedmodels.KeyValueModel{}
 
 					if responseKeyValue.GetKey() != nil {
 						tfStateKeyValue.Key = types.StringValue(*responseKeyValue.GetKey())
@@ -591,7 +594,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 	if len(responseServicePrincipal.GetAppRoles()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAppRole := range responseServicePrincipal.GetAppRoles() {
-			tfStateAppRole := servicePrincipalAppRoleModel{}
+			tfStateAppRole := sharedmodels.AppRoleModel{}
 
 			if len(responseAppRole.GetAllowedMemberTypes()) > 0 {
 				var valueArrayAllowedMemberTypes []attr.Value
@@ -674,7 +677,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 		tfStateServicePrincipal.Id = types.StringNull()
 	}
 	if responseServicePrincipal.GetInfo() != nil {
-		tfStateInformationalUrl := servicePrincipalInformationalUrlModel{}
+		tfStateInformationalUrl := sharedmodels.InformationalUrlModel{}
 		responseInformationalUrl := responseServicePrincipal.GetInfo()
 
 		if responseInformationalUrl.GetLogoUrl() != nil {
@@ -708,7 +711,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 	if len(responseServicePrincipal.GetKeyCredentials()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseKeyCredential := range responseServicePrincipal.GetKeyCredentials() {
-			tfStateKeyCredential := servicePrincipalKeyCredentialModel{}
+			tfStateKeyCredential := sharedmodels.KeyCredentialModel{}
 
 			if responseKeyCredential.GetCustomKeyIdentifier() != nil {
 				tfStateKeyCredential.CustomKeyIdentifier = types.StringValue(string(responseKeyCredential.GetCustomKeyIdentifier()[:]))
@@ -783,7 +786,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 	if len(responseServicePrincipal.GetOauth2PermissionScopes()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responsePermissionScope := range responseServicePrincipal.GetOauth2PermissionScopes() {
-			tfStatePermissionScope := servicePrincipalPermissionScopeModel{}
+			tfStatePermissionScope := sharedmodels.PermissionScopeModel{}
 
 			if responsePermissionScope.GetAdminConsentDescription() != nil {
 				tfStatePermissionScope.AdminConsentDescription = types.StringValue(*responsePermissionScope.GetAdminConsentDescription())
@@ -838,7 +841,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 	if len(responseServicePrincipal.GetPasswordCredentials()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responsePasswordCredential := range responseServicePrincipal.GetPasswordCredentials() {
-			tfStatePasswordCredential := servicePrincipalPasswordCredentialModel{}
+			tfStatePasswordCredential := sharedmodels.PasswordCredentialModel{}
 
 			if responsePasswordCredential.GetCustomKeyIdentifier() != nil {
 				tfStatePasswordCredential.CustomKeyIdentifier = types.StringValue(string(responsePasswordCredential.GetCustomKeyIdentifier()[:]))
@@ -903,7 +906,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 	if len(responseServicePrincipal.GetResourceSpecificApplicationPermissions()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseResourceSpecificPermission := range responseServicePrincipal.GetResourceSpecificApplicationPermissions() {
-			tfStateResourceSpecificPermission := servicePrincipalResourceSpecificPermissionModel{}
+			tfStateResourceSpecificPermission := sharedmodels.ResourceSpecificPermissionModel{}
 
 			if responseResourceSpecificPermission.GetDescription() != nil {
 				tfStateResourceSpecificPermission.Description = types.StringValue(*responseResourceSpecificPermission.GetDescription())
@@ -936,7 +939,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 		tfStateServicePrincipal.ResourceSpecificApplicationPermissions, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 	}
 	if responseServicePrincipal.GetSamlSingleSignOnSettings() != nil {
-		tfStateSamlSingleSignOnSettings := servicePrincipalSamlSingleSignOnSettingsModel{}
+		tfStateSamlSingleSignOnSettings := sharedmodels.SamlSingleSignOnSettingsModel{}
 		responseSamlSingleSignOnSettings := responseServicePrincipal.GetSamlSingleSignOnSettings()
 
 		if responseSamlSingleSignOnSettings.GetRelayState() != nil {
@@ -983,7 +986,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, req datasource.Re
 		tfStateServicePrincipal.TokenEncryptionKeyId = types.StringNull()
 	}
 	if responseServicePrincipal.GetVerifiedPublisher() != nil {
-		tfStateVerifiedPublisher := servicePrincipalVerifiedPublisherModel{}
+		tfStateVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		responseVerifiedPublisher := responseServicePrincipal.GetVerifiedPublisher()
 
 		if responseVerifiedPublisher.GetAddedDateTime() != nil {
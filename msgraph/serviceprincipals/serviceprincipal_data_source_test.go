@@ -0,0 +1,29 @@
+package serviceprincipals_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+func TestAccServicePrincipalDataSource(t *testing.T) {
+	config := servicePrincipalResourceConfig + `
+data "msgraph_service_principal" "test" {
+  id = msgraph_service_principal.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.msgraph_service_principal.test", "id", "msgraph_service_principal.test", "id"),
+				),
+			},
+		},
+	})
+}
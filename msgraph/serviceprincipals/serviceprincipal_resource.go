@@ -2,6 +2,8 @@ package serviceprincipals
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -10,12 +12,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"strings"
 	"time"
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/serviceprincipals"
 
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/listplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/objectplanmodifiers"
@@ -26,6 +31,7 @@ import (
 var (
 	_ resource.Resource              = &servicePrincipalResource{}
 	_ resource.ResourceWithConfigure = &servicePrincipalResource{}
+	_ resource.ResourceWithMoveState = &servicePrincipalResource{}
 )
 
 // NewServicePrincipalResource is a helper function to simplify the provider implementation.
@@ -44,12 +50,12 @@ func (d *servicePrincipalResource) Metadata(_ context.Context, req resource.Meta
 }
 
 // Configure adds the provider configured client to the resource.
-func (d *servicePrincipalResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (d *servicePrincipalResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the resource.
@@ -804,7 +810,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 		var requestBodyAddIns []models.AddInable
 		for _, i := range tfPlanServicePrincipal.AddIns.Elements() {
 			requestBodyAddIn := models.NewAddIn()
-			tfPlanAddIn := servicePrincipalAddInModel{}
+			tfPlanAddIn := sharedmodels.AddInModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAddIn)
 
 			if !tfPlanAddIn.Id.IsUnknown() {
@@ -819,7 +825,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 				var requestBodyProperties []models.KeyValueable
 				for _, i := range tfPlanAddIn.Properties.Elements() {
 					requestBodyKeyValue := models.NewKeyValue()
-					tfPlanKeyValue := servicePrincipalKeyValueModel{}
+					tfPlanKeyValue := sharedmodels.KeyValueModel{}
 					types.ListValueFrom(ctx, i.Type(ctx), &tfPlanKeyValue)
 
 					if !tfPlanKeyValue.Key.IsUnknown() {
@@ -905,7 +911,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 		var requestBodyAppRoles []models.AppRoleable
 		for _, i := range tfPlanServicePrincipal.AppRoles.Elements() {
 			requestBodyAppRole := models.NewAppRole()
-			tfPlanAppRole := servicePrincipalAppRoleModel{}
+			tfPlanAppRole := sharedmodels.AppRoleModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAppRole)
 
 			if len(tfPlanAppRole.AllowedMemberTypes.Elements()) > 0 {
@@ -1019,7 +1025,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 
 	if !tfPlanServicePrincipal.Info.IsUnknown() {
 		requestBodyInformationalUrl := models.NewInformationalUrl()
-		tfPlanInformationalUrl := servicePrincipalInformationalUrlModel{}
+		tfPlanInformationalUrl := sharedmodels.InformationalUrlModel{}
 		tfPlanServicePrincipal.Info.As(ctx, &tfPlanInformationalUrl, basetypes.ObjectAsOptions{})
 
 		if !tfPlanInformationalUrl.LogoUrl.IsUnknown() {
@@ -1067,7 +1073,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 		var requestBodyKeyCredentials []models.KeyCredentialable
 		for _, i := range tfPlanServicePrincipal.KeyCredentials.Elements() {
 			requestBodyKeyCredential := models.NewKeyCredential()
-			tfPlanKeyCredential := servicePrincipalKeyCredentialModel{}
+			tfPlanKeyCredential := sharedmodels.KeyCredentialModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanKeyCredential)
 
 			if !tfPlanKeyCredential.CustomKeyIdentifier.IsUnknown() {
@@ -1170,7 +1176,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 		var requestBodyOauth2PermissionScopes []models.PermissionScopeable
 		for _, i := range tfPlanServicePrincipal.Oauth2PermissionScopes.Elements() {
 			requestBodyPermissionScope := models.NewPermissionScope()
-			tfPlanPermissionScope := servicePrincipalPermissionScopeModel{}
+			tfPlanPermissionScope := sharedmodels.PermissionScopeModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPermissionScope)
 
 			if !tfPlanPermissionScope.AdminConsentDescription.IsUnknown() {
@@ -1247,7 +1253,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 		var requestBodyPasswordCredentials []models.PasswordCredentialable
 		for _, i := range tfPlanServicePrincipal.PasswordCredentials.Elements() {
 			requestBodyPasswordCredential := models.NewPasswordCredential()
-			tfPlanPasswordCredential := servicePrincipalPasswordCredentialModel{}
+			tfPlanPasswordCredential := sharedmodels.PasswordCredentialModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPasswordCredential)
 
 			if !tfPlanPasswordCredential.CustomKeyIdentifier.IsUnknown() {
@@ -1336,7 +1342,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 		var requestBodyResourceSpecificApplicationPermissions []models.ResourceSpecificPermissionable
 		for _, i := range tfPlanServicePrincipal.ResourceSpecificApplicationPermissions.Elements() {
 			requestBodyResourceSpecificPermission := models.NewResourceSpecificPermission()
-			tfPlanResourceSpecificPermission := servicePrincipalResourceSpecificPermissionModel{}
+			tfPlanResourceSpecificPermission := sharedmodels.ResourceSpecificPermissionModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanResourceSpecificPermission)
 
 			if !tfPlanResourceSpecificPermission.Description.IsUnknown() {
@@ -1383,7 +1389,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 
 	if !tfPlanServicePrincipal.SamlSingleSignOnSettings.IsUnknown() {
 		requestBodySamlSingleSignOnSettings := models.NewSamlSingleSignOnSettings()
-		tfPlanSamlSingleSignOnSettings := servicePrincipalSamlSingleSignOnSettingsModel{}
+		tfPlanSamlSingleSignOnSettings := sharedmodels.SamlSingleSignOnSettingsModel{}
 		tfPlanServicePrincipal.SamlSingleSignOnSettings.As(ctx, &tfPlanSamlSingleSignOnSettings, basetypes.ObjectAsOptions{})
 
 		if !tfPlanSamlSingleSignOnSettings.RelayState.IsUnknown() {
@@ -1443,7 +1449,7 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 
 	if !tfPlanServicePrincipal.VerifiedPublisher.IsUnknown() {
 		requestBodyVerifiedPublisher := models.NewVerifiedPublisher()
-		tfPlanVerifiedPublisher := servicePrincipalVerifiedPublisherModel{}
+		tfPlanVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		tfPlanServicePrincipal.VerifiedPublisher.As(ctx, &tfPlanVerifiedPublisher, basetypes.ObjectAsOptions{})
 
 		if !tfPlanVerifiedPublisher.AddedDateTime.IsUnknown() {
@@ -1475,7 +1481,10 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Create new ServicePrincipal
-	result, err := r.client.ServicePrincipals().Post(context.Background(), requestBodyServicePrincipal, nil)
+	created := clients.NewCreatedObject()
+	result, err := r.client.ServicePrincipals().Post(ctx, requestBodyServicePrincipal, &serviceprincipals.ServicePrincipalsRequestBuilderPostRequestConfiguration{
+		Options: created.Options(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating ServicePrincipal",
@@ -1486,7 +1495,15 @@ func (r *servicePrincipalResource) Create(ctx context.Context, req resource.Crea
 
 	// Map response body to schema and populate Computed attribute value
 	// TODO: Add support for other Computed values
-	tfPlanServicePrincipal.Id = types.StringValue(*result.GetId())
+	id, err := created.Id(result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ServicePrincipal",
+			err.Error(),
+		)
+		return
+	}
+	tfPlanServicePrincipal.Id = types.StringValue(id)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, tfPlanServicePrincipal)
@@ -1576,7 +1593,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 	if len(responseServicePrincipal.GetAddIns()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAddIn := range responseServicePrincipal.GetAddIns() {
-			tfStateAddIn := servicePrincipalAddInModel{}
+			tfStateAddIn := sharedmodels.AddInModel{}
 
 			if responseAddIn.GetId() != nil {
 				tfStateAddIn.Id = types.StringValue(responseAddIn.GetId().String())
@@ -1586,7 +1603,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 			if len(responseAddIn.GetProperties()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseKeyValue := range responseAddIn.GetProperties() {
-					tfStateKeyValue := servicePrincipalKeyValueModel{}
+					tfStateKeyValue := sharedmodels.KeyValueModel{}
 
 					if responseKeyValue.GetKey() != nil {
 						tfStateKeyValue.Key = types.StringValue(*responseKeyValue.GetKey())
@@ -1651,7 +1668,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 	if len(responseServicePrincipal.GetAppRoles()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAppRole := range responseServicePrincipal.GetAppRoles() {
-			tfStateAppRole := servicePrincipalAppRoleModel{}
+			tfStateAppRole := sharedmodels.AppRoleModel{}
 
 			if len(responseAppRole.GetAllowedMemberTypes()) > 0 {
 				var valueArrayAllowedMemberTypes []attr.Value
@@ -1734,7 +1751,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 		tfStateServicePrincipal.Id = types.StringNull()
 	}
 	if responseServicePrincipal.GetInfo() != nil {
-		tfStateInformationalUrl := servicePrincipalInformationalUrlModel{}
+		tfStateInformationalUrl := sharedmodels.InformationalUrlModel{}
 		responseInformationalUrl := responseServicePrincipal.GetInfo()
 
 		if responseInformationalUrl.GetLogoUrl() != nil {
@@ -1768,7 +1785,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 	if len(responseServicePrincipal.GetKeyCredentials()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseKeyCredential := range responseServicePrincipal.GetKeyCredentials() {
-			tfStateKeyCredential := servicePrincipalKeyCredentialModel{}
+			tfStateKeyCredential := sharedmodels.KeyCredentialModel{}
 
 			if responseKeyCredential.GetCustomKeyIdentifier() != nil {
 				tfStateKeyCredential.CustomKeyIdentifier = types.StringValue(string(responseKeyCredential.GetCustomKeyIdentifier()[:]))
@@ -1843,7 +1860,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 	if len(responseServicePrincipal.GetOauth2PermissionScopes()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responsePermissionScope := range responseServicePrincipal.GetOauth2PermissionScopes() {
-			tfStatePermissionScope := servicePrincipalPermissionScopeModel{}
+			tfStatePermissionScope := sharedmodels.PermissionScopeModel{}
 
 			if responsePermissionScope.GetAdminConsentDescription() != nil {
 				tfStatePermissionScope.AdminConsentDescription = types.StringValue(*responsePermissionScope.GetAdminConsentDescription())
@@ -1898,7 +1915,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 	if len(responseServicePrincipal.GetPasswordCredentials()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responsePasswordCredential := range responseServicePrincipal.GetPasswordCredentials() {
-			tfStatePasswordCredential := servicePrincipalPasswordCredentialModel{}
+			tfStatePasswordCredential := sharedmodels.PasswordCredentialModel{}
 
 			if responsePasswordCredential.GetCustomKeyIdentifier() != nil {
 				tfStatePasswordCredential.CustomKeyIdentifier = types.StringValue(string(responsePasswordCredential.GetCustomKeyIdentifier()[:]))
@@ -1963,7 +1980,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 	if len(responseServicePrincipal.GetResourceSpecificApplicationPermissions()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseResourceSpecificPermission := range responseServicePrincipal.GetResourceSpecificApplicationPermissions() {
-			tfStateResourceSpecificPermission := servicePrincipalResourceSpecificPermissionModel{}
+			tfStateResourceSpecificPermission := sharedmodels.ResourceSpecificPermissionModel{}
 
 			if responseResourceSpecificPermission.GetDescription() != nil {
 				tfStateResourceSpecificPermission.Description = types.StringValue(*responseResourceSpecificPermission.GetDescription())
@@ -1996,7 +2013,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 		tfStateServicePrincipal.ResourceSpecificApplicationPermissions, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 	}
 	if responseServicePrincipal.GetSamlSingleSignOnSettings() != nil {
-		tfStateSamlSingleSignOnSettings := servicePrincipalSamlSingleSignOnSettingsModel{}
+		tfStateSamlSingleSignOnSettings := sharedmodels.SamlSingleSignOnSettingsModel{}
 		responseSamlSingleSignOnSettings := responseServicePrincipal.GetSamlSingleSignOnSettings()
 
 		if responseSamlSingleSignOnSettings.GetRelayState() != nil {
@@ -2043,7 +2060,7 @@ func (d *servicePrincipalResource) Read(ctx context.Context, req resource.ReadRe
 		tfStateServicePrincipal.TokenEncryptionKeyId = types.StringNull()
 	}
 	if responseServicePrincipal.GetVerifiedPublisher() != nil {
-		tfStateVerifiedPublisher := servicePrincipalVerifiedPublisherModel{}
+		tfStateVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		responseVerifiedPublisher := responseServicePrincipal.GetVerifiedPublisher()
 
 		if responseVerifiedPublisher.GetAddedDateTime() != nil {
@@ -2101,54 +2118,85 @@ func (r *servicePrincipalResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	if !tfPlanServicePrincipal.AddIns.Equal(tfStateServicePrincipal.AddIns) {
-		var tfPlanAddIns []models.AddInable
-		for k, i := range tfPlanServicePrincipal.AddIns.Elements() {
+		var objectArrayAddIns []models.AddInable
+		objectValuesAddIns := []basetypes.ObjectValue{}
+		tfStateElementsAddIns := tfStateServicePrincipal.AddIns.Elements()
+		for n, i := range tfPlanServicePrincipal.AddIns.Elements() {
 			requestBodyAddIn := models.NewAddIn()
-			tfPlanAddIn := servicePrincipalAddInModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAddIn)
-			tfStateAddIn := servicePrincipalAddInModel{}
-			types.ListValueFrom(ctx, tfStateServicePrincipal.AddIns.Elements()[k].Type(ctx), &tfPlanAddIn)
+			tfPlanAddIn := sharedmodels.AddInModel{}
+			i.(types.Object).As(ctx, &tfPlanAddIn, basetypes.ObjectAsOptions{})
+			tfStateAddIn := sharedmodels.AddInModel{}
+			if n < len(tfStateElementsAddIns) {
+				tfStateElementsAddIns[n].(types.Object).As(ctx, &tfStateAddIn, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanAddIn.Id.Equal(tfStateAddIn.Id) {
+			if tfPlanAddIn.Id.IsUnknown() {
+				tfPlanAddIn.Id = tfStateAddIn.Id
+			}
+			if !tfPlanAddIn.Id.IsNull() {
 				tfPlanId := tfPlanAddIn.Id.ValueString()
 				u, _ := uuid.Parse(tfPlanId)
 				requestBodyAddIn.SetId(&u)
 			}
 
-			if !tfPlanAddIn.Properties.Equal(tfStateAddIn.Properties) {
-				var tfPlanProperties []models.KeyValueable
-				for k, i := range tfPlanAddIn.Properties.Elements() {
+			if tfPlanAddIn.Properties.IsUnknown() {
+				tfPlanAddIn.Properties = tfStateAddIn.Properties
+			}
+			if !tfPlanAddIn.Properties.IsNull() {
+				var objectArrayProperties []models.KeyValueable
+				objectValuesProperties := []basetypes.ObjectValue{}
+				tfStateElementsProperties := tfStateAddIn.Properties.Elements()
+				for n, i := range tfPlanAddIn.Properties.Elements() {
 					requestBodyKeyValue := models.NewKeyValue()
-					tfPlanKeyValue := servicePrincipalKeyValueModel{}
-					types.ListValueFrom(ctx, i.Type(ctx), &tfPlanKeyValue)
-					tfStateKeyValue := servicePrincipalKeyValueModel{}
-					types.ListValueFrom(ctx, tfStateAddIn.Properties.Elements()[k].Type(ctx), &tfPlanKeyValue)
+					tfPlanKeyValue := sharedmodels.KeyValueModel{}
+					i.(types.Object).As(ctx, &tfPlanKeyValue, basetypes.ObjectAsOptions{})
+					tfStateKeyValue := sharedmodels.KeyValueModel{}
+					if n < len(tfStateElementsProperties) {
+						tfStateElementsProperties[n].(types.Object).As(ctx, &tfStateKeyValue, basetypes.ObjectAsOptions{})
+					}
 
-					if !tfPlanKeyValue.Key.Equal(tfStateKeyValue.Key) {
+					if tfPlanKeyValue.Key.IsUnknown() {
+						tfPlanKeyValue.Key = tfStateKeyValue.Key
+					}
+					if !tfPlanKeyValue.Key.IsNull() {
 						tfPlanKey := tfPlanKeyValue.Key.ValueString()
 						requestBodyKeyValue.SetKey(&tfPlanKey)
 					}
 
-					if !tfPlanKeyValue.Value.Equal(tfStateKeyValue.Value) {
+					if tfPlanKeyValue.Value.IsUnknown() {
+						tfPlanKeyValue.Value = tfStateKeyValue.Value
+					}
+					if !tfPlanKeyValue.Value.IsNull() {
 						tfPlanValue := tfPlanKeyValue.Value.ValueString()
 						requestBodyKeyValue.SetValue(&tfPlanValue)
 					}
+					objectArrayProperties = append(objectArrayProperties, requestBodyKeyValue)
+					objectValue, _ := types.ObjectValueFrom(ctx, tfPlanKeyValue.AttributeTypes(), tfPlanKeyValue)
+					objectValuesProperties = append(objectValuesProperties, objectValue)
 				}
-				requestBodyAddIn.SetProperties(tfPlanProperties)
+				requestBodyAddIn.SetProperties(objectArrayProperties)
+				tfPlanAddIn.Properties, _ = types.ListValueFrom(ctx, tfPlanAddIn.Properties.ElementType(ctx), objectValuesProperties)
 			}
 
-			if !tfPlanAddIn.Type.Equal(tfStateAddIn.Type) {
+			if tfPlanAddIn.Type.IsUnknown() {
+				tfPlanAddIn.Type = tfStateAddIn.Type
+			}
+			if !tfPlanAddIn.Type.IsNull() {
 				tfPlanType := tfPlanAddIn.Type.ValueString()
 				requestBodyAddIn.SetTypeEscaped(&tfPlanType)
 			}
+			objectArrayAddIns = append(objectArrayAddIns, requestBodyAddIn)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanAddIn.AttributeTypes(), tfPlanAddIn)
+			objectValuesAddIns = append(objectValuesAddIns, objectValue)
 		}
-		requestBodyServicePrincipal.SetAddIns(tfPlanAddIns)
+		requestBodyServicePrincipal.SetAddIns(objectArrayAddIns)
+		tfPlanServicePrincipal.AddIns, _ = types.ListValueFrom(ctx, tfPlanServicePrincipal.AddIns.ElementType(ctx), objectValuesAddIns)
 	}
 
 	if !tfPlanServicePrincipal.AlternativeNames.Equal(tfStateServicePrincipal.AlternativeNames) {
 		var stringArrayAlternativeNames []string
 		for _, i := range tfPlanServicePrincipal.AlternativeNames.Elements() {
-			stringArrayAlternativeNames = append(stringArrayAlternativeNames, i.String())
+			stringArrayAlternativeNames = append(stringArrayAlternativeNames, i.(types.String).ValueString())
 		}
 		requestBodyServicePrincipal.SetAlternativeNames(stringArrayAlternativeNames)
 	}
@@ -2180,54 +2228,83 @@ func (r *servicePrincipalResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	if !tfPlanServicePrincipal.AppRoles.Equal(tfStateServicePrincipal.AppRoles) {
-		var tfPlanAppRoles []models.AppRoleable
-		for k, i := range tfPlanServicePrincipal.AppRoles.Elements() {
+		var objectArrayAppRoles []models.AppRoleable
+		objectValuesAppRoles := []basetypes.ObjectValue{}
+		tfStateElementsAppRoles := tfStateServicePrincipal.AppRoles.Elements()
+		for n, i := range tfPlanServicePrincipal.AppRoles.Elements() {
 			requestBodyAppRole := models.NewAppRole()
-			tfPlanAppRole := servicePrincipalAppRoleModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAppRole)
-			tfStateAppRole := servicePrincipalAppRoleModel{}
-			types.ListValueFrom(ctx, tfStateServicePrincipal.AppRoles.Elements()[k].Type(ctx), &tfPlanAppRole)
+			tfPlanAppRole := sharedmodels.AppRoleModel{}
+			i.(types.Object).As(ctx, &tfPlanAppRole, basetypes.ObjectAsOptions{})
+			tfStateAppRole := sharedmodels.AppRoleModel{}
+			if n < len(tfStateElementsAppRoles) {
+				tfStateElementsAppRoles[n].(types.Object).As(ctx, &tfStateAppRole, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanAppRole.AllowedMemberTypes.Equal(tfStateAppRole.AllowedMemberTypes) {
+			if tfPlanAppRole.AllowedMemberTypes.IsUnknown() {
+				tfPlanAppRole.AllowedMemberTypes = tfStateAppRole.AllowedMemberTypes
+			}
+			if !tfPlanAppRole.AllowedMemberTypes.IsNull() {
 				var stringArrayAllowedMemberTypes []string
 				for _, i := range tfPlanAppRole.AllowedMemberTypes.Elements() {
-					stringArrayAllowedMemberTypes = append(stringArrayAllowedMemberTypes, i.String())
+					stringArrayAllowedMemberTypes = append(stringArrayAllowedMemberTypes, i.(types.String).ValueString())
 				}
 				requestBodyAppRole.SetAllowedMemberTypes(stringArrayAllowedMemberTypes)
 			}
 
-			if !tfPlanAppRole.Description.Equal(tfStateAppRole.Description) {
+			if tfPlanAppRole.Description.IsUnknown() {
+				tfPlanAppRole.Description = tfStateAppRole.Description
+			}
+			if !tfPlanAppRole.Description.IsNull() {
 				tfPlanDescription := tfPlanAppRole.Description.ValueString()
 				requestBodyAppRole.SetDescription(&tfPlanDescription)
 			}
 
-			if !tfPlanAppRole.DisplayName.Equal(tfStateAppRole.DisplayName) {
+			if tfPlanAppRole.DisplayName.IsUnknown() {
+				tfPlanAppRole.DisplayName = tfStateAppRole.DisplayName
+			}
+			if !tfPlanAppRole.DisplayName.IsNull() {
 				tfPlanDisplayName := tfPlanAppRole.DisplayName.ValueString()
 				requestBodyAppRole.SetDisplayName(&tfPlanDisplayName)
 			}
 
-			if !tfPlanAppRole.Id.Equal(tfStateAppRole.Id) {
+			if tfPlanAppRole.Id.IsUnknown() {
+				tfPlanAppRole.Id = tfStateAppRole.Id
+			}
+			if !tfPlanAppRole.Id.IsNull() {
 				tfPlanId := tfPlanAppRole.Id.ValueString()
 				u, _ := uuid.Parse(tfPlanId)
 				requestBodyAppRole.SetId(&u)
 			}
 
-			if !tfPlanAppRole.IsEnabled.Equal(tfStateAppRole.IsEnabled) {
+			if tfPlanAppRole.IsEnabled.IsUnknown() {
+				tfPlanAppRole.IsEnabled = tfStateAppRole.IsEnabled
+			}
+			if !tfPlanAppRole.IsEnabled.IsNull() {
 				tfPlanIsEnabled := tfPlanAppRole.IsEnabled.ValueBool()
 				requestBodyAppRole.SetIsEnabled(&tfPlanIsEnabled)
 			}
 
-			if !tfPlanAppRole.Origin.Equal(tfStateAppRole.Origin) {
+			if tfPlanAppRole.Origin.IsUnknown() {
+				tfPlanAppRole.Origin = tfStateAppRole.Origin
+			}
+			if !tfPlanAppRole.Origin.IsNull() {
 				tfPlanOrigin := tfPlanAppRole.Origin.ValueString()
 				requestBodyAppRole.SetOrigin(&tfPlanOrigin)
 			}
 
-			if !tfPlanAppRole.Value.Equal(tfStateAppRole.Value) {
+			if tfPlanAppRole.Value.IsUnknown() {
+				tfPlanAppRole.Value = tfStateAppRole.Value
+			}
+			if !tfPlanAppRole.Value.IsNull() {
 				tfPlanValue := tfPlanAppRole.Value.ValueString()
 				requestBodyAppRole.SetValue(&tfPlanValue)
 			}
+			objectArrayAppRoles = append(objectArrayAppRoles, requestBodyAppRole)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanAppRole.AttributeTypes(), tfPlanAppRole)
+			objectValuesAppRoles = append(objectValuesAppRoles, objectValue)
 		}
-		requestBodyServicePrincipal.SetAppRoles(tfPlanAppRoles)
+		requestBodyServicePrincipal.SetAppRoles(objectArrayAppRoles)
+		tfPlanServicePrincipal.AppRoles, _ = types.ListValueFrom(ctx, tfPlanServicePrincipal.AppRoles.ElementType(ctx), objectValuesAppRoles)
 	}
 
 	if !tfPlanServicePrincipal.ApplicationTemplateId.Equal(tfStateServicePrincipal.ApplicationTemplateId) {
@@ -2268,32 +2345,47 @@ func (r *servicePrincipalResource) Update(ctx context.Context, req resource.Upda
 
 	if !tfPlanServicePrincipal.Info.Equal(tfStateServicePrincipal.Info) {
 		requestBodyInformationalUrl := models.NewInformationalUrl()
-		tfPlanInformationalUrl := servicePrincipalInformationalUrlModel{}
+		tfPlanInformationalUrl := sharedmodels.InformationalUrlModel{}
 		tfPlanServicePrincipal.Info.As(ctx, &tfPlanInformationalUrl, basetypes.ObjectAsOptions{})
-		tfStateInformationalUrl := servicePrincipalInformationalUrlModel{}
+		tfStateInformationalUrl := sharedmodels.InformationalUrlModel{}
 		tfStateServicePrincipal.Info.As(ctx, &tfStateInformationalUrl, basetypes.ObjectAsOptions{})
 
-		if !tfPlanInformationalUrl.LogoUrl.Equal(tfStateInformationalUrl.LogoUrl) {
+		if tfPlanInformationalUrl.LogoUrl.IsUnknown() {
+			tfPlanInformationalUrl.LogoUrl = tfStateInformationalUrl.LogoUrl
+		}
+		if !tfPlanInformationalUrl.LogoUrl.IsNull() {
 			tfPlanLogoUrl := tfPlanInformationalUrl.LogoUrl.ValueString()
 			requestBodyInformationalUrl.SetLogoUrl(&tfPlanLogoUrl)
 		}
 
-		if !tfPlanInformationalUrl.MarketingUrl.Equal(tfStateInformationalUrl.MarketingUrl) {
+		if tfPlanInformationalUrl.MarketingUrl.IsUnknown() {
+			tfPlanInformationalUrl.MarketingUrl = tfStateInformationalUrl.MarketingUrl
+		}
+		if !tfPlanInformationalUrl.MarketingUrl.IsNull() {
 			tfPlanMarketingUrl := tfPlanInformationalUrl.MarketingUrl.ValueString()
 			requestBodyInformationalUrl.SetMarketingUrl(&tfPlanMarketingUrl)
 		}
 
-		if !tfPlanInformationalUrl.PrivacyStatementUrl.Equal(tfStateInformationalUrl.PrivacyStatementUrl) {
+		if tfPlanInformationalUrl.PrivacyStatementUrl.IsUnknown() {
+			tfPlanInformationalUrl.PrivacyStatementUrl = tfStateInformationalUrl.PrivacyStatementUrl
+		}
+		if !tfPlanInformationalUrl.PrivacyStatementUrl.IsNull() {
 			tfPlanPrivacyStatementUrl := tfPlanInformationalUrl.PrivacyStatementUrl.ValueString()
 			requestBodyInformationalUrl.SetPrivacyStatementUrl(&tfPlanPrivacyStatementUrl)
 		}
 
-		if !tfPlanInformationalUrl.SupportUrl.Equal(tfStateInformationalUrl.SupportUrl) {
+		if tfPlanInformationalUrl.SupportUrl.IsUnknown() {
+			tfPlanInformationalUrl.SupportUrl = tfStateInformationalUrl.SupportUrl
+		}
+		if !tfPlanInformationalUrl.SupportUrl.IsNull() {
 			tfPlanSupportUrl := tfPlanInformationalUrl.SupportUrl.ValueString()
 			requestBodyInformationalUrl.SetSupportUrl(&tfPlanSupportUrl)
 		}
 
-		if !tfPlanInformationalUrl.TermsOfServiceUrl.Equal(tfStateInformationalUrl.TermsOfServiceUrl) {
+		if tfPlanInformationalUrl.TermsOfServiceUrl.IsUnknown() {
+			tfPlanInformationalUrl.TermsOfServiceUrl = tfStateInformationalUrl.TermsOfServiceUrl
+		}
+		if !tfPlanInformationalUrl.TermsOfServiceUrl.IsNull() {
 			tfPlanTermsOfServiceUrl := tfPlanInformationalUrl.TermsOfServiceUrl.ValueString()
 			requestBodyInformationalUrl.SetTermsOfServiceUrl(&tfPlanTermsOfServiceUrl)
 		}
@@ -2302,58 +2394,90 @@ func (r *servicePrincipalResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	if !tfPlanServicePrincipal.KeyCredentials.Equal(tfStateServicePrincipal.KeyCredentials) {
-		var tfPlanKeyCredentials []models.KeyCredentialable
-		for k, i := range tfPlanServicePrincipal.KeyCredentials.Elements() {
+		var objectArrayKeyCredentials []models.KeyCredentialable
+		objectValuesKeyCredentials := []basetypes.ObjectValue{}
+		tfStateElementsKeyCredentials := tfStateServicePrincipal.KeyCredentials.Elements()
+		for n, i := range tfPlanServicePrincipal.KeyCredentials.Elements() {
 			requestBodyKeyCredential := models.NewKeyCredential()
-			tfPlanKeyCredential := servicePrincipalKeyCredentialModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanKeyCredential)
-			tfStateKeyCredential := servicePrincipalKeyCredentialModel{}
-			types.ListValueFrom(ctx, tfStateServicePrincipal.KeyCredentials.Elements()[k].Type(ctx), &tfPlanKeyCredential)
+			tfPlanKeyCredential := sharedmodels.KeyCredentialModel{}
+			i.(types.Object).As(ctx, &tfPlanKeyCredential, basetypes.ObjectAsOptions{})
+			tfStateKeyCredential := sharedmodels.KeyCredentialModel{}
+			if n < len(tfStateElementsKeyCredentials) {
+				tfStateElementsKeyCredentials[n].(types.Object).As(ctx, &tfStateKeyCredential, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanKeyCredential.CustomKeyIdentifier.Equal(tfStateKeyCredential.CustomKeyIdentifier) {
+			if tfPlanKeyCredential.CustomKeyIdentifier.IsUnknown() {
+				tfPlanKeyCredential.CustomKeyIdentifier = tfStateKeyCredential.CustomKeyIdentifier
+			}
+			if !tfPlanKeyCredential.CustomKeyIdentifier.IsNull() {
 				tfPlanCustomKeyIdentifier := tfPlanKeyCredential.CustomKeyIdentifier.ValueString()
 				requestBodyKeyCredential.SetCustomKeyIdentifier([]byte(tfPlanCustomKeyIdentifier))
 			}
 
-			if !tfPlanKeyCredential.DisplayName.Equal(tfStateKeyCredential.DisplayName) {
+			if tfPlanKeyCredential.DisplayName.IsUnknown() {
+				tfPlanKeyCredential.DisplayName = tfStateKeyCredential.DisplayName
+			}
+			if !tfPlanKeyCredential.DisplayName.IsNull() {
 				tfPlanDisplayName := tfPlanKeyCredential.DisplayName.ValueString()
 				requestBodyKeyCredential.SetDisplayName(&tfPlanDisplayName)
 			}
 
-			if !tfPlanKeyCredential.EndDateTime.Equal(tfStateKeyCredential.EndDateTime) {
+			if tfPlanKeyCredential.EndDateTime.IsUnknown() {
+				tfPlanKeyCredential.EndDateTime = tfStateKeyCredential.EndDateTime
+			}
+			if !tfPlanKeyCredential.EndDateTime.IsNull() {
 				tfPlanEndDateTime := tfPlanKeyCredential.EndDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanEndDateTime)
 				requestBodyKeyCredential.SetEndDateTime(&t)
 			}
 
-			if !tfPlanKeyCredential.Key.Equal(tfStateKeyCredential.Key) {
+			if tfPlanKeyCredential.Key.IsUnknown() {
+				tfPlanKeyCredential.Key = tfStateKeyCredential.Key
+			}
+			if !tfPlanKeyCredential.Key.IsNull() {
 				tfPlanKey := tfPlanKeyCredential.Key.ValueString()
 				requestBodyKeyCredential.SetKey([]byte(tfPlanKey))
 			}
 
-			if !tfPlanKeyCredential.KeyId.Equal(tfStateKeyCredential.KeyId) {
+			if tfPlanKeyCredential.KeyId.IsUnknown() {
+				tfPlanKeyCredential.KeyId = tfStateKeyCredential.KeyId
+			}
+			if !tfPlanKeyCredential.KeyId.IsNull() {
 				tfPlanKeyId := tfPlanKeyCredential.KeyId.ValueString()
 				u, _ := uuid.Parse(tfPlanKeyId)
 				requestBodyKeyCredential.SetKeyId(&u)
 			}
 
-			if !tfPlanKeyCredential.StartDateTime.Equal(tfStateKeyCredential.StartDateTime) {
+			if tfPlanKeyCredential.StartDateTime.IsUnknown() {
+				tfPlanKeyCredential.StartDateTime = tfStateKeyCredential.StartDateTime
+			}
+			if !tfPlanKeyCredential.StartDateTime.IsNull() {
 				tfPlanStartDateTime := tfPlanKeyCredential.StartDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanStartDateTime)
 				requestBodyKeyCredential.SetStartDateTime(&t)
 			}
 
-			if !tfPlanKeyCredential.Type.Equal(tfStateKeyCredential.Type) {
+			if tfPlanKeyCredential.Type.IsUnknown() {
+				tfPlanKeyCredential.Type = tfStateKeyCredential.Type
+			}
+			if !tfPlanKeyCredential.Type.IsNull() {
 				tfPlanType := tfPlanKeyCredential.Type.ValueString()
 				requestBodyKeyCredential.SetTypeEscaped(&tfPlanType)
 			}
 
-			if !tfPlanKeyCredential.Usage.Equal(tfStateKeyCredential.Usage) {
+			if tfPlanKeyCredential.Usage.IsUnknown() {
+				tfPlanKeyCredential.Usage = tfStateKeyCredential.Usage
+			}
+			if !tfPlanKeyCredential.Usage.IsNull() {
 				tfPlanUsage := tfPlanKeyCredential.Usage.ValueString()
 				requestBodyKeyCredential.SetUsage(&tfPlanUsage)
 			}
+			objectArrayKeyCredentials = append(objectArrayKeyCredentials, requestBodyKeyCredential)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanKeyCredential.AttributeTypes(), tfPlanKeyCredential)
+			objectValuesKeyCredentials = append(objectValuesKeyCredentials, objectValue)
 		}
-		requestBodyServicePrincipal.SetKeyCredentials(tfPlanKeyCredentials)
+		requestBodyServicePrincipal.SetKeyCredentials(objectArrayKeyCredentials)
+		tfPlanServicePrincipal.KeyCredentials, _ = types.ListValueFrom(ctx, tfPlanServicePrincipal.KeyCredentials.ElementType(ctx), objectValuesKeyCredentials)
 	}
 
 	if !tfPlanServicePrincipal.LoginUrl.Equal(tfStateServicePrincipal.LoginUrl) {
@@ -2374,117 +2498,181 @@ func (r *servicePrincipalResource) Update(ctx context.Context, req resource.Upda
 	if !tfPlanServicePrincipal.NotificationEmailAddresses.Equal(tfStateServicePrincipal.NotificationEmailAddresses) {
 		var stringArrayNotificationEmailAddresses []string
 		for _, i := range tfPlanServicePrincipal.NotificationEmailAddresses.Elements() {
-			stringArrayNotificationEmailAddresses = append(stringArrayNotificationEmailAddresses, i.String())
+			stringArrayNotificationEmailAddresses = append(stringArrayNotificationEmailAddresses, i.(types.String).ValueString())
 		}
 		requestBodyServicePrincipal.SetNotificationEmailAddresses(stringArrayNotificationEmailAddresses)
 	}
 
 	if !tfPlanServicePrincipal.Oauth2PermissionScopes.Equal(tfStateServicePrincipal.Oauth2PermissionScopes) {
-		var tfPlanOauth2PermissionScopes []models.PermissionScopeable
-		for k, i := range tfPlanServicePrincipal.Oauth2PermissionScopes.Elements() {
+		var objectArrayOauth2PermissionScopes []models.PermissionScopeable
+		objectValuesOauth2PermissionScopes := []basetypes.ObjectValue{}
+		tfStateElementsOauth2PermissionScopes := tfStateServicePrincipal.Oauth2PermissionScopes.Elements()
+		for n, i := range tfPlanServicePrincipal.Oauth2PermissionScopes.Elements() {
 			requestBodyPermissionScope := models.NewPermissionScope()
-			tfPlanPermissionScope := servicePrincipalPermissionScopeModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPermissionScope)
-			tfStatePermissionScope := servicePrincipalPermissionScopeModel{}
-			types.ListValueFrom(ctx, tfStateServicePrincipal.Oauth2PermissionScopes.Elements()[k].Type(ctx), &tfPlanPermissionScope)
+			tfPlanPermissionScope := sharedmodels.PermissionScopeModel{}
+			i.(types.Object).As(ctx, &tfPlanPermissionScope, basetypes.ObjectAsOptions{})
+			tfStatePermissionScope := sharedmodels.PermissionScopeModel{}
+			if n < len(tfStateElementsOauth2PermissionScopes) {
+				tfStateElementsOauth2PermissionScopes[n].(types.Object).As(ctx, &tfStatePermissionScope, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanPermissionScope.AdminConsentDescription.Equal(tfStatePermissionScope.AdminConsentDescription) {
+			if tfPlanPermissionScope.AdminConsentDescription.IsUnknown() {
+				tfPlanPermissionScope.AdminConsentDescription = tfStatePermissionScope.AdminConsentDescription
+			}
+			if !tfPlanPermissionScope.AdminConsentDescription.IsNull() {
 				tfPlanAdminConsentDescription := tfPlanPermissionScope.AdminConsentDescription.ValueString()
 				requestBodyPermissionScope.SetAdminConsentDescription(&tfPlanAdminConsentDescription)
 			}
 
-			if !tfPlanPermissionScope.AdminConsentDisplayName.Equal(tfStatePermissionScope.AdminConsentDisplayName) {
+			if tfPlanPermissionScope.AdminConsentDisplayName.IsUnknown() {
+				tfPlanPermissionScope.AdminConsentDisplayName = tfStatePermissionScope.AdminConsentDisplayName
+			}
+			if !tfPlanPermissionScope.AdminConsentDisplayName.IsNull() {
 				tfPlanAdminConsentDisplayName := tfPlanPermissionScope.AdminConsentDisplayName.ValueString()
 				requestBodyPermissionScope.SetAdminConsentDisplayName(&tfPlanAdminConsentDisplayName)
 			}
 
-			if !tfPlanPermissionScope.Id.Equal(tfStatePermissionScope.Id) {
+			if tfPlanPermissionScope.Id.IsUnknown() {
+				tfPlanPermissionScope.Id = tfStatePermissionScope.Id
+			}
+			if !tfPlanPermissionScope.Id.IsNull() {
 				tfPlanId := tfPlanPermissionScope.Id.ValueString()
 				u, _ := uuid.Parse(tfPlanId)
 				requestBodyPermissionScope.SetId(&u)
 			}
 
-			if !tfPlanPermissionScope.IsEnabled.Equal(tfStatePermissionScope.IsEnabled) {
+			if tfPlanPermissionScope.IsEnabled.IsUnknown() {
+				tfPlanPermissionScope.IsEnabled = tfStatePermissionScope.IsEnabled
+			}
+			if !tfPlanPermissionScope.IsEnabled.IsNull() {
 				tfPlanIsEnabled := tfPlanPermissionScope.IsEnabled.ValueBool()
 				requestBodyPermissionScope.SetIsEnabled(&tfPlanIsEnabled)
 			}
 
-			if !tfPlanPermissionScope.Origin.Equal(tfStatePermissionScope.Origin) {
+			if tfPlanPermissionScope.Origin.IsUnknown() {
+				tfPlanPermissionScope.Origin = tfStatePermissionScope.Origin
+			}
+			if !tfPlanPermissionScope.Origin.IsNull() {
 				tfPlanOrigin := tfPlanPermissionScope.Origin.ValueString()
 				requestBodyPermissionScope.SetOrigin(&tfPlanOrigin)
 			}
 
-			if !tfPlanPermissionScope.Type.Equal(tfStatePermissionScope.Type) {
+			if tfPlanPermissionScope.Type.IsUnknown() {
+				tfPlanPermissionScope.Type = tfStatePermissionScope.Type
+			}
+			if !tfPlanPermissionScope.Type.IsNull() {
 				tfPlanType := tfPlanPermissionScope.Type.ValueString()
 				requestBodyPermissionScope.SetTypeEscaped(&tfPlanType)
 			}
 
-			if !tfPlanPermissionScope.UserConsentDescription.Equal(tfStatePermissionScope.UserConsentDescription) {
+			if tfPlanPermissionScope.UserConsentDescription.IsUnknown() {
+				tfPlanPermissionScope.UserConsentDescription = tfStatePermissionScope.UserConsentDescription
+			}
+			if !tfPlanPermissionScope.UserConsentDescription.IsNull() {
 				tfPlanUserConsentDescription := tfPlanPermissionScope.UserConsentDescription.ValueString()
 				requestBodyPermissionScope.SetUserConsentDescription(&tfPlanUserConsentDescription)
 			}
 
-			if !tfPlanPermissionScope.UserConsentDisplayName.Equal(tfStatePermissionScope.UserConsentDisplayName) {
+			if tfPlanPermissionScope.UserConsentDisplayName.IsUnknown() {
+				tfPlanPermissionScope.UserConsentDisplayName = tfStatePermissionScope.UserConsentDisplayName
+			}
+			if !tfPlanPermissionScope.UserConsentDisplayName.IsNull() {
 				tfPlanUserConsentDisplayName := tfPlanPermissionScope.UserConsentDisplayName.ValueString()
 				requestBodyPermissionScope.SetUserConsentDisplayName(&tfPlanUserConsentDisplayName)
 			}
 
-			if !tfPlanPermissionScope.Value.Equal(tfStatePermissionScope.Value) {
+			if tfPlanPermissionScope.Value.IsUnknown() {
+				tfPlanPermissionScope.Value = tfStatePermissionScope.Value
+			}
+			if !tfPlanPermissionScope.Value.IsNull() {
 				tfPlanValue := tfPlanPermissionScope.Value.ValueString()
 				requestBodyPermissionScope.SetValue(&tfPlanValue)
 			}
+			objectArrayOauth2PermissionScopes = append(objectArrayOauth2PermissionScopes, requestBodyPermissionScope)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanPermissionScope.AttributeTypes(), tfPlanPermissionScope)
+			objectValuesOauth2PermissionScopes = append(objectValuesOauth2PermissionScopes, objectValue)
 		}
-		requestBodyServicePrincipal.SetOauth2PermissionScopes(tfPlanOauth2PermissionScopes)
+		requestBodyServicePrincipal.SetOauth2PermissionScopes(objectArrayOauth2PermissionScopes)
+		tfPlanServicePrincipal.Oauth2PermissionScopes, _ = types.ListValueFrom(ctx, tfPlanServicePrincipal.Oauth2PermissionScopes.ElementType(ctx), objectValuesOauth2PermissionScopes)
 	}
 
 	if !tfPlanServicePrincipal.PasswordCredentials.Equal(tfStateServicePrincipal.PasswordCredentials) {
-		var tfPlanPasswordCredentials []models.PasswordCredentialable
-		for k, i := range tfPlanServicePrincipal.PasswordCredentials.Elements() {
+		var objectArrayPasswordCredentials []models.PasswordCredentialable
+		objectValuesPasswordCredentials := []basetypes.ObjectValue{}
+		tfStateElementsPasswordCredentials := tfStateServicePrincipal.PasswordCredentials.Elements()
+		for n, i := range tfPlanServicePrincipal.PasswordCredentials.Elements() {
 			requestBodyPasswordCredential := models.NewPasswordCredential()
-			tfPlanPasswordCredential := servicePrincipalPasswordCredentialModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanPasswordCredential)
-			tfStatePasswordCredential := servicePrincipalPasswordCredentialModel{}
-			types.ListValueFrom(ctx, tfStateServicePrincipal.PasswordCredentials.Elements()[k].Type(ctx), &tfPlanPasswordCredential)
+			tfPlanPasswordCredential := sharedmodels.PasswordCredentialModel{}
+			i.(types.Object).As(ctx, &tfPlanPasswordCredential, basetypes.ObjectAsOptions{})
+			tfStatePasswordCredential := sharedmodels.PasswordCredentialModel{}
+			if n < len(tfStateElementsPasswordCredentials) {
+				tfStateElementsPasswordCredentials[n].(types.Object).As(ctx, &tfStatePasswordCredential, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanPasswordCredential.CustomKeyIdentifier.Equal(tfStatePasswordCredential.CustomKeyIdentifier) {
+			if tfPlanPasswordCredential.CustomKeyIdentifier.IsUnknown() {
+				tfPlanPasswordCredential.CustomKeyIdentifier = tfStatePasswordCredential.CustomKeyIdentifier
+			}
+			if !tfPlanPasswordCredential.CustomKeyIdentifier.IsNull() {
 				tfPlanCustomKeyIdentifier := tfPlanPasswordCredential.CustomKeyIdentifier.ValueString()
 				requestBodyPasswordCredential.SetCustomKeyIdentifier([]byte(tfPlanCustomKeyIdentifier))
 			}
 
-			if !tfPlanPasswordCredential.DisplayName.Equal(tfStatePasswordCredential.DisplayName) {
+			if tfPlanPasswordCredential.DisplayName.IsUnknown() {
+				tfPlanPasswordCredential.DisplayName = tfStatePasswordCredential.DisplayName
+			}
+			if !tfPlanPasswordCredential.DisplayName.IsNull() {
 				tfPlanDisplayName := tfPlanPasswordCredential.DisplayName.ValueString()
 				requestBodyPasswordCredential.SetDisplayName(&tfPlanDisplayName)
 			}
 
-			if !tfPlanPasswordCredential.EndDateTime.Equal(tfStatePasswordCredential.EndDateTime) {
+			if tfPlanPasswordCredential.EndDateTime.IsUnknown() {
+				tfPlanPasswordCredential.EndDateTime = tfStatePasswordCredential.EndDateTime
+			}
+			if !tfPlanPasswordCredential.EndDateTime.IsNull() {
 				tfPlanEndDateTime := tfPlanPasswordCredential.EndDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanEndDateTime)
 				requestBodyPasswordCredential.SetEndDateTime(&t)
 			}
 
-			if !tfPlanPasswordCredential.Hint.Equal(tfStatePasswordCredential.Hint) {
+			if tfPlanPasswordCredential.Hint.IsUnknown() {
+				tfPlanPasswordCredential.Hint = tfStatePasswordCredential.Hint
+			}
+			if !tfPlanPasswordCredential.Hint.IsNull() {
 				tfPlanHint := tfPlanPasswordCredential.Hint.ValueString()
 				requestBodyPasswordCredential.SetHint(&tfPlanHint)
 			}
 
-			if !tfPlanPasswordCredential.KeyId.Equal(tfStatePasswordCredential.KeyId) {
+			if tfPlanPasswordCredential.KeyId.IsUnknown() {
+				tfPlanPasswordCredential.KeyId = tfStatePasswordCredential.KeyId
+			}
+			if !tfPlanPasswordCredential.KeyId.IsNull() {
 				tfPlanKeyId := tfPlanPasswordCredential.KeyId.ValueString()
 				u, _ := uuid.Parse(tfPlanKeyId)
 				requestBodyPasswordCredential.SetKeyId(&u)
 			}
 
-			if !tfPlanPasswordCredential.SecretText.Equal(tfStatePasswordCredential.SecretText) {
+			if tfPlanPasswordCredential.SecretText.IsUnknown() {
+				tfPlanPasswordCredential.SecretText = tfStatePasswordCredential.SecretText
+			}
+			if !tfPlanPasswordCredential.SecretText.IsNull() {
 				tfPlanSecretText := tfPlanPasswordCredential.SecretText.ValueString()
 				requestBodyPasswordCredential.SetSecretText(&tfPlanSecretText)
 			}
 
-			if !tfPlanPasswordCredential.StartDateTime.Equal(tfStatePasswordCredential.StartDateTime) {
+			if tfPlanPasswordCredential.StartDateTime.IsUnknown() {
+				tfPlanPasswordCredential.StartDateTime = tfStatePasswordCredential.StartDateTime
+			}
+			if !tfPlanPasswordCredential.StartDateTime.IsNull() {
 				tfPlanStartDateTime := tfPlanPasswordCredential.StartDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanStartDateTime)
 				requestBodyPasswordCredential.SetStartDateTime(&t)
 			}
+			objectArrayPasswordCredentials = append(objectArrayPasswordCredentials, requestBodyPasswordCredential)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanPasswordCredential.AttributeTypes(), tfPlanPasswordCredential)
+			objectValuesPasswordCredentials = append(objectValuesPasswordCredentials, objectValue)
 		}
-		requestBodyServicePrincipal.SetPasswordCredentials(tfPlanPasswordCredentials)
+		requestBodyServicePrincipal.SetPasswordCredentials(objectArrayPasswordCredentials)
+		tfPlanServicePrincipal.PasswordCredentials, _ = types.ListValueFrom(ctx, tfPlanServicePrincipal.PasswordCredentials.ElementType(ctx), objectValuesPasswordCredentials)
 	}
 
 	if !tfPlanServicePrincipal.PreferredSingleSignOnMode.Equal(tfStateServicePrincipal.PreferredSingleSignOnMode) {
@@ -2500,57 +2688,83 @@ func (r *servicePrincipalResource) Update(ctx context.Context, req resource.Upda
 	if !tfPlanServicePrincipal.ReplyUrls.Equal(tfStateServicePrincipal.ReplyUrls) {
 		var stringArrayReplyUrls []string
 		for _, i := range tfPlanServicePrincipal.ReplyUrls.Elements() {
-			stringArrayReplyUrls = append(stringArrayReplyUrls, i.String())
+			stringArrayReplyUrls = append(stringArrayReplyUrls, i.(types.String).ValueString())
 		}
 		requestBodyServicePrincipal.SetReplyUrls(stringArrayReplyUrls)
 	}
 
 	if !tfPlanServicePrincipal.ResourceSpecificApplicationPermissions.Equal(tfStateServicePrincipal.ResourceSpecificApplicationPermissions) {
-		var tfPlanResourceSpecificApplicationPermissions []models.ResourceSpecificPermissionable
-		for k, i := range tfPlanServicePrincipal.ResourceSpecificApplicationPermissions.Elements() {
+		var objectArrayResourceSpecificApplicationPermissions []models.ResourceSpecificPermissionable
+		objectValuesResourceSpecificApplicationPermissions := []basetypes.ObjectValue{}
+		tfStateElementsResourceSpecificApplicationPermissions := tfStateServicePrincipal.ResourceSpecificApplicationPermissions.Elements()
+		for n, i := range tfPlanServicePrincipal.ResourceSpecificApplicationPermissions.Elements() {
 			requestBodyResourceSpecificPermission := models.NewResourceSpecificPermission()
-			tfPlanResourceSpecificPermission := servicePrincipalResourceSpecificPermissionModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanResourceSpecificPermission)
-			tfStateResourceSpecificPermission := servicePrincipalResourceSpecificPermissionModel{}
-			types.ListValueFrom(ctx, tfStateServicePrincipal.ResourceSpecificApplicationPermissions.Elements()[k].Type(ctx), &tfPlanResourceSpecificPermission)
+			tfPlanResourceSpecificPermission := sharedmodels.ResourceSpecificPermissionModel{}
+			i.(types.Object).As(ctx, &tfPlanResourceSpecificPermission, basetypes.ObjectAsOptions{})
+			tfStateResourceSpecificPermission := sharedmodels.ResourceSpecificPermissionModel{}
+			if n < len(tfStateElementsResourceSpecificApplicationPermissions) {
+				tfStateElementsResourceSpecificApplicationPermissions[n].(types.Object).As(ctx, &tfStateResourceSpecificPermission, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanResourceSpecificPermission.Description.Equal(tfStateResourceSpecificPermission.Description) {
+			if tfPlanResourceSpecificPermission.Description.IsUnknown() {
+				tfPlanResourceSpecificPermission.Description = tfStateResourceSpecificPermission.Description
+			}
+			if !tfPlanResourceSpecificPermission.Description.IsNull() {
 				tfPlanDescription := tfPlanResourceSpecificPermission.Description.ValueString()
 				requestBodyResourceSpecificPermission.SetDescription(&tfPlanDescription)
 			}
 
-			if !tfPlanResourceSpecificPermission.DisplayName.Equal(tfStateResourceSpecificPermission.DisplayName) {
+			if tfPlanResourceSpecificPermission.DisplayName.IsUnknown() {
+				tfPlanResourceSpecificPermission.DisplayName = tfStateResourceSpecificPermission.DisplayName
+			}
+			if !tfPlanResourceSpecificPermission.DisplayName.IsNull() {
 				tfPlanDisplayName := tfPlanResourceSpecificPermission.DisplayName.ValueString()
 				requestBodyResourceSpecificPermission.SetDisplayName(&tfPlanDisplayName)
 			}
 
-			if !tfPlanResourceSpecificPermission.Id.Equal(tfStateResourceSpecificPermission.Id) {
+			if tfPlanResourceSpecificPermission.Id.IsUnknown() {
+				tfPlanResourceSpecificPermission.Id = tfStateResourceSpecificPermission.Id
+			}
+			if !tfPlanResourceSpecificPermission.Id.IsNull() {
 				tfPlanId := tfPlanResourceSpecificPermission.Id.ValueString()
 				u, _ := uuid.Parse(tfPlanId)
 				requestBodyResourceSpecificPermission.SetId(&u)
 			}
 
-			if !tfPlanResourceSpecificPermission.IsEnabled.Equal(tfStateResourceSpecificPermission.IsEnabled) {
+			if tfPlanResourceSpecificPermission.IsEnabled.IsUnknown() {
+				tfPlanResourceSpecificPermission.IsEnabled = tfStateResourceSpecificPermission.IsEnabled
+			}
+			if !tfPlanResourceSpecificPermission.IsEnabled.IsNull() {
 				tfPlanIsEnabled := tfPlanResourceSpecificPermission.IsEnabled.ValueBool()
 				requestBodyResourceSpecificPermission.SetIsEnabled(&tfPlanIsEnabled)
 			}
 
-			if !tfPlanResourceSpecificPermission.Value.Equal(tfStateResourceSpecificPermission.Value) {
+			if tfPlanResourceSpecificPermission.Value.IsUnknown() {
+				tfPlanResourceSpecificPermission.Value = tfStateResourceSpecificPermission.Value
+			}
+			if !tfPlanResourceSpecificPermission.Value.IsNull() {
 				tfPlanValue := tfPlanResourceSpecificPermission.Value.ValueString()
 				requestBodyResourceSpecificPermission.SetValue(&tfPlanValue)
 			}
+			objectArrayResourceSpecificApplicationPermissions = append(objectArrayResourceSpecificApplicationPermissions, requestBodyResourceSpecificPermission)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanResourceSpecificPermission.AttributeTypes(), tfPlanResourceSpecificPermission)
+			objectValuesResourceSpecificApplicationPermissions = append(objectValuesResourceSpecificApplicationPermissions, objectValue)
 		}
-		requestBodyServicePrincipal.SetResourceSpecificApplicationPermissions(tfPlanResourceSpecificApplicationPermissions)
+		requestBodyServicePrincipal.SetResourceSpecificApplicationPermissions(objectArrayResourceSpecificApplicationPermissions)
+		tfPlanServicePrincipal.ResourceSpecificApplicationPermissions, _ = types.ListValueFrom(ctx, tfPlanServicePrincipal.ResourceSpecificApplicationPermissions.ElementType(ctx), objectValuesResourceSpecificApplicationPermissions)
 	}
 
 	if !tfPlanServicePrincipal.SamlSingleSignOnSettings.Equal(tfStateServicePrincipal.SamlSingleSignOnSettings) {
 		requestBodySamlSingleSignOnSettings := models.NewSamlSingleSignOnSettings()
-		tfPlanSamlSingleSignOnSettings := servicePrincipalSamlSingleSignOnSettingsModel{}
+		tfPlanSamlSingleSignOnSettings := sharedmodels.SamlSingleSignOnSettingsModel{}
 		tfPlanServicePrincipal.SamlSingleSignOnSettings.As(ctx, &tfPlanSamlSingleSignOnSettings, basetypes.ObjectAsOptions{})
-		tfStateSamlSingleSignOnSettings := servicePrincipalSamlSingleSignOnSettingsModel{}
+		tfStateSamlSingleSignOnSettings := sharedmodels.SamlSingleSignOnSettingsModel{}
 		tfStateServicePrincipal.SamlSingleSignOnSettings.As(ctx, &tfStateSamlSingleSignOnSettings, basetypes.ObjectAsOptions{})
 
-		if !tfPlanSamlSingleSignOnSettings.RelayState.Equal(tfStateSamlSingleSignOnSettings.RelayState) {
+		if tfPlanSamlSingleSignOnSettings.RelayState.IsUnknown() {
+			tfPlanSamlSingleSignOnSettings.RelayState = tfStateSamlSingleSignOnSettings.RelayState
+		}
+		if !tfPlanSamlSingleSignOnSettings.RelayState.IsNull() {
 			tfPlanRelayState := tfPlanSamlSingleSignOnSettings.RelayState.ValueString()
 			requestBodySamlSingleSignOnSettings.SetRelayState(&tfPlanRelayState)
 		}
@@ -2561,7 +2775,7 @@ func (r *servicePrincipalResource) Update(ctx context.Context, req resource.Upda
 	if !tfPlanServicePrincipal.ServicePrincipalNames.Equal(tfStateServicePrincipal.ServicePrincipalNames) {
 		var stringArrayServicePrincipalNames []string
 		for _, i := range tfPlanServicePrincipal.ServicePrincipalNames.Elements() {
-			stringArrayServicePrincipalNames = append(stringArrayServicePrincipalNames, i.String())
+			stringArrayServicePrincipalNames = append(stringArrayServicePrincipalNames, i.(types.String).ValueString())
 		}
 		requestBodyServicePrincipal.SetServicePrincipalNames(stringArrayServicePrincipalNames)
 	}
@@ -2579,7 +2793,7 @@ func (r *servicePrincipalResource) Update(ctx context.Context, req resource.Upda
 	if !tfPlanServicePrincipal.Tags.Equal(tfStateServicePrincipal.Tags) {
 		var stringArrayTags []string
 		for _, i := range tfPlanServicePrincipal.Tags.Elements() {
-			stringArrayTags = append(stringArrayTags, i.String())
+			stringArrayTags = append(stringArrayTags, i.(types.String).ValueString())
 		}
 		requestBodyServicePrincipal.SetTags(stringArrayTags)
 	}
@@ -2592,23 +2806,32 @@ func (r *servicePrincipalResource) Update(ctx context.Context, req resource.Upda
 
 	if !tfPlanServicePrincipal.VerifiedPublisher.Equal(tfStateServicePrincipal.VerifiedPublisher) {
 		requestBodyVerifiedPublisher := models.NewVerifiedPublisher()
-		tfPlanVerifiedPublisher := servicePrincipalVerifiedPublisherModel{}
+		tfPlanVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		tfPlanServicePrincipal.VerifiedPublisher.As(ctx, &tfPlanVerifiedPublisher, basetypes.ObjectAsOptions{})
-		tfStateVerifiedPublisher := servicePrincipalVerifiedPublisherModel{}
+		tfStateVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 		tfStateServicePrincipal.VerifiedPublisher.As(ctx, &tfStateVerifiedPublisher, basetypes.ObjectAsOptions{})
 
-		if !tfPlanVerifiedPublisher.AddedDateTime.Equal(tfStateVerifiedPublisher.AddedDateTime) {
+		if tfPlanVerifiedPublisher.AddedDateTime.IsUnknown() {
+			tfPlanVerifiedPublisher.AddedDateTime = tfStateVerifiedPublisher.AddedDateTime
+		}
+		if !tfPlanVerifiedPublisher.AddedDateTime.IsNull() {
 			tfPlanAddedDateTime := tfPlanVerifiedPublisher.AddedDateTime.ValueString()
 			t, _ := time.Parse(time.RFC3339, tfPlanAddedDateTime)
 			requestBodyVerifiedPublisher.SetAddedDateTime(&t)
 		}
 
-		if !tfPlanVerifiedPublisher.DisplayName.Equal(tfStateVerifiedPublisher.DisplayName) {
+		if tfPlanVerifiedPublisher.DisplayName.IsUnknown() {
+			tfPlanVerifiedPublisher.DisplayName = tfStateVerifiedPublisher.DisplayName
+		}
+		if !tfPlanVerifiedPublisher.DisplayName.IsNull() {
 			tfPlanDisplayName := tfPlanVerifiedPublisher.DisplayName.ValueString()
 			requestBodyVerifiedPublisher.SetDisplayName(&tfPlanDisplayName)
 		}
 
-		if !tfPlanVerifiedPublisher.VerifiedPublisherId.Equal(tfStateVerifiedPublisher.VerifiedPublisherId) {
+		if tfPlanVerifiedPublisher.VerifiedPublisherId.IsUnknown() {
+			tfPlanVerifiedPublisher.VerifiedPublisherId = tfStateVerifiedPublisher.VerifiedPublisherId
+		}
+		if !tfPlanVerifiedPublisher.VerifiedPublisherId.IsNull() {
 			tfPlanVerifiedPublisherId := tfPlanVerifiedPublisher.VerifiedPublisherId.ValueString()
 			requestBodyVerifiedPublisher.SetVerifiedPublisherId(&tfPlanVerifiedPublisherId)
 		}
@@ -2645,8 +2868,7 @@ func (r *servicePrincipalResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	// TODO: Delete servicePrincipal
-	err := r.client.ServicePrincipals().ByServicePrincipalId(tfStateServicePrincipal.Id.ValueString()).Delete(context.Background(), nil)
+	err := r.client.ServicePrincipals().ByServicePrincipalId(tfStateServicePrincipal.Id.ValueString()).Delete(ctx, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting service_principal",
@@ -2658,5 +2880,166 @@ func (r *servicePrincipalResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *servicePrincipalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Besides its ID, the object can be imported by 'appId:<app_id>', or 'displayName:<display_name>', which is looked up to find its ID
+	var filter string
+	prefix, value, _ := strings.Cut(req.ID, ":")
+	switch prefix {
+	case "appId":
+		filter = fmt.Sprintf("appId eq '%s'", strings.ReplaceAll(value, "'", "''"))
+	case "displayName":
+		filter = fmt.Sprintf("displayName eq '%s'", strings.ReplaceAll(value, "'", "''"))
+	default:
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	qparams := serviceprincipals.ServicePrincipalsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &serviceprincipals.ServicePrincipalsRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Select: []string{"id"},
+		},
+	}
+	result, err := r.client.ServicePrincipals().Get(ctx, &qparams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing service_principal",
+			err.Error(),
+		)
+		return
+	}
+	if len(result.GetValue()) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier %q to match a single service_principal. Matched %d", req.ID, len(result.GetValue())),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *result.GetValue()[0].GetId())...)
+}
+
+// MoveState moves the state of resources of other providers to the resource, with a 'moved' block.
+// Only some of their attributes are moved. The others are set when the resource is next read,
+// as are empty strings and lists, which other providers store for optional attributes that aren't set
+func (r *servicePrincipalResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "azuread_service_principal" || !strings.HasSuffix(req.SourceProviderAddress, "/hashicorp/azuread") {
+					return
+				}
+
+				var source map[string]any
+				if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving state of azuread_service_principal",
+						err.Error(),
+					)
+					return
+				}
+				if v, ok := source["account_enabled"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("account_enabled"), v)...)
+				}
+				if v, ok := source["alternative_names"].([]any); ok && len(v) > 0 {
+					var values []string
+					for _, i := range v {
+						if s, ok := i.(string); ok {
+							values = append(values, s)
+						}
+					}
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("alternative_names"), values)...)
+				}
+				if v, ok := source["app_role_assignment_required"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("app_role_assignment_required"), v)...)
+				}
+				if v, ok := source["application_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("app_id"), v)...)
+				}
+				if v, ok := source["client_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("app_id"), v)...)
+				}
+				if v, ok := source["description"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("description"), v)...)
+				}
+				if v, ok := source["login_url"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("login_url"), v)...)
+				}
+				if v, ok := source["notes"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("notes"), v)...)
+				}
+				if v, ok := source["notification_email_addresses"].([]any); ok && len(v) > 0 {
+					var values []string
+					for _, i := range v {
+						if s, ok := i.(string); ok {
+							values = append(values, s)
+						}
+					}
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("notification_email_addresses"), values)...)
+				}
+				if v, ok := source["object_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), v)...)
+				}
+				if v, ok := source["preferred_single_sign_on_mode"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("preferred_single_sign_on_mode"), v)...)
+				}
+				if v, ok := source["tags"].([]any); ok && len(v) > 0 {
+					var values []string
+					for _, i := range v {
+						if s, ok := i.(string); ok {
+							values = append(values, s)
+						}
+					}
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("tags"), values)...)
+				}
+			},
+		},
+	}
+}
+
+// ListIds returns the IDs of the objects matching filter, an OData $filter expression, or of every object when it's empty.
+// It's used to export the existing objects of a tenant
+func (r *servicePrincipalResource) ListIds(ctx context.Context, filter string) ([]string, error) {
+
+	qparams := serviceprincipals.ServicePrincipalsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &serviceprincipals.ServicePrincipalsRequestBuilderGetQueryParameters{
+			Select: []string{"id"},
+		},
+	}
+	if filter != "" {
+		qparams.QueryParameters.Filter = &filter
+	}
+
+	result, err := r.client.ServicePrincipals().Get(ctx, &qparams)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for {
+		for _, object := range result.GetValue() {
+			ids = append(ids, *object.GetId())
+		}
+		nextLink := result.GetOdataNextLink()
+		if nextLink == nil {
+			return ids, nil
+		}
+		result, err = r.client.ServicePrincipals().WithUrl(*nextLink).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+}
+
+// ReadOnlyAttributes returns the paths of the attributes the API sets, which exports leave out of the configuration they write
+func (r *servicePrincipalResource) ReadOnlyAttributes() []string {
+	return []string{
+		"app_roles.origin",
+		"application_template_id",
+		"id",
+		"info.logo_url",
+		"password_credentials.hint",
+		"password_credentials.secret_text",
+		"resource_specific_application_permissions",
+		"sign_in_audience",
+	}
 }
@@ -0,0 +1,60 @@
+package serviceprincipals_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+const servicePrincipalResourceConfig = `
+resource "msgraph_application" "example" {
+  display_name = "Example"
+}
+
+resource "msgraph_service_principal" "test" {
+  app_id = msgraph_application.example.app_id
+}
+`
+
+func TestAccServicePrincipalResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: servicePrincipalResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("msgraph_service_principal.test", "id"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "msgraph_service_principal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import by app_id
+			{
+				ResourceName:      "msgraph_service_principal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "appId:" + s.RootModule().Resources["msgraph_service_principal.test"].Primary.Attributes["app_id"], nil
+				},
+			},
+			// Import by display_name
+			{
+				ResourceName:      "msgraph_service_principal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "displayName:" + s.RootModule().Resources["msgraph_service_principal.test"].Primary.Attributes["display_name"], nil
+				},
+			},
+			// Delete is tested once the last step is done
+		},
+	})
+}
@@ -10,6 +10,9 @@ import (
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/serviceprincipals"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,12 +37,12 @@ func (d *servicePrincipalsDataSource) Metadata(_ context.Context, req datasource
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *servicePrincipalsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *servicePrincipalsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -478,7 +481,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 			if len(responseServicePrincipal.GetAddIns()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseAddIn := range responseServicePrincipal.GetAddIns() {
-					tfStateAddIn := servicePrincipalsAddInModel{}
+					tfStateAddIn := sharedmodels.AddInModel{}
 
 					if responseAddIn.GetId() != nil {
 						tfStateAddIn.Id = types.StringValue(responseAddIn.GetId().String())
@@ -488,7 +491,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 					if len(responseAddIn.GetProperties()) > 0 {
 						objectValues := []basetypes.ObjectValue{}
 						for _, responseKeyValue := range responseAddIn.GetProperties() {
-							tfStateKeyValue := servicePrincipalsKeyValueModel{}
+							tfStateKeyValue := sharedmodels.KeyValueModel{}
 
 							if responseKeyValue.GetKey() != nil {
 								tfStateKeyValue.Key = types.StringValue(*responseKeyValue.GetKey())
@@ -553,7 +556,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 			if len(responseServicePrincipal.GetAppRoles()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseAppRole := range responseServicePrincipal.GetAppRoles() {
-					tfStateAppRole := servicePrincipalsAppRoleModel{}
+					tfStateAppRole := sharedmodels.AppRoleModel{}
 
 					if len(responseAppRole.GetAllowedMemberTypes()) > 0 {
 						var valueArrayAllowedMemberTypes []attr.Value
@@ -636,7 +639,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 				tfStateServicePrincipal.Id = types.StringNull()
 			}
 			if responseServicePrincipal.GetInfo() != nil {
-				tfStateInformationalUrl := servicePrincipalsInformationalUrlModel{}
+				tfStateInformationalUrl := sharedmodels.InformationalUrlModel{}
 				responseInformationalUrl := responseServicePrincipal.GetInfo()
 
 				if responseInformationalUrl.GetLogoUrl() != nil {
@@ -670,7 +673,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 			if len(responseServicePrincipal.GetKeyCredentials()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseKeyCredential := range responseServicePrincipal.GetKeyCredentials() {
-					tfStateKeyCredential := servicePrincipalsKeyCredentialModel{}
+					tfStateKeyCredential := sharedmodels.KeyCredentialModel{}
 
 					if responseKeyCredential.GetCustomKeyIdentifier() != nil {
 						tfStateKeyCredential.CustomKeyIdentifier = types.StringValue(string(responseKeyCredential.GetCustomKeyIdentifier()[:]))
@@ -745,7 +748,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 			if len(responseServicePrincipal.GetOauth2PermissionScopes()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responsePermissionScope := range responseServicePrincipal.GetOauth2PermissionScopes() {
-					tfStatePermissionScope := servicePrincipalsPermissionScopeModel{}
+					tfStatePermissionScope := sharedmodels.PermissionScopeModel{}
 
 					if responsePermissionScope.GetAdminConsentDescription() != nil {
 						tfStatePermissionScope.AdminConsentDescription = types.StringValue(*responsePermissionScope.GetAdminConsentDescription())
@@ -800,7 +803,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 			if len(responseServicePrincipal.GetPasswordCredentials()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responsePasswordCredential := range responseServicePrincipal.GetPasswordCredentials() {
-					tfStatePasswordCredential := servicePrincipalsPasswordCredentialModel{}
+					tfStatePasswordCredential := sharedmodels.PasswordCredentialModel{}
 
 					if responsePasswordCredential.GetCustomKeyIdentifier() != nil {
 						tfStatePasswordCredential.CustomKeyIdentifier = types.StringValue(string(responsePasswordCredential.GetCustomKeyIdentifier()[:]))
@@ -865,7 +868,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 			if len(responseServicePrincipal.GetResourceSpecificApplicationPermissions()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseResourceSpecificPermission := range responseServicePrincipal.GetResourceSpecificApplicationPermissions() {
-					tfStateResourceSpecificPermission := servicePrincipalsResourceSpecificPermissionModel{}
+					tfStateResourceSpecificPermission := sharedmodels.ResourceSpecificPermissionModel{}
 
 					if responseResourceSpecificPermission.GetDescription() != nil {
 						tfStateResourceSpecificPermission.Description = types.StringValue(*responseResourceSpecificPermission.GetDescription())
@@ -898,7 +901,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 				tfStateServicePrincipal.ResourceSpecificApplicationPermissions, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 			}
 			if responseServicePrincipal.GetSamlSingleSignOnSettings() != nil {
-				tfStateSamlSingleSignOnSettings := servicePrincipalsSamlSingleSignOnSettingsModel{}
+				tfStateSamlSingleSignOnSettings := sharedmodels.SamlSingleSignOnSettingsModel{}
 				responseSamlSingleSignOnSettings := responseServicePrincipal.GetSamlSingleSignOnSettings()
 
 				if responseSamlSingleSignOnSettings.GetRelayState() != nil {
@@ -945,7 +948,7 @@ func (d *servicePrincipalsDataSource) Read(ctx context.Context, req datasource.R
 				tfStateServicePrincipal.TokenEncryptionKeyId = types.StringNull()
 			}
 			if responseServicePrincipal.GetVerifiedPublisher() != nil {
-				tfStateVerifiedPublisher := servicePrincipalsVerifiedPublisherModel{}
+				tfStateVerifiedPublisher := sharedmodels.VerifiedPublisherModel{}
 				responseVerifiedPublisher := responseServicePrincipal.GetVerifiedPublisher()
 
 				if responseVerifiedPublisher.GetAddedDateTime() != nil {
@@ -0,0 +1,27 @@
+package serviceprincipals_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+func TestAccServicePrincipalsDataSource(t *testing.T) {
+	config := `
+data "msgraph_service_principals" "test" {}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.msgraph_service_principals.test", "value.#"),
+				),
+			},
+		},
+	})
+}
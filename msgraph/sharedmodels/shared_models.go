@@ -0,0 +1,773 @@
+// Models of nested objects that are used identically by more than one resource or data source.
+package sharedmodels
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AddInModel struct {
+	Id         types.String `tfsdk:"id"`
+	Properties types.List   `tfsdk:"properties"`
+	Type       types.String `tfsdk:"type"`
+}
+
+func (m AddInModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"properties": types.ListType{ElemType: types.ObjectType{AttrTypes: KeyValueModel{}.AttributeTypes()}},
+		"type":       types.StringType,
+	}
+}
+
+type AlternativeSecurityIdModel struct {
+	IdentityProvider types.String `tfsdk:"identity_provider"`
+	Key              types.String `tfsdk:"key"`
+	Type             types.Int64  `tfsdk:"type"`
+}
+
+func (m AlternativeSecurityIdModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"identity_provider": types.StringType,
+		"key":               types.StringType,
+		"type":              types.Int64Type,
+	}
+}
+
+type ApiApplicationModel struct {
+	AcceptMappedClaims          types.Bool  `tfsdk:"accept_mapped_claims"`
+	KnownClientApplications     types.List  `tfsdk:"known_client_applications"`
+	Oauth2PermissionScopes      types.List  `tfsdk:"oauth_2_permission_scopes"`
+	PreAuthorizedApplications   types.List  `tfsdk:"pre_authorized_applications"`
+	RequestedAccessTokenVersion types.Int64 `tfsdk:"requested_access_token_version"`
+}
+
+func (m ApiApplicationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"accept_mapped_claims":           types.BoolType,
+		"known_client_applications":      types.ListType{ElemType: types.StringType},
+		"oauth_2_permission_scopes":      types.ListType{ElemType: types.ObjectType{AttrTypes: PermissionScopeModel{}.AttributeTypes()}},
+		"pre_authorized_applications":    types.ListType{ElemType: types.ObjectType{AttrTypes: PreAuthorizedApplicationModel{}.AttributeTypes()}},
+		"requested_access_token_version": types.Int64Type,
+	}
+}
+
+type AppRoleModel struct {
+	AllowedMemberTypes types.List   `tfsdk:"allowed_member_types"`
+	Description        types.String `tfsdk:"description"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	Id                 types.String `tfsdk:"id"`
+	IsEnabled          types.Bool   `tfsdk:"is_enabled"`
+	Origin             types.String `tfsdk:"origin"`
+	Value              types.String `tfsdk:"value"`
+}
+
+func (m AppRoleModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"allowed_member_types": types.ListType{ElemType: types.StringType},
+		"description":          types.StringType,
+		"display_name":         types.StringType,
+		"id":                   types.StringType,
+		"is_enabled":           types.BoolType,
+		"origin":               types.StringType,
+		"value":                types.StringType,
+	}
+}
+
+type AssignedLabelModel struct {
+	DisplayName types.String `tfsdk:"display_name"`
+	LabelId     types.String `tfsdk:"label_id"`
+}
+
+func (m AssignedLabelModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"display_name": types.StringType,
+		"label_id":     types.StringType,
+	}
+}
+
+type AssignedLicenseModel struct {
+	DisabledPlans types.List   `tfsdk:"disabled_plans"`
+	SkuId         types.String `tfsdk:"sku_id"`
+}
+
+func (m AssignedLicenseModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"disabled_plans": types.ListType{ElemType: types.StringType},
+		"sku_id":         types.StringType,
+	}
+}
+
+type AssignedPlanModel struct {
+	AssignedDateTime types.String `tfsdk:"assigned_date_time"`
+	CapabilityStatus types.String `tfsdk:"capability_status"`
+	Service          types.String `tfsdk:"service"`
+	ServicePlanId    types.String `tfsdk:"service_plan_id"`
+}
+
+func (m AssignedPlanModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"assigned_date_time": types.StringType,
+		"capability_status":  types.StringType,
+		"service":            types.StringType,
+		"service_plan_id":    types.StringType,
+	}
+}
+
+type AuthorizationInfoModel struct {
+	CertificateUserIds types.List `tfsdk:"certificate_user_ids"`
+}
+
+func (m AuthorizationInfoModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"certificate_user_ids": types.ListType{ElemType: types.StringType},
+	}
+}
+
+type CertificationModel struct {
+	CertificationDetailsUrl         types.String `tfsdk:"certification_details_url"`
+	CertificationExpirationDateTime types.String `tfsdk:"certification_expiration_date_time"`
+	IsCertifiedByMicrosoft          types.Bool   `tfsdk:"is_certified_by_microsoft"`
+	IsPublisherAttested             types.Bool   `tfsdk:"is_publisher_attested"`
+	LastCertificationDateTime       types.String `tfsdk:"last_certification_date_time"`
+}
+
+func (m CertificationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"certification_details_url":          types.StringType,
+		"certification_expiration_date_time": types.StringType,
+		"is_certified_by_microsoft":          types.BoolType,
+		"is_publisher_attested":              types.BoolType,
+		"last_certification_date_time":       types.StringType,
+	}
+}
+
+type EmployeeOrgDataModel struct {
+	CostCenter types.String `tfsdk:"cost_center"`
+	Division   types.String `tfsdk:"division"`
+}
+
+func (m EmployeeOrgDataModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cost_center": types.StringType,
+		"division":    types.StringType,
+	}
+}
+
+type IdentityModel struct {
+	DisplayName types.String `tfsdk:"display_name"`
+	Id          types.String `tfsdk:"id"`
+}
+
+func (m IdentityModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"display_name": types.StringType,
+		"id":           types.StringType,
+	}
+}
+
+type IdentitySetModel struct {
+	Application types.Object `tfsdk:"application"`
+	Device      types.Object `tfsdk:"device"`
+	User        types.Object `tfsdk:"user"`
+}
+
+func (m IdentitySetModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"application": types.ObjectType{AttrTypes: IdentityModel{}.AttributeTypes()},
+		"device":      types.ObjectType{AttrTypes: IdentityModel{}.AttributeTypes()},
+		"user":        types.ObjectType{AttrTypes: IdentityModel{}.AttributeTypes()},
+	}
+}
+
+type ImplicitGrantSettingsModel struct {
+	EnableAccessTokenIssuance types.Bool `tfsdk:"enable_access_token_issuance"`
+	EnableIdTokenIssuance     types.Bool `tfsdk:"enable_id_token_issuance"`
+}
+
+func (m ImplicitGrantSettingsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enable_access_token_issuance": types.BoolType,
+		"enable_id_token_issuance":     types.BoolType,
+	}
+}
+
+type InformationalUrlModel struct {
+	LogoUrl             types.String `tfsdk:"logo_url"`
+	MarketingUrl        types.String `tfsdk:"marketing_url"`
+	PrivacyStatementUrl types.String `tfsdk:"privacy_statement_url"`
+	SupportUrl          types.String `tfsdk:"support_url"`
+	TermsOfServiceUrl   types.String `tfsdk:"terms_of_service_url"`
+}
+
+func (m InformationalUrlModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"logo_url":              types.StringType,
+		"marketing_url":         types.StringType,
+		"privacy_statement_url": types.StringType,
+		"support_url":           types.StringType,
+		"terms_of_service_url":  types.StringType,
+	}
+}
+
+type ItemReferenceModel struct {
+	DriveId       types.String `tfsdk:"drive_id"`
+	DriveType     types.String `tfsdk:"drive_type"`
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Path          types.String `tfsdk:"path"`
+	ShareId       types.String `tfsdk:"share_id"`
+	SharepointIds types.Object `tfsdk:"sharepoint_ids"`
+	SiteId        types.String `tfsdk:"site_id"`
+}
+
+func (m ItemReferenceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"drive_id":       types.StringType,
+		"drive_type":     types.StringType,
+		"id":             types.StringType,
+		"name":           types.StringType,
+		"path":           types.StringType,
+		"share_id":       types.StringType,
+		"sharepoint_ids": types.ObjectType{AttrTypes: SharepointIdsModel{}.AttributeTypes()},
+		"site_id":        types.StringType,
+	}
+}
+
+type KeyCredentialModel struct {
+	CustomKeyIdentifier types.String `tfsdk:"custom_key_identifier"`
+	DisplayName         types.String `tfsdk:"display_name"`
+	EndDateTime         types.String `tfsdk:"end_date_time"`
+	Key                 types.String `tfsdk:"key"`
+	KeyId               types.String `tfsdk:"key_id"`
+	StartDateTime       types.String `tfsdk:"start_date_time"`
+	Type                types.String `tfsdk:"type"`
+	Usage               types.String `tfsdk:"usage"`
+}
+
+func (m KeyCredentialModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"custom_key_identifier": types.StringType,
+		"display_name":          types.StringType,
+		"end_date_time":         types.StringType,
+		"key":                   types.StringType,
+		"key_id":                types.StringType,
+		"start_date_time":       types.StringType,
+		"type":                  types.StringType,
+		"usage":                 types.StringType,
+	}
+}
+
+type KeyValueModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (m KeyValueModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":   types.StringType,
+		"value": types.StringType,
+	}
+}
+
+type LicenseAssignmentStateModel struct {
+	AssignedByGroup     types.String `tfsdk:"assigned_by_group"`
+	DisabledPlans       types.List   `tfsdk:"disabled_plans"`
+	Error               types.String `tfsdk:"error"`
+	LastUpdatedDateTime types.String `tfsdk:"last_updated_date_time"`
+	SkuId               types.String `tfsdk:"sku_id"`
+	State               types.String `tfsdk:"state"`
+}
+
+func (m LicenseAssignmentStateModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"assigned_by_group":      types.StringType,
+		"disabled_plans":         types.ListType{ElemType: types.StringType},
+		"error":                  types.StringType,
+		"last_updated_date_time": types.StringType,
+		"sku_id":                 types.StringType,
+		"state":                  types.StringType,
+	}
+}
+
+type LicenseProcessingStateModel struct {
+	State types.String `tfsdk:"state"`
+}
+
+func (m LicenseProcessingStateModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"state": types.StringType,
+	}
+}
+
+type ObjectIdentityModel struct {
+	Issuer           types.String `tfsdk:"issuer"`
+	IssuerAssignedId types.String `tfsdk:"issuer_assigned_id"`
+	SignInType       types.String `tfsdk:"sign_in_type"`
+}
+
+func (m ObjectIdentityModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"issuer":             types.StringType,
+		"issuer_assigned_id": types.StringType,
+		"sign_in_type":       types.StringType,
+	}
+}
+
+type OnPremisesExtensionAttributesModel struct {
+	ExtensionAttribute1  types.String `tfsdk:"extension_attribute_1"`
+	ExtensionAttribute10 types.String `tfsdk:"extension_attribute_10"`
+	ExtensionAttribute11 types.String `tfsdk:"extension_attribute_11"`
+	ExtensionAttribute12 types.String `tfsdk:"extension_attribute_12"`
+	ExtensionAttribute13 types.String `tfsdk:"extension_attribute_13"`
+	ExtensionAttribute14 types.String `tfsdk:"extension_attribute_14"`
+	ExtensionAttribute15 types.String `tfsdk:"extension_attribute_15"`
+	ExtensionAttribute2  types.String `tfsdk:"extension_attribute_2"`
+	ExtensionAttribute3  types.String `tfsdk:"extension_attribute_3"`
+	ExtensionAttribute4  types.String `tfsdk:"extension_attribute_4"`
+	ExtensionAttribute5  types.String `tfsdk:"extension_attribute_5"`
+	ExtensionAttribute6  types.String `tfsdk:"extension_attribute_6"`
+	ExtensionAttribute7  types.String `tfsdk:"extension_attribute_7"`
+	ExtensionAttribute8  types.String `tfsdk:"extension_attribute_8"`
+	ExtensionAttribute9  types.String `tfsdk:"extension_attribute_9"`
+}
+
+func (m OnPremisesExtensionAttributesModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"extension_attribute_1":  types.StringType,
+		"extension_attribute_10": types.StringType,
+		"extension_attribute_11": types.StringType,
+		"extension_attribute_12": types.StringType,
+		"extension_attribute_13": types.StringType,
+		"extension_attribute_14": types.StringType,
+		"extension_attribute_15": types.StringType,
+		"extension_attribute_2":  types.StringType,
+		"extension_attribute_3":  types.StringType,
+		"extension_attribute_4":  types.StringType,
+		"extension_attribute_5":  types.StringType,
+		"extension_attribute_6":  types.StringType,
+		"extension_attribute_7":  types.StringType,
+		"extension_attribute_8":  types.StringType,
+		"extension_attribute_9":  types.StringType,
+	}
+}
+
+type OnPremisesProvisioningErrorModel struct {
+	Category             types.String `tfsdk:"category"`
+	OccurredDateTime     types.String `tfsdk:"occurred_date_time"`
+	PropertyCausingError types.String `tfsdk:"property_causing_error"`
+	Value                types.String `tfsdk:"value"`
+}
+
+func (m OnPremisesProvisioningErrorModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"category":               types.StringType,
+		"occurred_date_time":     types.StringType,
+		"property_causing_error": types.StringType,
+		"value":                  types.StringType,
+	}
+}
+
+type OptionalClaimModel struct {
+	AdditionalProperties types.List   `tfsdk:"additional_properties"`
+	Essential            types.Bool   `tfsdk:"essential"`
+	Name                 types.String `tfsdk:"name"`
+	Source               types.String `tfsdk:"source"`
+}
+
+func (m OptionalClaimModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"additional_properties": types.ListType{ElemType: types.StringType},
+		"essential":             types.BoolType,
+		"name":                  types.StringType,
+		"source":                types.StringType,
+	}
+}
+
+type OptionalClaimsModel struct {
+	AccessToken types.List `tfsdk:"access_token"`
+	IdToken     types.List `tfsdk:"id_token"`
+	Saml2Token  types.List `tfsdk:"saml_2_token"`
+}
+
+func (m OptionalClaimsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"access_token": types.ListType{ElemType: types.ObjectType{AttrTypes: OptionalClaimModel{}.AttributeTypes()}},
+		"id_token":     types.ListType{ElemType: types.ObjectType{AttrTypes: OptionalClaimModel{}.AttributeTypes()}},
+		"saml_2_token": types.ListType{ElemType: types.ObjectType{AttrTypes: OptionalClaimModel{}.AttributeTypes()}},
+	}
+}
+
+type ParentalControlSettingsModel struct {
+	CountriesBlockedForMinors types.List   `tfsdk:"countries_blocked_for_minors"`
+	LegalAgeGroupRule         types.String `tfsdk:"legal_age_group_rule"`
+}
+
+func (m ParentalControlSettingsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"countries_blocked_for_minors": types.ListType{ElemType: types.StringType},
+		"legal_age_group_rule":         types.StringType,
+	}
+}
+
+type PasswordCredentialModel struct {
+	CustomKeyIdentifier types.String `tfsdk:"custom_key_identifier"`
+	DisplayName         types.String `tfsdk:"display_name"`
+	EndDateTime         types.String `tfsdk:"end_date_time"`
+	Hint                types.String `tfsdk:"hint"`
+	KeyId               types.String `tfsdk:"key_id"`
+	SecretText          types.String `tfsdk:"secret_text"`
+	StartDateTime       types.String `tfsdk:"start_date_time"`
+}
+
+func (m PasswordCredentialModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"custom_key_identifier": types.StringType,
+		"display_name":          types.StringType,
+		"end_date_time":         types.StringType,
+		"hint":                  types.StringType,
+		"key_id":                types.StringType,
+		"secret_text":           types.StringType,
+		"start_date_time":       types.StringType,
+	}
+}
+
+type PasswordProfileModel struct {
+	ForceChangePasswordNextSignIn        types.Bool   `tfsdk:"force_change_password_next_sign_in"`
+	ForceChangePasswordNextSignInWithMfa types.Bool   `tfsdk:"force_change_password_next_sign_in_with_mfa"`
+	Password                             types.String `tfsdk:"password"`
+}
+
+func (m PasswordProfileModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"force_change_password_next_sign_in":          types.BoolType,
+		"force_change_password_next_sign_in_with_mfa": types.BoolType,
+		"password": types.StringType,
+	}
+}
+
+type PermissionScopeModel struct {
+	AdminConsentDescription types.String `tfsdk:"admin_consent_description"`
+	AdminConsentDisplayName types.String `tfsdk:"admin_consent_display_name"`
+	Id                      types.String `tfsdk:"id"`
+	IsEnabled               types.Bool   `tfsdk:"is_enabled"`
+	Origin                  types.String `tfsdk:"origin"`
+	Type                    types.String `tfsdk:"type"`
+	UserConsentDescription  types.String `tfsdk:"user_consent_description"`
+	UserConsentDisplayName  types.String `tfsdk:"user_consent_display_name"`
+	Value                   types.String `tfsdk:"value"`
+}
+
+func (m PermissionScopeModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"admin_consent_description":  types.StringType,
+		"admin_consent_display_name": types.StringType,
+		"id":                         types.StringType,
+		"is_enabled":                 types.BoolType,
+		"origin":                     types.StringType,
+		"type":                       types.StringType,
+		"user_consent_description":   types.StringType,
+		"user_consent_display_name":  types.StringType,
+		"value":                      types.StringType,
+	}
+}
+
+type PreAuthorizedApplicationModel struct {
+	AppId                  types.String `tfsdk:"app_id"`
+	DelegatedPermissionIds types.List   `tfsdk:"delegated_permission_ids"`
+}
+
+func (m PreAuthorizedApplicationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"app_id":                   types.StringType,
+		"delegated_permission_ids": types.ListType{ElemType: types.StringType},
+	}
+}
+
+type ProvisionedPlanModel struct {
+	CapabilityStatus   types.String `tfsdk:"capability_status"`
+	ProvisioningStatus types.String `tfsdk:"provisioning_status"`
+	Service            types.String `tfsdk:"service"`
+}
+
+func (m ProvisionedPlanModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"capability_status":   types.StringType,
+		"provisioning_status": types.StringType,
+		"service":             types.StringType,
+	}
+}
+
+type PublicClientApplicationModel struct {
+	RedirectUris types.List `tfsdk:"redirect_uris"`
+}
+
+func (m PublicClientApplicationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"redirect_uris": types.ListType{ElemType: types.StringType},
+	}
+}
+
+type PublicErrorModel struct {
+	Code       types.String `tfsdk:"code"`
+	Details    types.List   `tfsdk:"details"`
+	InnerError types.Object `tfsdk:"inner_error"`
+	Message    types.String `tfsdk:"message"`
+	Target     types.String `tfsdk:"target"`
+}
+
+func (m PublicErrorModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"code":        types.StringType,
+		"details":     types.ListType{ElemType: types.ObjectType{AttrTypes: PublicErrorDetailModel{}.AttributeTypes()}},
+		"inner_error": types.ObjectType{AttrTypes: PublicInnerErrorModel{}.AttributeTypes()},
+		"message":     types.StringType,
+		"target":      types.StringType,
+	}
+}
+
+type PublicErrorDetailModel struct {
+	Code    types.String `tfsdk:"code"`
+	Message types.String `tfsdk:"message"`
+	Target  types.String `tfsdk:"target"`
+}
+
+func (m PublicErrorDetailModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"code":    types.StringType,
+		"message": types.StringType,
+		"target":  types.StringType,
+	}
+}
+
+type PublicInnerErrorModel struct {
+	Code    types.String `tfsdk:"code"`
+	Details types.List   `tfsdk:"details"`
+	Message types.String `tfsdk:"message"`
+	Target  types.String `tfsdk:"target"`
+}
+
+func (m PublicInnerErrorModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"code":    types.StringType,
+		"details": types.ListType{ElemType: types.ObjectType{AttrTypes: PublicErrorDetailModel{}.AttributeTypes()}},
+		"message": types.StringType,
+		"target":  types.StringType,
+	}
+}
+
+type RedirectUriSettingsModel struct {
+	Index types.Int64  `tfsdk:"index"`
+	Uri   types.String `tfsdk:"uri"`
+}
+
+func (m RedirectUriSettingsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"index": types.Int64Type,
+		"uri":   types.StringType,
+	}
+}
+
+type RequestSignatureVerificationModel struct {
+	AllowedWeakAlgorithms   types.String `tfsdk:"allowed_weak_algorithms"`
+	IsSignedRequestRequired types.Bool   `tfsdk:"is_signed_request_required"`
+}
+
+func (m RequestSignatureVerificationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"allowed_weak_algorithms":    types.StringType,
+		"is_signed_request_required": types.BoolType,
+	}
+}
+
+type RequiredResourceAccessModel struct {
+	ResourceAccess types.List   `tfsdk:"resource_access"`
+	ResourceAppId  types.String `tfsdk:"resource_app_id"`
+}
+
+func (m RequiredResourceAccessModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"resource_access": types.ListType{ElemType: types.ObjectType{AttrTypes: ResourceAccessModel{}.AttributeTypes()}},
+		"resource_app_id": types.StringType,
+	}
+}
+
+type ResourceAccessModel struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (m ResourceAccessModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"type": types.StringType,
+	}
+}
+
+type ResourceSpecificPermissionModel struct {
+	Description types.String `tfsdk:"description"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Id          types.String `tfsdk:"id"`
+	IsEnabled   types.Bool   `tfsdk:"is_enabled"`
+	Value       types.String `tfsdk:"value"`
+}
+
+func (m ResourceSpecificPermissionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"description":  types.StringType,
+		"display_name": types.StringType,
+		"id":           types.StringType,
+		"is_enabled":   types.BoolType,
+		"value":        types.StringType,
+	}
+}
+
+type SamlSingleSignOnSettingsModel struct {
+	RelayState types.String `tfsdk:"relay_state"`
+}
+
+func (m SamlSingleSignOnSettingsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"relay_state": types.StringType,
+	}
+}
+
+type ServicePrincipalLockConfigurationModel struct {
+	AllProperties              types.Bool `tfsdk:"all_properties"`
+	CredentialsWithUsageSign   types.Bool `tfsdk:"credentials_with_usage_sign"`
+	CredentialsWithUsageVerify types.Bool `tfsdk:"credentials_with_usage_verify"`
+	IsEnabled                  types.Bool `tfsdk:"is_enabled"`
+	TokenEncryptionKeyId       types.Bool `tfsdk:"token_encryption_key_id"`
+}
+
+func (m ServicePrincipalLockConfigurationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"all_properties":                types.BoolType,
+		"credentials_with_usage_sign":   types.BoolType,
+		"credentials_with_usage_verify": types.BoolType,
+		"is_enabled":                    types.BoolType,
+		"token_encryption_key_id":       types.BoolType,
+	}
+}
+
+type ServiceProvisioningErrorModel struct {
+	CreatedDateTime types.String `tfsdk:"created_date_time"`
+	IsResolved      types.Bool   `tfsdk:"is_resolved"`
+	ServiceInstance types.String `tfsdk:"service_instance"`
+}
+
+func (m ServiceProvisioningErrorModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"created_date_time": types.StringType,
+		"is_resolved":       types.BoolType,
+		"service_instance":  types.StringType,
+	}
+}
+
+type SharepointIdsModel struct {
+	ListId           types.String `tfsdk:"list_id"`
+	ListItemId       types.String `tfsdk:"list_item_id"`
+	ListItemUniqueId types.String `tfsdk:"list_item_unique_id"`
+	SiteId           types.String `tfsdk:"site_id"`
+	SiteUrl          types.String `tfsdk:"site_url"`
+	TenantId         types.String `tfsdk:"tenant_id"`
+	WebId            types.String `tfsdk:"web_id"`
+}
+
+func (m SharepointIdsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"list_id":             types.StringType,
+		"list_item_id":        types.StringType,
+		"list_item_unique_id": types.StringType,
+		"site_id":             types.StringType,
+		"site_url":            types.StringType,
+		"tenant_id":           types.StringType,
+		"web_id":              types.StringType,
+	}
+}
+
+type SignInActivityModel struct {
+	LastNonInteractiveSignInDateTime  types.String `tfsdk:"last_non_interactive_sign_in_date_time"`
+	LastNonInteractiveSignInRequestId types.String `tfsdk:"last_non_interactive_sign_in_request_id"`
+	LastSignInDateTime                types.String `tfsdk:"last_sign_in_date_time"`
+	LastSignInRequestId               types.String `tfsdk:"last_sign_in_request_id"`
+	LastSuccessfulSignInDateTime      types.String `tfsdk:"last_successful_sign_in_date_time"`
+	LastSuccessfulSignInRequestId     types.String `tfsdk:"last_successful_sign_in_request_id"`
+}
+
+func (m SignInActivityModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"last_non_interactive_sign_in_date_time":  types.StringType,
+		"last_non_interactive_sign_in_request_id": types.StringType,
+		"last_sign_in_date_time":                  types.StringType,
+		"last_sign_in_request_id":                 types.StringType,
+		"last_successful_sign_in_date_time":       types.StringType,
+		"last_successful_sign_in_request_id":      types.StringType,
+	}
+}
+
+type SiteArchivalDetailsModel struct {
+	ArchiveStatus types.String `tfsdk:"archive_status"`
+}
+
+func (m SiteArchivalDetailsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"archive_status": types.StringType,
+	}
+}
+
+type SiteCollectionModel struct {
+	ArchivalDetails  types.Object `tfsdk:"archival_details"`
+	DataLocationCode types.String `tfsdk:"data_location_code"`
+	Hostname         types.String `tfsdk:"hostname"`
+}
+
+func (m SiteCollectionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"archival_details":   types.ObjectType{AttrTypes: SiteArchivalDetailsModel{}.AttributeTypes()},
+		"data_location_code": types.StringType,
+		"hostname":           types.StringType,
+	}
+}
+
+type SpaApplicationModel struct {
+	RedirectUris types.List `tfsdk:"redirect_uris"`
+}
+
+func (m SpaApplicationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"redirect_uris": types.ListType{ElemType: types.StringType},
+	}
+}
+
+type VerifiedPublisherModel struct {
+	AddedDateTime       types.String `tfsdk:"added_date_time"`
+	DisplayName         types.String `tfsdk:"display_name"`
+	VerifiedPublisherId types.String `tfsdk:"verified_publisher_id"`
+}
+
+func (m VerifiedPublisherModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"added_date_time":       types.StringType,
+		"display_name":          types.StringType,
+		"verified_publisher_id": types.StringType,
+	}
+}
+
+type WebApplicationModel struct {
+	HomePageUrl           types.String `tfsdk:"home_page_url"`
+	ImplicitGrantSettings types.Object `tfsdk:"implicit_grant_settings"`
+	LogoutUrl             types.String `tfsdk:"logout_url"`
+	RedirectUriSettings   types.List   `tfsdk:"redirect_uri_settings"`
+	RedirectUris          types.List   `tfsdk:"redirect_uris"`
+}
+
+func (m WebApplicationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"home_page_url":           types.StringType,
+		"implicit_grant_settings": types.ObjectType{AttrTypes: ImplicitGrantSettingsModel{}.AttributeTypes()},
+		"logout_url":              types.StringType,
+		"redirect_uri_settings":   types.ListType{ElemType: types.ObjectType{AttrTypes: RedirectUriSettingsModel{}.AttributeTypes()}},
+		"redirect_uris":           types.ListType{ElemType: types.StringType},
+	}
+}
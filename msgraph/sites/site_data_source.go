@@ -10,6 +10,9 @@ import (
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/sites"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,12 +37,12 @@ func (d *siteDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *siteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *siteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -445,11 +448,11 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	if responseSite.GetCreatedBy() != nil {
-		tfStateIdentitySet := siteIdentitySetModel{}
+		tfStateIdentitySet := sharedmodels.IdentitySetModel{}
 		responseIdentitySet := responseSite.GetCreatedBy()
 
 		if responseIdentitySet.GetApplication() != nil {
-			tfStateIdentity := siteIdentityModel{}
+			tfStateIdentity := sharedmodels.IdentityModel{}
 			responseIdentity := responseIdentitySet.GetApplication()
 
 			if responseIdentity.GetDisplayName() != nil {
@@ -466,7 +469,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			tfStateIdentitySet.Application, _ = types.ObjectValueFrom(ctx, tfStateIdentity.AttributeTypes(), tfStateIdentity)
 		}
 		if responseIdentitySet.GetDevice() != nil {
-			tfStateIdentity := siteIdentityModel{}
+			tfStateIdentity := sharedmodels.IdentityModel{}
 			responseIdentity := responseIdentitySet.GetDevice()
 
 			if responseIdentity.GetDisplayName() != nil {
@@ -483,7 +486,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			tfStateIdentitySet.Device, _ = types.ObjectValueFrom(ctx, tfStateIdentity.AttributeTypes(), tfStateIdentity)
 		}
 		if responseIdentitySet.GetUser() != nil {
-			tfStateIdentity := siteIdentityModel{}
+			tfStateIdentity := sharedmodels.IdentityModel{}
 			responseIdentity := responseIdentitySet.GetUser()
 
 			if responseIdentity.GetDisplayName() != nil {
@@ -523,7 +526,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateSite.ETag = types.StringNull()
 	}
 	if responseSite.GetError() != nil {
-		tfStatePublicError := sitePublicErrorModel{}
+		tfStatePublicError := sharedmodels.PublicErrorModel{}
 		responsePublicError := responseSite.GetError()
 
 		if responsePublicError.GetCode() != nil {
@@ -534,7 +537,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		if len(responsePublicError.GetDetails()) > 0 {
 			objectValues := []basetypes.ObjectValue{}
 			for _, responsePublicErrorDetail := range responsePublicError.GetDetails() {
-				tfStatePublicErrorDetail := sitePublicErrorDetailModel{}
+				tfStatePublicErrorDetail := sharedmodels.PublicErrorDetailModel{}
 
 				if responsePublicErrorDetail.GetCode() != nil {
 					tfStatePublicErrorDetail.Code = types.StringValue(*responsePublicErrorDetail.GetCode())
@@ -557,7 +560,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			tfStatePublicError.Details, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 		}
 		if responsePublicError.GetInnerError() != nil {
-			tfStatePublicInnerError := sitePublicInnerErrorModel{}
+			tfStatePublicInnerError := sharedmodels.PublicInnerErrorModel{}
 			responsePublicInnerError := responsePublicError.GetInnerError()
 
 			if responsePublicInnerError.GetCode() != nil {
@@ -568,7 +571,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			if len(responsePublicInnerError.GetDetails()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responsePublicErrorDetail := range responsePublicInnerError.GetDetails() {
-					tfStatePublicErrorDetail := sitePublicErrorDetailModel{}
+					tfStatePublicErrorDetail := sharedmodels.PublicErrorDetailModel{}
 
 					if responsePublicErrorDetail.GetCode() != nil {
 						tfStatePublicErrorDetail.Code = types.StringValue(*responsePublicErrorDetail.GetCode())
@@ -627,11 +630,11 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateSite.IsPersonalSite = types.BoolNull()
 	}
 	if responseSite.GetLastModifiedBy() != nil {
-		tfStateIdentitySet := siteIdentitySetModel{}
+		tfStateIdentitySet := sharedmodels.IdentitySetModel{}
 		responseIdentitySet := responseSite.GetLastModifiedBy()
 
 		if responseIdentitySet.GetApplication() != nil {
-			tfStateIdentity := siteIdentityModel{}
+			tfStateIdentity := sharedmodels.IdentityModel{}
 			responseIdentity := responseIdentitySet.GetApplication()
 
 			if responseIdentity.GetDisplayName() != nil {
@@ -648,7 +651,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			tfStateIdentitySet.Application, _ = types.ObjectValueFrom(ctx, tfStateIdentity.AttributeTypes(), tfStateIdentity)
 		}
 		if responseIdentitySet.GetDevice() != nil {
-			tfStateIdentity := siteIdentityModel{}
+			tfStateIdentity := sharedmodels.IdentityModel{}
 			responseIdentity := responseIdentitySet.GetDevice()
 
 			if responseIdentity.GetDisplayName() != nil {
@@ -665,7 +668,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			tfStateIdentitySet.Device, _ = types.ObjectValueFrom(ctx, tfStateIdentity.AttributeTypes(), tfStateIdentity)
 		}
 		if responseIdentitySet.GetUser() != nil {
-			tfStateIdentity := siteIdentityModel{}
+			tfStateIdentity := sharedmodels.IdentityModel{}
 			responseIdentity := responseIdentitySet.GetUser()
 
 			if responseIdentity.GetDisplayName() != nil {
@@ -695,7 +698,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateSite.Name = types.StringNull()
 	}
 	if responseSite.GetParentReference() != nil {
-		tfStateItemReference := siteItemReferenceModel{}
+		tfStateItemReference := sharedmodels.ItemReferenceModel{}
 		responseItemReference := responseSite.GetParentReference()
 
 		if responseItemReference.GetDriveId() != nil {
@@ -729,7 +732,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			tfStateItemReference.ShareId = types.StringNull()
 		}
 		if responseItemReference.GetSharepointIds() != nil {
-			tfStateSharepointIds := siteSharepointIdsModel{}
+			tfStateSharepointIds := sharedmodels.SharepointIdsModel{}
 			responseSharepointIds := responseItemReference.GetSharepointIds()
 
 			if responseSharepointIds.GetListId() != nil {
@@ -779,7 +782,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateSite.ParentReference, _ = types.ObjectValueFrom(ctx, tfStateItemReference.AttributeTypes(), tfStateItemReference)
 	}
 	if responseSite.GetSharepointIds() != nil {
-		tfStateSharepointIds := siteSharepointIdsModel{}
+		tfStateSharepointIds := sharedmodels.SharepointIdsModel{}
 		responseSharepointIds := responseSite.GetSharepointIds()
 
 		if responseSharepointIds.GetListId() != nil {
@@ -821,11 +824,11 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateSite.SharepointIds, _ = types.ObjectValueFrom(ctx, tfStateSharepointIds.AttributeTypes(), tfStateSharepointIds)
 	}
 	if responseSite.GetSiteCollection() != nil {
-		tfStateSiteCollection := siteSiteCollectionModel{}
+		tfStateSiteCollection := sharedmodels.SiteCollectionModel{}
 		responseSiteCollection := responseSite.GetSiteCollection()
 
 		if responseSiteCollection.GetArchivalDetails() != nil {
-			tfStateSiteArchivalDetails := siteSiteArchivalDetailsModel{}
+			tfStateSiteArchivalDetails := sharedmodels.SiteArchivalDetailsModel{}
 			responseSiteArchivalDetails := responseSiteCollection.GetArchivalDetails()
 
 			if responseSiteArchivalDetails.GetArchiveStatus() != nil {
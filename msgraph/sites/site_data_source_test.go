@@ -0,0 +1,37 @@
+package sites_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+func TestAccSiteDataSource(t *testing.T) {
+	// There's no resource to create an object to look up, so an existing one is needed
+	id := os.Getenv("MSGRAPH_TEST_SITE_ID")
+	if id == "" {
+		t.Skip("MSGRAPH_TEST_SITE_ID must be set to the id of an existing object to look up")
+	}
+
+	config := fmt.Sprintf(`
+data "msgraph_site" "test" {
+  id = "%s"
+}
+`, id)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.msgraph_site.test", "id", id),
+				),
+			},
+		},
+	})
+}
@@ -9,6 +9,9 @@ import (
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/sites"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -33,12 +36,12 @@ func (d *sitesDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *sitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *sitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -424,11 +427,11 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			tfStateSite := sitesSiteModel{}
 
 			if responseSite.GetCreatedBy() != nil {
-				tfStateIdentitySet := sitesIdentitySetModel{}
+				tfStateIdentitySet := sharedmodels.IdentitySetModel{}
 				responseIdentitySet := responseSite.GetCreatedBy()
 
 				if responseIdentitySet.GetApplication() != nil {
-					tfStateIdentity := sitesIdentityModel{}
+					tfStateIdentity := sharedmodels.IdentityModel{}
 					responseIdentity := responseIdentitySet.GetApplication()
 
 					if responseIdentity.GetDisplayName() != nil {
@@ -445,7 +448,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 					tfStateIdentitySet.Application, _ = types.ObjectValueFrom(ctx, tfStateIdentity.AttributeTypes(), tfStateIdentity)
 				}
 				if responseIdentitySet.GetDevice() != nil {
-					tfStateIdentity := sitesIdentityModel{}
+					tfStateIdentity := sharedmodels.IdentityModel{}
 					responseIdentity := responseIdentitySet.GetDevice()
 
 					if responseIdentity.GetDisplayName() != nil {
@@ -462,7 +465,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 					tfStateIdentitySet.Device, _ = types.ObjectValueFrom(ctx, tfStateIdentity.AttributeTypes(), tfStateIdentity)
 				}
 				if responseIdentitySet.GetUser() != nil {
-					tfStateIdentity := sitesIdentityModel{}
+					tfStateIdentity := sharedmodels.IdentityModel{}
 					responseIdentity := responseIdentitySet.GetUser()
 
 					if responseIdentity.GetDisplayName() != nil {
@@ -502,7 +505,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateSite.ETag = types.StringNull()
 			}
 			if responseSite.GetError() != nil {
-				tfStatePublicError := sitesPublicErrorModel{}
+				tfStatePublicError := sharedmodels.PublicErrorModel{}
 				responsePublicError := responseSite.GetError()
 
 				if responsePublicError.GetCode() != nil {
@@ -513,7 +516,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				if len(responsePublicError.GetDetails()) > 0 {
 					objectValues := []basetypes.ObjectValue{}
 					for _, responsePublicErrorDetail := range responsePublicError.GetDetails() {
-						tfStatePublicErrorDetail := sitesPublicErrorDetailModel{}
+						tfStatePublicErrorDetail := sharedmodels.PublicErrorDetailModel{}
 
 						if responsePublicErrorDetail.GetCode() != nil {
 							tfStatePublicErrorDetail.Code = types.StringValue(*responsePublicErrorDetail.GetCode())
@@ -536,7 +539,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 					tfStatePublicError.Details, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 				}
 				if responsePublicError.GetInnerError() != nil {
-					tfStatePublicInnerError := sitesPublicInnerErrorModel{}
+					tfStatePublicInnerError := sharedmodels.PublicInnerErrorModel{}
 					responsePublicInnerError := responsePublicError.GetInnerError()
 
 					if responsePublicInnerError.GetCode() != nil {
@@ -547,7 +550,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 					if len(responsePublicInnerError.GetDetails()) > 0 {
 						objectValues := []basetypes.ObjectValue{}
 						for _, responsePublicErrorDetail := range responsePublicInnerError.GetDetails() {
-							tfStatePublicErrorDetail := sitesPublicErrorDetailModel{}
+							tfStatePublicErrorDetail := sharedmodels.PublicErrorDetailModel{}
 
 							if responsePublicErrorDetail.GetCode() != nil {
 								tfStatePublicErrorDetail.Code = types.StringValue(*responsePublicErrorDetail.GetCode())
@@ -606,11 +609,11 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateSite.IsPersonalSite = types.BoolNull()
 			}
 			if responseSite.GetLastModifiedBy() != nil {
-				tfStateIdentitySet := sitesIdentitySetModel{}
+				tfStateIdentitySet := sharedmodels.IdentitySetModel{}
 				responseIdentitySet := responseSite.GetLastModifiedBy()
 
 				if responseIdentitySet.GetApplication() != nil {
-					tfStateIdentity := sitesIdentityModel{}
+					tfStateIdentity := sharedmodels.IdentityModel{}
 					responseIdentity := responseIdentitySet.GetApplication()
 
 					if responseIdentity.GetDisplayName() != nil {
@@ -627,7 +630,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 					tfStateIdentitySet.Application, _ = types.ObjectValueFrom(ctx, tfStateIdentity.AttributeTypes(), tfStateIdentity)
 				}
 				if responseIdentitySet.GetDevice() != nil {
-					tfStateIdentity := sitesIdentityModel{}
+					tfStateIdentity := sharedmodels.IdentityModel{}
 					responseIdentity := responseIdentitySet.GetDevice()
 
 					if responseIdentity.GetDisplayName() != nil {
@@ -644,7 +647,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 					tfStateIdentitySet.Device, _ = types.ObjectValueFrom(ctx, tfStateIdentity.AttributeTypes(), tfStateIdentity)
 				}
 				if responseIdentitySet.GetUser() != nil {
-					tfStateIdentity := sitesIdentityModel{}
+					tfStateIdentity := sharedmodels.IdentityModel{}
 					responseIdentity := responseIdentitySet.GetUser()
 
 					if responseIdentity.GetDisplayName() != nil {
@@ -674,7 +677,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateSite.Name = types.StringNull()
 			}
 			if responseSite.GetParentReference() != nil {
-				tfStateItemReference := sitesItemReferenceModel{}
+				tfStateItemReference := sharedmodels.ItemReferenceModel{}
 				responseItemReference := responseSite.GetParentReference()
 
 				if responseItemReference.GetDriveId() != nil {
@@ -708,7 +711,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 					tfStateItemReference.ShareId = types.StringNull()
 				}
 				if responseItemReference.GetSharepointIds() != nil {
-					tfStateSharepointIds := sitesSharepointIdsModel{}
+					tfStateSharepointIds := sharedmodels.SharepointIdsModel{}
 					responseSharepointIds := responseItemReference.GetSharepointIds()
 
 					if responseSharepointIds.GetListId() != nil {
@@ -758,7 +761,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateSite.ParentReference, _ = types.ObjectValueFrom(ctx, tfStateItemReference.AttributeTypes(), tfStateItemReference)
 			}
 			if responseSite.GetSharepointIds() != nil {
-				tfStateSharepointIds := sitesSharepointIdsModel{}
+				tfStateSharepointIds := sharedmodels.SharepointIdsModel{}
 				responseSharepointIds := responseSite.GetSharepointIds()
 
 				if responseSharepointIds.GetListId() != nil {
@@ -800,11 +803,11 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateSite.SharepointIds, _ = types.ObjectValueFrom(ctx, tfStateSharepointIds.AttributeTypes(), tfStateSharepointIds)
 			}
 			if responseSite.GetSiteCollection() != nil {
-				tfStateSiteCollection := sitesSiteCollectionModel{}
+				tfStateSiteCollection := sharedmodels.SiteCollectionModel{}
 				responseSiteCollection := responseSite.GetSiteCollection()
 
 				if responseSiteCollection.GetArchivalDetails() != nil {
-					tfStateSiteArchivalDetails := sitesSiteArchivalDetailsModel{}
+					tfStateSiteArchivalDetails := sharedmodels.SiteArchivalDetailsModel{}
 					responseSiteArchivalDetails := responseSiteCollection.GetArchivalDetails()
 
 					if responseSiteArchivalDetails.GetArchiveStatus() != nil {
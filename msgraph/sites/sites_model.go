@@ -3,6 +3,8 @@ package sites
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 type sitesModel struct {
@@ -35,164 +37,20 @@ type sitesSiteModel struct {
 
 func (m sitesSiteModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"created_by":              types.ObjectType{AttrTypes: sitesIdentitySetModel{}.AttributeTypes()},
+		"created_by":              types.ObjectType{AttrTypes: sharedmodels.IdentitySetModel{}.AttributeTypes()},
 		"created_date_time":       types.StringType,
 		"description":             types.StringType,
 		"display_name":            types.StringType,
 		"e_tag":                   types.StringType,
-		"error":                   types.ObjectType{AttrTypes: sitesPublicErrorModel{}.AttributeTypes()},
+		"error":                   types.ObjectType{AttrTypes: sharedmodels.PublicErrorModel{}.AttributeTypes()},
 		"id":                      types.StringType,
 		"is_personal_site":        types.BoolType,
-		"last_modified_by":        types.ObjectType{AttrTypes: sitesIdentitySetModel{}.AttributeTypes()},
+		"last_modified_by":        types.ObjectType{AttrTypes: sharedmodels.IdentitySetModel{}.AttributeTypes()},
 		"last_modified_date_time": types.StringType,
 		"name":                    types.StringType,
-		"parent_reference":        types.ObjectType{AttrTypes: sitesItemReferenceModel{}.AttributeTypes()},
-		"sharepoint_ids":          types.ObjectType{AttrTypes: sitesSharepointIdsModel{}.AttributeTypes()},
-		"site_collection":         types.ObjectType{AttrTypes: sitesSiteCollectionModel{}.AttributeTypes()},
+		"parent_reference":        types.ObjectType{AttrTypes: sharedmodels.ItemReferenceModel{}.AttributeTypes()},
+		"sharepoint_ids":          types.ObjectType{AttrTypes: sharedmodels.SharepointIdsModel{}.AttributeTypes()},
+		"site_collection":         types.ObjectType{AttrTypes: sharedmodels.SiteCollectionModel{}.AttributeTypes()},
 		"web_url":                 types.StringType,
 	}
 }
-
-type sitesIdentitySetModel struct {
-	Application types.Object `tfsdk:"application"`
-	Device      types.Object `tfsdk:"device"`
-	User        types.Object `tfsdk:"user"`
-}
-
-func (m sitesIdentitySetModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"application": types.ObjectType{AttrTypes: sitesIdentityModel{}.AttributeTypes()},
-		"device":      types.ObjectType{AttrTypes: sitesIdentityModel{}.AttributeTypes()},
-		"user":        types.ObjectType{AttrTypes: sitesIdentityModel{}.AttributeTypes()},
-	}
-}
-
-type sitesPublicErrorModel struct {
-	Code       types.String `tfsdk:"code"`
-	Details    types.List   `tfsdk:"details"`
-	InnerError types.Object `tfsdk:"inner_error"`
-	Message    types.String `tfsdk:"message"`
-	Target     types.String `tfsdk:"target"`
-}
-
-func (m sitesPublicErrorModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"code":        types.StringType,
-		"details":     types.ListType{ElemType: types.ObjectType{AttrTypes: sitesPublicErrorDetailModel{}.AttributeTypes()}},
-		"inner_error": types.ObjectType{AttrTypes: sitesPublicInnerErrorModel{}.AttributeTypes()},
-		"message":     types.StringType,
-		"target":      types.StringType,
-	}
-}
-
-type sitesItemReferenceModel struct {
-	DriveId       types.String `tfsdk:"drive_id"`
-	DriveType     types.String `tfsdk:"drive_type"`
-	Id            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Path          types.String `tfsdk:"path"`
-	ShareId       types.String `tfsdk:"share_id"`
-	SharepointIds types.Object `tfsdk:"sharepoint_ids"`
-	SiteId        types.String `tfsdk:"site_id"`
-}
-
-func (m sitesItemReferenceModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"drive_id":       types.StringType,
-		"drive_type":     types.StringType,
-		"id":             types.StringType,
-		"name":           types.StringType,
-		"path":           types.StringType,
-		"share_id":       types.StringType,
-		"sharepoint_ids": types.ObjectType{AttrTypes: sitesSharepointIdsModel{}.AttributeTypes()},
-		"site_id":        types.StringType,
-	}
-}
-
-type sitesSharepointIdsModel struct {
-	ListId           types.String `tfsdk:"list_id"`
-	ListItemId       types.String `tfsdk:"list_item_id"`
-	ListItemUniqueId types.String `tfsdk:"list_item_unique_id"`
-	SiteId           types.String `tfsdk:"site_id"`
-	SiteUrl          types.String `tfsdk:"site_url"`
-	TenantId         types.String `tfsdk:"tenant_id"`
-	WebId            types.String `tfsdk:"web_id"`
-}
-
-func (m sitesSharepointIdsModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"list_id":             types.StringType,
-		"list_item_id":        types.StringType,
-		"list_item_unique_id": types.StringType,
-		"site_id":             types.StringType,
-		"site_url":            types.StringType,
-		"tenant_id":           types.StringType,
-		"web_id":              types.StringType,
-	}
-}
-
-type sitesSiteCollectionModel struct {
-	ArchivalDetails  types.Object `tfsdk:"archival_details"`
-	DataLocationCode types.String `tfsdk:"data_location_code"`
-	Hostname         types.String `tfsdk:"hostname"`
-}
-
-func (m sitesSiteCollectionModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"archival_details":   types.ObjectType{AttrTypes: sitesSiteArchivalDetailsModel{}.AttributeTypes()},
-		"data_location_code": types.StringType,
-		"hostname":           types.StringType,
-	}
-}
-
-type sitesIdentityModel struct {
-	DisplayName types.String `tfsdk:"display_name"`
-	Id          types.String `tfsdk:"id"`
-}
-
-func (m sitesIdentityModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"display_name": types.StringType,
-		"id":           types.StringType,
-	}
-}
-
-type sitesPublicErrorDetailModel struct {
-	Code    types.String `tfsdk:"code"`
-	Message types.String `tfsdk:"message"`
-	Target  types.String `tfsdk:"target"`
-}
-
-func (m sitesPublicErrorDetailModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"code":    types.StringType,
-		"message": types.StringType,
-		"target":  types.StringType,
-	}
-}
-
-type sitesPublicInnerErrorModel struct {
-	Code    types.String `tfsdk:"code"`
-	Details types.List   `tfsdk:"details"`
-	Message types.String `tfsdk:"message"`
-	Target  types.String `tfsdk:"target"`
-}
-
-func (m sitesPublicInnerErrorModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"code":    types.StringType,
-		"details": types.ListType{ElemType: types.ObjectType{AttrTypes: sitesPublicErrorDetailModel{}.AttributeTypes()}},
-		"message": types.StringType,
-		"target":  types.StringType,
-	}
-}
-
-type sitesSiteArchivalDetailsModel struct {
-	ArchiveStatus types.String `tfsdk:"archive_status"`
-}
-
-func (m sitesSiteArchivalDetailsModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"archive_status": types.StringType,
-	}
-}
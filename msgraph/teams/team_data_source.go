@@ -9,6 +9,8 @@ import (
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/teams"
+
+	"terraform-provider-msgraph/msgraph/clients"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -33,12 +35,12 @@ func (d *teamDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *teamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *teamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
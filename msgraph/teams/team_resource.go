@@ -14,6 +14,7 @@ import (
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/teams"
 
+	"terraform-provider-msgraph/msgraph/clients"
 	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/objectplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/stringplanmodifiers"
@@ -41,12 +42,12 @@ func (d *teamResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Configure adds the provider configured client to the resource.
-func (d *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (d *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the resource.
@@ -582,7 +583,10 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Create new Team
-	result, err := r.client.Teams().Post(context.Background(), requestBodyTeam, nil)
+	created := clients.NewCreatedObject()
+	result, err := r.client.Teams().Post(ctx, requestBodyTeam, &teams.TeamsRequestBuilderPostRequestConfiguration{
+		Options: created.Options(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Team",
@@ -593,7 +597,15 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Map response body to schema and populate Computed attribute value
 	// TODO: Add support for other Computed values
-	tfPlanTeam.Id = types.StringValue(*result.GetId())
+	id, err := created.Id(result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Team",
+			err.Error(),
+		)
+		return
+	}
+	tfPlanTeam.Id = types.StringValue(id)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, tfPlanTeam)
@@ -882,22 +894,34 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		tfStateTeamFunSettings := teamTeamFunSettingsModel{}
 		tfStateTeam.FunSettings.As(ctx, &tfStateTeamFunSettings, basetypes.ObjectAsOptions{})
 
-		if !tfPlanTeamFunSettings.AllowCustomMemes.Equal(tfStateTeamFunSettings.AllowCustomMemes) {
+		if tfPlanTeamFunSettings.AllowCustomMemes.IsUnknown() {
+			tfPlanTeamFunSettings.AllowCustomMemes = tfStateTeamFunSettings.AllowCustomMemes
+		}
+		if !tfPlanTeamFunSettings.AllowCustomMemes.IsNull() {
 			tfPlanAllowCustomMemes := tfPlanTeamFunSettings.AllowCustomMemes.ValueBool()
 			requestBodyTeamFunSettings.SetAllowCustomMemes(&tfPlanAllowCustomMemes)
 		}
 
-		if !tfPlanTeamFunSettings.AllowGiphy.Equal(tfStateTeamFunSettings.AllowGiphy) {
+		if tfPlanTeamFunSettings.AllowGiphy.IsUnknown() {
+			tfPlanTeamFunSettings.AllowGiphy = tfStateTeamFunSettings.AllowGiphy
+		}
+		if !tfPlanTeamFunSettings.AllowGiphy.IsNull() {
 			tfPlanAllowGiphy := tfPlanTeamFunSettings.AllowGiphy.ValueBool()
 			requestBodyTeamFunSettings.SetAllowGiphy(&tfPlanAllowGiphy)
 		}
 
-		if !tfPlanTeamFunSettings.AllowStickersAndMemes.Equal(tfStateTeamFunSettings.AllowStickersAndMemes) {
+		if tfPlanTeamFunSettings.AllowStickersAndMemes.IsUnknown() {
+			tfPlanTeamFunSettings.AllowStickersAndMemes = tfStateTeamFunSettings.AllowStickersAndMemes
+		}
+		if !tfPlanTeamFunSettings.AllowStickersAndMemes.IsNull() {
 			tfPlanAllowStickersAndMemes := tfPlanTeamFunSettings.AllowStickersAndMemes.ValueBool()
 			requestBodyTeamFunSettings.SetAllowStickersAndMemes(&tfPlanAllowStickersAndMemes)
 		}
 
-		if !tfPlanTeamFunSettings.GiphyContentRating.Equal(tfStateTeamFunSettings.GiphyContentRating) {
+		if tfPlanTeamFunSettings.GiphyContentRating.IsUnknown() {
+			tfPlanTeamFunSettings.GiphyContentRating = tfStateTeamFunSettings.GiphyContentRating
+		}
+		if !tfPlanTeamFunSettings.GiphyContentRating.IsNull() {
 			tfPlanGiphyContentRating := tfPlanTeamFunSettings.GiphyContentRating.ValueString()
 			parsedGiphyContentRating, _ := models.ParseGiphyRatingType(tfPlanGiphyContentRating)
 			assertedGiphyContentRating := parsedGiphyContentRating.(models.GiphyRatingType)
@@ -914,12 +938,18 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		tfStateTeamGuestSettings := teamTeamGuestSettingsModel{}
 		tfStateTeam.GuestSettings.As(ctx, &tfStateTeamGuestSettings, basetypes.ObjectAsOptions{})
 
-		if !tfPlanTeamGuestSettings.AllowCreateUpdateChannels.Equal(tfStateTeamGuestSettings.AllowCreateUpdateChannels) {
+		if tfPlanTeamGuestSettings.AllowCreateUpdateChannels.IsUnknown() {
+			tfPlanTeamGuestSettings.AllowCreateUpdateChannels = tfStateTeamGuestSettings.AllowCreateUpdateChannels
+		}
+		if !tfPlanTeamGuestSettings.AllowCreateUpdateChannels.IsNull() {
 			tfPlanAllowCreateUpdateChannels := tfPlanTeamGuestSettings.AllowCreateUpdateChannels.ValueBool()
 			requestBodyTeamGuestSettings.SetAllowCreateUpdateChannels(&tfPlanAllowCreateUpdateChannels)
 		}
 
-		if !tfPlanTeamGuestSettings.AllowDeleteChannels.Equal(tfStateTeamGuestSettings.AllowDeleteChannels) {
+		if tfPlanTeamGuestSettings.AllowDeleteChannels.IsUnknown() {
+			tfPlanTeamGuestSettings.AllowDeleteChannels = tfStateTeamGuestSettings.AllowDeleteChannels
+		}
+		if !tfPlanTeamGuestSettings.AllowDeleteChannels.IsNull() {
 			tfPlanAllowDeleteChannels := tfPlanTeamGuestSettings.AllowDeleteChannels.ValueBool()
 			requestBodyTeamGuestSettings.SetAllowDeleteChannels(&tfPlanAllowDeleteChannels)
 		}
@@ -949,32 +979,50 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		tfStateTeamMemberSettings := teamTeamMemberSettingsModel{}
 		tfStateTeam.MemberSettings.As(ctx, &tfStateTeamMemberSettings, basetypes.ObjectAsOptions{})
 
-		if !tfPlanTeamMemberSettings.AllowAddRemoveApps.Equal(tfStateTeamMemberSettings.AllowAddRemoveApps) {
+		if tfPlanTeamMemberSettings.AllowAddRemoveApps.IsUnknown() {
+			tfPlanTeamMemberSettings.AllowAddRemoveApps = tfStateTeamMemberSettings.AllowAddRemoveApps
+		}
+		if !tfPlanTeamMemberSettings.AllowAddRemoveApps.IsNull() {
 			tfPlanAllowAddRemoveApps := tfPlanTeamMemberSettings.AllowAddRemoveApps.ValueBool()
 			requestBodyTeamMemberSettings.SetAllowAddRemoveApps(&tfPlanAllowAddRemoveApps)
 		}
 
-		if !tfPlanTeamMemberSettings.AllowCreatePrivateChannels.Equal(tfStateTeamMemberSettings.AllowCreatePrivateChannels) {
+		if tfPlanTeamMemberSettings.AllowCreatePrivateChannels.IsUnknown() {
+			tfPlanTeamMemberSettings.AllowCreatePrivateChannels = tfStateTeamMemberSettings.AllowCreatePrivateChannels
+		}
+		if !tfPlanTeamMemberSettings.AllowCreatePrivateChannels.IsNull() {
 			tfPlanAllowCreatePrivateChannels := tfPlanTeamMemberSettings.AllowCreatePrivateChannels.ValueBool()
 			requestBodyTeamMemberSettings.SetAllowCreatePrivateChannels(&tfPlanAllowCreatePrivateChannels)
 		}
 
-		if !tfPlanTeamMemberSettings.AllowCreateUpdateChannels.Equal(tfStateTeamMemberSettings.AllowCreateUpdateChannels) {
+		if tfPlanTeamMemberSettings.AllowCreateUpdateChannels.IsUnknown() {
+			tfPlanTeamMemberSettings.AllowCreateUpdateChannels = tfStateTeamMemberSettings.AllowCreateUpdateChannels
+		}
+		if !tfPlanTeamMemberSettings.AllowCreateUpdateChannels.IsNull() {
 			tfPlanAllowCreateUpdateChannels := tfPlanTeamMemberSettings.AllowCreateUpdateChannels.ValueBool()
 			requestBodyTeamMemberSettings.SetAllowCreateUpdateChannels(&tfPlanAllowCreateUpdateChannels)
 		}
 
-		if !tfPlanTeamMemberSettings.AllowCreateUpdateRemoveConnectors.Equal(tfStateTeamMemberSettings.AllowCreateUpdateRemoveConnectors) {
+		if tfPlanTeamMemberSettings.AllowCreateUpdateRemoveConnectors.IsUnknown() {
+			tfPlanTeamMemberSettings.AllowCreateUpdateRemoveConnectors = tfStateTeamMemberSettings.AllowCreateUpdateRemoveConnectors
+		}
+		if !tfPlanTeamMemberSettings.AllowCreateUpdateRemoveConnectors.IsNull() {
 			tfPlanAllowCreateUpdateRemoveConnectors := tfPlanTeamMemberSettings.AllowCreateUpdateRemoveConnectors.ValueBool()
 			requestBodyTeamMemberSettings.SetAllowCreateUpdateRemoveConnectors(&tfPlanAllowCreateUpdateRemoveConnectors)
 		}
 
-		if !tfPlanTeamMemberSettings.AllowCreateUpdateRemoveTabs.Equal(tfStateTeamMemberSettings.AllowCreateUpdateRemoveTabs) {
+		if tfPlanTeamMemberSettings.AllowCreateUpdateRemoveTabs.IsUnknown() {
+			tfPlanTeamMemberSettings.AllowCreateUpdateRemoveTabs = tfStateTeamMemberSettings.AllowCreateUpdateRemoveTabs
+		}
+		if !tfPlanTeamMemberSettings.AllowCreateUpdateRemoveTabs.IsNull() {
 			tfPlanAllowCreateUpdateRemoveTabs := tfPlanTeamMemberSettings.AllowCreateUpdateRemoveTabs.ValueBool()
 			requestBodyTeamMemberSettings.SetAllowCreateUpdateRemoveTabs(&tfPlanAllowCreateUpdateRemoveTabs)
 		}
 
-		if !tfPlanTeamMemberSettings.AllowDeleteChannels.Equal(tfStateTeamMemberSettings.AllowDeleteChannels) {
+		if tfPlanTeamMemberSettings.AllowDeleteChannels.IsUnknown() {
+			tfPlanTeamMemberSettings.AllowDeleteChannels = tfStateTeamMemberSettings.AllowDeleteChannels
+		}
+		if !tfPlanTeamMemberSettings.AllowDeleteChannels.IsNull() {
 			tfPlanAllowDeleteChannels := tfPlanTeamMemberSettings.AllowDeleteChannels.ValueBool()
 			requestBodyTeamMemberSettings.SetAllowDeleteChannels(&tfPlanAllowDeleteChannels)
 		}
@@ -989,27 +1037,42 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		tfStateTeamMessagingSettings := teamTeamMessagingSettingsModel{}
 		tfStateTeam.MessagingSettings.As(ctx, &tfStateTeamMessagingSettings, basetypes.ObjectAsOptions{})
 
-		if !tfPlanTeamMessagingSettings.AllowChannelMentions.Equal(tfStateTeamMessagingSettings.AllowChannelMentions) {
+		if tfPlanTeamMessagingSettings.AllowChannelMentions.IsUnknown() {
+			tfPlanTeamMessagingSettings.AllowChannelMentions = tfStateTeamMessagingSettings.AllowChannelMentions
+		}
+		if !tfPlanTeamMessagingSettings.AllowChannelMentions.IsNull() {
 			tfPlanAllowChannelMentions := tfPlanTeamMessagingSettings.AllowChannelMentions.ValueBool()
 			requestBodyTeamMessagingSettings.SetAllowChannelMentions(&tfPlanAllowChannelMentions)
 		}
 
-		if !tfPlanTeamMessagingSettings.AllowOwnerDeleteMessages.Equal(tfStateTeamMessagingSettings.AllowOwnerDeleteMessages) {
+		if tfPlanTeamMessagingSettings.AllowOwnerDeleteMessages.IsUnknown() {
+			tfPlanTeamMessagingSettings.AllowOwnerDeleteMessages = tfStateTeamMessagingSettings.AllowOwnerDeleteMessages
+		}
+		if !tfPlanTeamMessagingSettings.AllowOwnerDeleteMessages.IsNull() {
 			tfPlanAllowOwnerDeleteMessages := tfPlanTeamMessagingSettings.AllowOwnerDeleteMessages.ValueBool()
 			requestBodyTeamMessagingSettings.SetAllowOwnerDeleteMessages(&tfPlanAllowOwnerDeleteMessages)
 		}
 
-		if !tfPlanTeamMessagingSettings.AllowTeamMentions.Equal(tfStateTeamMessagingSettings.AllowTeamMentions) {
+		if tfPlanTeamMessagingSettings.AllowTeamMentions.IsUnknown() {
+			tfPlanTeamMessagingSettings.AllowTeamMentions = tfStateTeamMessagingSettings.AllowTeamMentions
+		}
+		if !tfPlanTeamMessagingSettings.AllowTeamMentions.IsNull() {
 			tfPlanAllowTeamMentions := tfPlanTeamMessagingSettings.AllowTeamMentions.ValueBool()
 			requestBodyTeamMessagingSettings.SetAllowTeamMentions(&tfPlanAllowTeamMentions)
 		}
 
-		if !tfPlanTeamMessagingSettings.AllowUserDeleteMessages.Equal(tfStateTeamMessagingSettings.AllowUserDeleteMessages) {
+		if tfPlanTeamMessagingSettings.AllowUserDeleteMessages.IsUnknown() {
+			tfPlanTeamMessagingSettings.AllowUserDeleteMessages = tfStateTeamMessagingSettings.AllowUserDeleteMessages
+		}
+		if !tfPlanTeamMessagingSettings.AllowUserDeleteMessages.IsNull() {
 			tfPlanAllowUserDeleteMessages := tfPlanTeamMessagingSettings.AllowUserDeleteMessages.ValueBool()
 			requestBodyTeamMessagingSettings.SetAllowUserDeleteMessages(&tfPlanAllowUserDeleteMessages)
 		}
 
-		if !tfPlanTeamMessagingSettings.AllowUserEditMessages.Equal(tfStateTeamMessagingSettings.AllowUserEditMessages) {
+		if tfPlanTeamMessagingSettings.AllowUserEditMessages.IsUnknown() {
+			tfPlanTeamMessagingSettings.AllowUserEditMessages = tfStateTeamMessagingSettings.AllowUserEditMessages
+		}
+		if !tfPlanTeamMessagingSettings.AllowUserEditMessages.IsNull() {
 			tfPlanAllowUserEditMessages := tfPlanTeamMessagingSettings.AllowUserEditMessages.ValueBool()
 			requestBodyTeamMessagingSettings.SetAllowUserEditMessages(&tfPlanAllowUserEditMessages)
 		}
@@ -1070,8 +1133,7 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	// TODO: Delete team
-	err := r.client.Teams().ByTeamId(tfStateTeam.Id.ValueString()).Delete(context.Background(), nil)
+	err := r.client.Teams().ByTeamId(tfStateTeam.Id.ValueString()).Delete(ctx, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting team",
@@ -1085,3 +1147,45 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// ListIds returns the IDs of the objects matching filter, an OData $filter expression, or of every object when it's empty.
+// It's used to export the existing objects of a tenant
+func (r *teamResource) ListIds(ctx context.Context, filter string) ([]string, error) {
+
+	qparams := teams.TeamsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &teams.TeamsRequestBuilderGetQueryParameters{
+			Select: []string{"id"},
+		},
+	}
+	if filter != "" {
+		qparams.QueryParameters.Filter = &filter
+	}
+
+	result, err := r.client.Teams().Get(ctx, &qparams)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for {
+		for _, object := range result.GetValue() {
+			ids = append(ids, *object.GetId())
+		}
+		nextLink := result.GetOdataNextLink()
+		if nextLink == nil {
+			return ids, nil
+		}
+		result, err = r.client.Teams().WithUrl(*nextLink).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+}
+
+// ReadOnlyAttributes returns the paths of the attributes the API sets, which exports leave out of the configuration they write
+func (r *teamResource) ReadOnlyAttributes() []string {
+	return []string{
+		"id",
+	}
+}
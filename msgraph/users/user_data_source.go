@@ -11,6 +11,9 @@ import (
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -35,12 +38,12 @@ func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -746,7 +749,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if len(responseUser.GetAssignedLicenses()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAssignedLicense := range responseUser.GetAssignedLicenses() {
-			tfStateAssignedLicense := userAssignedLicenseModel{}
+			tfStateAssignedLicense := sharedmodels.AssignedLicenseModel{}
 
 			if len(responseAssignedLicense.GetDisabledPlans()) > 0 {
 				var valueArrayDisabledPlans []attr.Value
@@ -770,7 +773,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if len(responseUser.GetAssignedPlans()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAssignedPlan := range responseUser.GetAssignedPlans() {
-			tfStateAssignedPlan := userAssignedPlanModel{}
+			tfStateAssignedPlan := sharedmodels.AssignedPlanModel{}
 
 			if responseAssignedPlan.GetAssignedDateTime() != nil {
 				tfStateAssignedPlan.AssignedDateTime = types.StringValue(responseAssignedPlan.GetAssignedDateTime().String())
@@ -798,7 +801,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateUser.AssignedPlans, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 	}
 	if responseUser.GetAuthorizationInfo() != nil {
-		tfStateAuthorizationInfo := userAuthorizationInfoModel{}
+		tfStateAuthorizationInfo := sharedmodels.AuthorizationInfoModel{}
 		responseAuthorizationInfo := responseUser.GetAuthorizationInfo()
 
 		if len(responseAuthorizationInfo.GetCertificateUserIds()) > 0 {
@@ -890,7 +893,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateUser.EmployeeLeaveDateTime = types.StringNull()
 	}
 	if responseUser.GetEmployeeOrgData() != nil {
-		tfStateEmployeeOrgData := userEmployeeOrgDataModel{}
+		tfStateEmployeeOrgData := sharedmodels.EmployeeOrgDataModel{}
 		responseEmployeeOrgData := responseUser.GetEmployeeOrgData()
 
 		if responseEmployeeOrgData.GetCostCenter() != nil {
@@ -944,7 +947,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if len(responseUser.GetIdentities()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseObjectIdentity := range responseUser.GetIdentities() {
-			tfStateObjectIdentity := userObjectIdentityModel{}
+			tfStateObjectIdentity := sharedmodels.ObjectIdentityModel{}
 
 			if responseObjectIdentity.GetIssuer() != nil {
 				tfStateObjectIdentity.Issuer = types.StringValue(*responseObjectIdentity.GetIssuer())
@@ -1014,7 +1017,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if len(responseUser.GetLicenseAssignmentStates()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseLicenseAssignmentState := range responseUser.GetLicenseAssignmentStates() {
-			tfStateLicenseAssignmentState := userLicenseAssignmentStateModel{}
+			tfStateLicenseAssignmentState := sharedmodels.LicenseAssignmentStateModel{}
 
 			if responseLicenseAssignmentState.GetAssignedByGroup() != nil {
 				tfStateLicenseAssignmentState.AssignedByGroup = types.StringValue(*responseLicenseAssignmentState.GetAssignedByGroup())
@@ -1091,7 +1094,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateUser.OnPremisesDomainName = types.StringNull()
 	}
 	if responseUser.GetOnPremisesExtensionAttributes() != nil {
-		tfStateOnPremisesExtensionAttributes := userOnPremisesExtensionAttributesModel{}
+		tfStateOnPremisesExtensionAttributes := sharedmodels.OnPremisesExtensionAttributesModel{}
 		responseOnPremisesExtensionAttributes := responseUser.GetOnPremisesExtensionAttributes()
 
 		if responseOnPremisesExtensionAttributes.GetExtensionAttribute1() != nil {
@@ -1185,7 +1188,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if len(responseUser.GetOnPremisesProvisioningErrors()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseOnPremisesProvisioningError := range responseUser.GetOnPremisesProvisioningErrors() {
-			tfStateOnPremisesProvisioningError := userOnPremisesProvisioningErrorModel{}
+			tfStateOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
 
 			if responseOnPremisesProvisioningError.GetCategory() != nil {
 				tfStateOnPremisesProvisioningError.Category = types.StringValue(*responseOnPremisesProvisioningError.GetCategory())
@@ -1248,7 +1251,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateUser.PasswordPolicies = types.StringNull()
 	}
 	if responseUser.GetPasswordProfile() != nil {
-		tfStatePasswordProfile := userPasswordProfileModel{}
+		tfStatePasswordProfile := sharedmodels.PasswordProfileModel{}
 		responsePasswordProfile := responseUser.GetPasswordProfile()
 
 		if responsePasswordProfile.GetForceChangePasswordNextSignIn() != nil {
@@ -1302,7 +1305,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if len(responseUser.GetProvisionedPlans()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseProvisionedPlan := range responseUser.GetProvisionedPlans() {
-			tfStateProvisionedPlan := userProvisionedPlanModel{}
+			tfStateProvisionedPlan := sharedmodels.ProvisionedPlanModel{}
 
 			if responseProvisionedPlan.GetCapabilityStatus() != nil {
 				tfStateProvisionedPlan.CapabilityStatus = types.StringValue(*responseProvisionedPlan.GetCapabilityStatus())
@@ -1362,7 +1365,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if len(responseUser.GetServiceProvisioningErrors()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseServiceProvisioningError := range responseUser.GetServiceProvisioningErrors() {
-			tfStateServiceProvisioningError := userServiceProvisioningErrorModel{}
+			tfStateServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
 
 			if responseServiceProvisioningError.GetCreatedDateTime() != nil {
 				tfStateServiceProvisioningError.CreatedDateTime = types.StringValue(responseServiceProvisioningError.GetCreatedDateTime().String())
@@ -1390,7 +1393,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		tfStateUser.ShowInAddressList = types.BoolNull()
 	}
 	if responseUser.GetSignInActivity() != nil {
-		tfStateSignInActivity := userSignInActivityModel{}
+		tfStateSignInActivity := sharedmodels.SignInActivityModel{}
 		responseSignInActivity := responseUser.GetSignInActivity()
 
 		if responseSignInActivity.GetLastNonInteractiveSignInDateTime() != nil {
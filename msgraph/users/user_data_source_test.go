@@ -0,0 +1,29 @@
+package users_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	config := userResourceConfig + `
+data "msgraph_user" "test" {
+  id = msgraph_user.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.msgraph_user.test", "id", "msgraph_user.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -3,6 +3,8 @@ package users
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 type userModel struct {
@@ -89,9 +91,9 @@ func (m userModel) AttributeTypes() map[string]attr.Type {
 		"about_me":                              types.StringType,
 		"account_enabled":                       types.BoolType,
 		"age_group":                             types.StringType,
-		"assigned_licenses":                     types.ListType{ElemType: types.ObjectType{AttrTypes: userAssignedLicenseModel{}.AttributeTypes()}},
-		"assigned_plans":                        types.ListType{ElemType: types.ObjectType{AttrTypes: userAssignedPlanModel{}.AttributeTypes()}},
-		"authorization_info":                    types.ObjectType{AttrTypes: userAuthorizationInfoModel{}.AttributeTypes()},
+		"assigned_licenses":                     types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.AssignedLicenseModel{}.AttributeTypes()}},
+		"assigned_plans":                        types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.AssignedPlanModel{}.AttributeTypes()}},
+		"authorization_info":                    types.ObjectType{AttrTypes: sharedmodels.AuthorizationInfoModel{}.AttributeTypes()},
 		"birthday":                              types.StringType,
 		"business_phones":                       types.ListType{ElemType: types.StringType},
 		"city":                                  types.StringType,
@@ -106,7 +108,7 @@ func (m userModel) AttributeTypes() map[string]attr.Type {
 		"employee_hire_date":                    types.StringType,
 		"employee_id":                           types.StringType,
 		"employee_leave_date_time":              types.StringType,
-		"employee_org_data":                     types.ObjectType{AttrTypes: userEmployeeOrgDataModel{}.AttributeTypes()},
+		"employee_org_data":                     types.ObjectType{AttrTypes: sharedmodels.EmployeeOrgDataModel{}.AttributeTypes()},
 		"employee_type":                         types.StringType,
 		"external_user_state":                   types.StringType,
 		"external_user_state_change_date_time":  types.StringType,
@@ -114,7 +116,7 @@ func (m userModel) AttributeTypes() map[string]attr.Type {
 		"given_name":                            types.StringType,
 		"hire_date":                             types.StringType,
 		"id":                                    types.StringType,
-		"identities":                            types.ListType{ElemType: types.ObjectType{AttrTypes: userObjectIdentityModel{}.AttributeTypes()}},
+		"identities":                            types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.ObjectIdentityModel{}.AttributeTypes()}},
 		"im_addresses":                          types.ListType{ElemType: types.StringType},
 		"interests":                             types.ListType{ElemType: types.StringType},
 		"is_management_restricted":              types.BoolType,
@@ -122,7 +124,7 @@ func (m userModel) AttributeTypes() map[string]attr.Type {
 		"job_title":                             types.StringType,
 		"last_password_change_date_time":        types.StringType,
 		"legal_age_group_classification":        types.StringType,
-		"license_assignment_states":             types.ListType{ElemType: types.ObjectType{AttrTypes: userLicenseAssignmentStateModel{}.AttributeTypes()}},
+		"license_assignment_states":             types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.LicenseAssignmentStateModel{}.AttributeTypes()}},
 		"mail":                                  types.StringType,
 		"mail_nickname":                         types.StringType,
 		"mobile_phone":                          types.StringType,
@@ -130,30 +132,30 @@ func (m userModel) AttributeTypes() map[string]attr.Type {
 		"office_location":                       types.StringType,
 		"on_premises_distinguished_name":        types.StringType,
 		"on_premises_domain_name":               types.StringType,
-		"on_premises_extension_attributes":      types.ObjectType{AttrTypes: userOnPremisesExtensionAttributesModel{}.AttributeTypes()},
+		"on_premises_extension_attributes":      types.ObjectType{AttrTypes: sharedmodels.OnPremisesExtensionAttributesModel{}.AttributeTypes()},
 		"on_premises_immutable_id":              types.StringType,
 		"on_premises_last_sync_date_time":       types.StringType,
-		"on_premises_provisioning_errors":       types.ListType{ElemType: types.ObjectType{AttrTypes: userOnPremisesProvisioningErrorModel{}.AttributeTypes()}},
+		"on_premises_provisioning_errors":       types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.OnPremisesProvisioningErrorModel{}.AttributeTypes()}},
 		"on_premises_sam_account_name":          types.StringType,
 		"on_premises_security_identifier":       types.StringType,
 		"on_premises_sync_enabled":              types.BoolType,
 		"on_premises_user_principal_name":       types.StringType,
 		"other_mails":                           types.ListType{ElemType: types.StringType},
 		"password_policies":                     types.StringType,
-		"password_profile":                      types.ObjectType{AttrTypes: userPasswordProfileModel{}.AttributeTypes()},
+		"password_profile":                      types.ObjectType{AttrTypes: sharedmodels.PasswordProfileModel{}.AttributeTypes()},
 		"past_projects":                         types.ListType{ElemType: types.StringType},
 		"postal_code":                           types.StringType,
 		"preferred_data_location":               types.StringType,
 		"preferred_language":                    types.StringType,
 		"preferred_name":                        types.StringType,
-		"provisioned_plans":                     types.ListType{ElemType: types.ObjectType{AttrTypes: userProvisionedPlanModel{}.AttributeTypes()}},
+		"provisioned_plans":                     types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.ProvisionedPlanModel{}.AttributeTypes()}},
 		"proxy_addresses":                       types.ListType{ElemType: types.StringType},
 		"responsibilities":                      types.ListType{ElemType: types.StringType},
 		"schools":                               types.ListType{ElemType: types.StringType},
 		"security_identifier":                   types.StringType,
-		"service_provisioning_errors":           types.ListType{ElemType: types.ObjectType{AttrTypes: userServiceProvisioningErrorModel{}.AttributeTypes()}},
+		"service_provisioning_errors":           types.ListType{ElemType: types.ObjectType{AttrTypes: sharedmodels.ServiceProvisioningErrorModel{}.AttributeTypes()}},
 		"show_in_address_list":                  types.BoolType,
-		"sign_in_activity":                      types.ObjectType{AttrTypes: userSignInActivityModel{}.AttributeTypes()},
+		"sign_in_activity":                      types.ObjectType{AttrTypes: sharedmodels.SignInActivityModel{}.AttributeTypes()},
 		"sign_in_sessions_valid_from_date_time": types.StringType,
 		"skills":                                types.ListType{ElemType: types.StringType},
 		"state":                                 types.StringType,
@@ -164,203 +166,3 @@ func (m userModel) AttributeTypes() map[string]attr.Type {
 		"user_type":                             types.StringType,
 	}
 }
-
-type userAssignedLicenseModel struct {
-	DisabledPlans types.List   `tfsdk:"disabled_plans"`
-	SkuId         types.String `tfsdk:"sku_id"`
-}
-
-func (m userAssignedLicenseModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"disabled_plans": types.ListType{ElemType: types.StringType},
-		"sku_id":         types.StringType,
-	}
-}
-
-type userAssignedPlanModel struct {
-	AssignedDateTime types.String `tfsdk:"assigned_date_time"`
-	CapabilityStatus types.String `tfsdk:"capability_status"`
-	Service          types.String `tfsdk:"service"`
-	ServicePlanId    types.String `tfsdk:"service_plan_id"`
-}
-
-func (m userAssignedPlanModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"assigned_date_time": types.StringType,
-		"capability_status":  types.StringType,
-		"service":            types.StringType,
-		"service_plan_id":    types.StringType,
-	}
-}
-
-type userAuthorizationInfoModel struct {
-	CertificateUserIds types.List `tfsdk:"certificate_user_ids"`
-}
-
-func (m userAuthorizationInfoModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"certificate_user_ids": types.ListType{ElemType: types.StringType},
-	}
-}
-
-type userEmployeeOrgDataModel struct {
-	CostCenter types.String `tfsdk:"cost_center"`
-	Division   types.String `tfsdk:"division"`
-}
-
-func (m userEmployeeOrgDataModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"cost_center": types.StringType,
-		"division":    types.StringType,
-	}
-}
-
-type userObjectIdentityModel struct {
-	Issuer           types.String `tfsdk:"issuer"`
-	IssuerAssignedId types.String `tfsdk:"issuer_assigned_id"`
-	SignInType       types.String `tfsdk:"sign_in_type"`
-}
-
-func (m userObjectIdentityModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"issuer":             types.StringType,
-		"issuer_assigned_id": types.StringType,
-		"sign_in_type":       types.StringType,
-	}
-}
-
-type userLicenseAssignmentStateModel struct {
-	AssignedByGroup     types.String `tfsdk:"assigned_by_group"`
-	DisabledPlans       types.List   `tfsdk:"disabled_plans"`
-	Error               types.String `tfsdk:"error"`
-	LastUpdatedDateTime types.String `tfsdk:"last_updated_date_time"`
-	SkuId               types.String `tfsdk:"sku_id"`
-	State               types.String `tfsdk:"state"`
-}
-
-func (m userLicenseAssignmentStateModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"assigned_by_group":      types.StringType,
-		"disabled_plans":         types.ListType{ElemType: types.StringType},
-		"error":                  types.StringType,
-		"last_updated_date_time": types.StringType,
-		"sku_id":                 types.StringType,
-		"state":                  types.StringType,
-	}
-}
-
-type userOnPremisesExtensionAttributesModel struct {
-	ExtensionAttribute1  types.String `tfsdk:"extension_attribute_1"`
-	ExtensionAttribute10 types.String `tfsdk:"extension_attribute_10"`
-	ExtensionAttribute11 types.String `tfsdk:"extension_attribute_11"`
-	ExtensionAttribute12 types.String `tfsdk:"extension_attribute_12"`
-	ExtensionAttribute13 types.String `tfsdk:"extension_attribute_13"`
-	ExtensionAttribute14 types.String `tfsdk:"extension_attribute_14"`
-	ExtensionAttribute15 types.String `tfsdk:"extension_attribute_15"`
-	ExtensionAttribute2  types.String `tfsdk:"extension_attribute_2"`
-	ExtensionAttribute3  types.String `tfsdk:"extension_attribute_3"`
-	ExtensionAttribute4  types.String `tfsdk:"extension_attribute_4"`
-	ExtensionAttribute5  types.String `tfsdk:"extension_attribute_5"`
-	ExtensionAttribute6  types.String `tfsdk:"extension_attribute_6"`
-	ExtensionAttribute7  types.String `tfsdk:"extension_attribute_7"`
-	ExtensionAttribute8  types.String `tfsdk:"extension_attribute_8"`
-	ExtensionAttribute9  types.String `tfsdk:"extension_attribute_9"`
-}
-
-func (m userOnPremisesExtensionAttributesModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"extension_attribute_1":  types.StringType,
-		"extension_attribute_10": types.StringType,
-		"extension_attribute_11": types.StringType,
-		"extension_attribute_12": types.StringType,
-		"extension_attribute_13": types.StringType,
-		"extension_attribute_14": types.StringType,
-		"extension_attribute_15": types.StringType,
-		"extension_attribute_2":  types.StringType,
-		"extension_attribute_3":  types.StringType,
-		"extension_attribute_4":  types.StringType,
-		"extension_attribute_5":  types.StringType,
-		"extension_attribute_6":  types.StringType,
-		"extension_attribute_7":  types.StringType,
-		"extension_attribute_8":  types.StringType,
-		"extension_attribute_9":  types.StringType,
-	}
-}
-
-type userOnPremisesProvisioningErrorModel struct {
-	Category             types.String `tfsdk:"category"`
-	OccurredDateTime     types.String `tfsdk:"occurred_date_time"`
-	PropertyCausingError types.String `tfsdk:"property_causing_error"`
-	Value                types.String `tfsdk:"value"`
-}
-
-func (m userOnPremisesProvisioningErrorModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"category":               types.StringType,
-		"occurred_date_time":     types.StringType,
-		"property_causing_error": types.StringType,
-		"value":                  types.StringType,
-	}
-}
-
-type userPasswordProfileModel struct {
-	ForceChangePasswordNextSignIn        types.Bool   `tfsdk:"force_change_password_next_sign_in"`
-	ForceChangePasswordNextSignInWithMfa types.Bool   `tfsdk:"force_change_password_next_sign_in_with_mfa"`
-	Password                             types.String `tfsdk:"password"`
-}
-
-func (m userPasswordProfileModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"force_change_password_next_sign_in":          types.BoolType,
-		"force_change_password_next_sign_in_with_mfa": types.BoolType,
-		"password": types.StringType,
-	}
-}
-
-type userProvisionedPlanModel struct {
-	CapabilityStatus   types.String `tfsdk:"capability_status"`
-	ProvisioningStatus types.String `tfsdk:"provisioning_status"`
-	Service            types.String `tfsdk:"service"`
-}
-
-func (m userProvisionedPlanModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"capability_status":   types.StringType,
-		"provisioning_status": types.StringType,
-		"service":             types.StringType,
-	}
-}
-
-type userServiceProvisioningErrorModel struct {
-	CreatedDateTime types.String `tfsdk:"created_date_time"`
-	IsResolved      types.Bool   `tfsdk:"is_resolved"`
-	ServiceInstance types.String `tfsdk:"service_instance"`
-}
-
-func (m userServiceProvisioningErrorModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"created_date_time": types.StringType,
-		"is_resolved":       types.BoolType,
-		"service_instance":  types.StringType,
-	}
-}
-
-type userSignInActivityModel struct {
-	LastNonInteractiveSignInDateTime  types.String `tfsdk:"last_non_interactive_sign_in_date_time"`
-	LastNonInteractiveSignInRequestId types.String `tfsdk:"last_non_interactive_sign_in_request_id"`
-	LastSignInDateTime                types.String `tfsdk:"last_sign_in_date_time"`
-	LastSignInRequestId               types.String `tfsdk:"last_sign_in_request_id"`
-	LastSuccessfulSignInDateTime      types.String `tfsdk:"last_successful_sign_in_date_time"`
-	LastSuccessfulSignInRequestId     types.String `tfsdk:"last_successful_sign_in_request_id"`
-}
-
-func (m userSignInActivityModel) AttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"last_non_interactive_sign_in_date_time":  types.StringType,
-		"last_non_interactive_sign_in_request_id": types.StringType,
-		"last_sign_in_date_time":                  types.StringType,
-		"last_sign_in_request_id":                 types.StringType,
-		"last_successful_sign_in_date_time":       types.StringType,
-		"last_successful_sign_in_request_id":      types.StringType,
-	}
-}
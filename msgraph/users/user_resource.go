@@ -2,20 +2,28 @@ package users
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"strings"
 	"time"
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/models/odataerrors"
 	"github.com/microsoftgraph/msgraph-sdk-go/users"
 
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/listplanmodifiers"
 	"terraform-provider-msgraph/planmodifiers/objectplanmodifiers"
@@ -26,6 +34,7 @@ import (
 var (
 	_ resource.Resource              = &userResource{}
 	_ resource.ResourceWithConfigure = &userResource{}
+	_ resource.ResourceWithMoveState = &userResource{}
 )
 
 // NewUserResource is a helper function to simplify the provider implementation.
@@ -38,18 +47,25 @@ type userResource struct {
 	client *msgraphsdk.GraphServiceClient
 }
 
+// userResourceModel is the model of the resource, which has the attributes controlling how it's deleted besides those of the user
+type userResourceModel struct {
+	userModel
+	PreventDeletion   types.Bool `tfsdk:"prevent_deletion"`
+	PermanentlyDelete types.Bool `tfsdk:"permanently_delete"`
+}
+
 // Metadata returns the resource type name.
 func (d *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
 }
 
 // Configure adds the provider configured client to the resource.
-func (d *userResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (d *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the resource.
@@ -69,9 +85,7 @@ func (d *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "true if the account is enabled; otherwise, false. This property is required when a user is created. Returned only on $select. Supports $filter (eq, ne, not, and in).",
 				Optional:    true,
 				Computed:    true,
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifiers.UseStateForUnconfigured(),
-				},
+				Default:     booldefault.StaticBool(true),
 			},
 			"age_group": schema.StringAttribute{
 				Description: "Sets the age group of the user. Allowed values: null, Minor, NotAdult, and Adult. For more information, see legal age group property definitions. Returned only on $select. Supports $filter (eq, ne, not, and in).",
@@ -1131,6 +1145,19 @@ func (d *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifiers.UseStateForUnconfigured(),
 				},
 			},
+
+			"prevent_deletion": schema.BoolAttribute{
+				Description: "Whether deleting the resource fails, to protect the object from being deleted by Terraform. Set it to `false`, and apply, before the resource can be deleted. Not sent to the API. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"permanently_delete": schema.BoolAttribute{
+				Description: "Whether the object is also purged from the deleted items of the directory when the resource is deleted, instead of being kept there for 30 days to be restored. Not sent to the API. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -1138,7 +1165,7 @@ func (d *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 // Create creates the resource and sets the initial Terraform state.
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from Terraform plan
-	var tfPlanUser userModel
+	var tfPlanUser userResourceModel
 	diags := req.Plan.Get(ctx, &tfPlanUser)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1172,7 +1199,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		var requestBodyAssignedLicenses []models.AssignedLicenseable
 		for _, i := range tfPlanUser.AssignedLicenses.Elements() {
 			requestBodyAssignedLicense := models.NewAssignedLicense()
-			tfPlanAssignedLicense := userAssignedLicenseModel{}
+			tfPlanAssignedLicense := sharedmodels.AssignedLicenseModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAssignedLicense)
 
 			if len(tfPlanAssignedLicense.DisabledPlans.Elements()) > 0 {
@@ -1204,7 +1231,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		var requestBodyAssignedPlans []models.AssignedPlanable
 		for _, i := range tfPlanUser.AssignedPlans.Elements() {
 			requestBodyAssignedPlan := models.NewAssignedPlan()
-			tfPlanAssignedPlan := userAssignedPlanModel{}
+			tfPlanAssignedPlan := sharedmodels.AssignedPlanModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAssignedPlan)
 
 			if !tfPlanAssignedPlan.AssignedDateTime.IsUnknown() {
@@ -1245,7 +1272,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	if !tfPlanUser.AuthorizationInfo.IsUnknown() {
 		requestBodyAuthorizationInfo := models.NewAuthorizationInfo()
-		tfPlanAuthorizationInfo := userAuthorizationInfoModel{}
+		tfPlanAuthorizationInfo := sharedmodels.AuthorizationInfoModel{}
 		tfPlanUser.AuthorizationInfo.As(ctx, &tfPlanAuthorizationInfo, basetypes.ObjectAsOptions{})
 
 		if len(tfPlanAuthorizationInfo.CertificateUserIds.Elements()) > 0 {
@@ -1372,7 +1399,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	if !tfPlanUser.EmployeeOrgData.IsUnknown() {
 		requestBodyEmployeeOrgData := models.NewEmployeeOrgData()
-		tfPlanEmployeeOrgData := userEmployeeOrgDataModel{}
+		tfPlanEmployeeOrgData := sharedmodels.EmployeeOrgDataModel{}
 		tfPlanUser.EmployeeOrgData.As(ctx, &tfPlanEmployeeOrgData, basetypes.ObjectAsOptions{})
 
 		if !tfPlanEmployeeOrgData.CostCenter.IsUnknown() {
@@ -1450,7 +1477,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		var requestBodyIdentities []models.ObjectIdentityable
 		for _, i := range tfPlanUser.Identities.Elements() {
 			requestBodyObjectIdentity := models.NewObjectIdentity()
-			tfPlanObjectIdentity := userObjectIdentityModel{}
+			tfPlanObjectIdentity := sharedmodels.ObjectIdentityModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanObjectIdentity)
 
 			if !tfPlanObjectIdentity.Issuer.IsUnknown() {
@@ -1540,7 +1567,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		var requestBodyLicenseAssignmentStates []models.LicenseAssignmentStateable
 		for _, i := range tfPlanUser.LicenseAssignmentStates.Elements() {
 			requestBodyLicenseAssignmentState := models.NewLicenseAssignmentState()
-			tfPlanLicenseAssignmentState := userLicenseAssignmentStateModel{}
+			tfPlanLicenseAssignmentState := sharedmodels.LicenseAssignmentStateModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanLicenseAssignmentState)
 
 			if !tfPlanLicenseAssignmentState.AssignedByGroup.IsUnknown() {
@@ -1648,7 +1675,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	if !tfPlanUser.OnPremisesExtensionAttributes.IsUnknown() {
 		requestBodyOnPremisesExtensionAttributes := models.NewOnPremisesExtensionAttributes()
-		tfPlanOnPremisesExtensionAttributes := userOnPremisesExtensionAttributesModel{}
+		tfPlanOnPremisesExtensionAttributes := sharedmodels.OnPremisesExtensionAttributesModel{}
 		tfPlanUser.OnPremisesExtensionAttributes.As(ctx, &tfPlanOnPremisesExtensionAttributes, basetypes.ObjectAsOptions{})
 
 		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute1.IsUnknown() {
@@ -1781,7 +1808,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		var requestBodyOnPremisesProvisioningErrors []models.OnPremisesProvisioningErrorable
 		for _, i := range tfPlanUser.OnPremisesProvisioningErrors.Elements() {
 			requestBodyOnPremisesProvisioningError := models.NewOnPremisesProvisioningError()
-			tfPlanOnPremisesProvisioningError := userOnPremisesProvisioningErrorModel{}
+			tfPlanOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOnPremisesProvisioningError)
 
 			if !tfPlanOnPremisesProvisioningError.Category.IsUnknown() {
@@ -1866,7 +1893,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	if !tfPlanUser.PasswordProfile.IsUnknown() {
 		requestBodyPasswordProfile := models.NewPasswordProfile()
-		tfPlanPasswordProfile := userPasswordProfileModel{}
+		tfPlanPasswordProfile := sharedmodels.PasswordProfileModel{}
 		tfPlanUser.PasswordProfile.As(ctx, &tfPlanPasswordProfile, basetypes.ObjectAsOptions{})
 
 		if !tfPlanPasswordProfile.ForceChangePasswordNextSignIn.IsUnknown() {
@@ -1938,7 +1965,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		var requestBodyProvisionedPlans []models.ProvisionedPlanable
 		for _, i := range tfPlanUser.ProvisionedPlans.Elements() {
 			requestBodyProvisionedPlan := models.NewProvisionedPlan()
-			tfPlanProvisionedPlan := userProvisionedPlanModel{}
+			tfPlanProvisionedPlan := sharedmodels.ProvisionedPlanModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanProvisionedPlan)
 
 			if !tfPlanProvisionedPlan.CapabilityStatus.IsUnknown() {
@@ -2009,7 +2036,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		var requestBodyServiceProvisioningErrors []models.ServiceProvisioningErrorable
 		for _, i := range tfPlanUser.ServiceProvisioningErrors.Elements() {
 			requestBodyServiceProvisioningError := models.NewServiceProvisioningError()
-			tfPlanServiceProvisioningError := userServiceProvisioningErrorModel{}
+			tfPlanServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
 			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanServiceProvisioningError)
 
 			if !tfPlanServiceProvisioningError.CreatedDateTime.IsUnknown() {
@@ -2049,7 +2076,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	if !tfPlanUser.SignInActivity.IsUnknown() {
 		requestBodySignInActivity := models.NewSignInActivity()
-		tfPlanSignInActivity := userSignInActivityModel{}
+		tfPlanSignInActivity := sharedmodels.SignInActivityModel{}
 		tfPlanUser.SignInActivity.As(ctx, &tfPlanSignInActivity, basetypes.ObjectAsOptions{})
 
 		if !tfPlanSignInActivity.LastNonInteractiveSignInDateTime.IsUnknown() {
@@ -2164,7 +2191,10 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Create new User
-	result, err := r.client.Users().Post(context.Background(), requestBodyUser, nil)
+	created := clients.NewCreatedObject()
+	result, err := r.client.Users().Post(ctx, requestBodyUser, &users.UsersRequestBuilderPostRequestConfiguration{
+		Options: created.Options(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating User",
@@ -2175,7 +2205,15 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Map response body to schema and populate Computed attribute value
 	// TODO: Add support for other Computed values
-	tfPlanUser.Id = types.StringValue(*result.GetId())
+	id, err := created.Id(result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating User",
+			err.Error(),
+		)
+		return
+	}
+	tfPlanUser.Id = types.StringValue(id)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, tfPlanUser)
@@ -2188,7 +2226,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var tfStateUser userModel
+	var tfStateUser userResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &tfStateUser)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -2316,7 +2354,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseUser.GetAssignedLicenses()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAssignedLicense := range responseUser.GetAssignedLicenses() {
-			tfStateAssignedLicense := userAssignedLicenseModel{}
+			tfStateAssignedLicense := sharedmodels.AssignedLicenseModel{}
 
 			if len(responseAssignedLicense.GetDisabledPlans()) > 0 {
 				var valueArrayDisabledPlans []attr.Value
@@ -2340,7 +2378,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseUser.GetAssignedPlans()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseAssignedPlan := range responseUser.GetAssignedPlans() {
-			tfStateAssignedPlan := userAssignedPlanModel{}
+			tfStateAssignedPlan := sharedmodels.AssignedPlanModel{}
 
 			if responseAssignedPlan.GetAssignedDateTime() != nil {
 				tfStateAssignedPlan.AssignedDateTime = types.StringValue(responseAssignedPlan.GetAssignedDateTime().String())
@@ -2368,7 +2406,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		tfStateUser.AssignedPlans, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 	}
 	if responseUser.GetAuthorizationInfo() != nil {
-		tfStateAuthorizationInfo := userAuthorizationInfoModel{}
+		tfStateAuthorizationInfo := sharedmodels.AuthorizationInfoModel{}
 		responseAuthorizationInfo := responseUser.GetAuthorizationInfo()
 
 		if len(responseAuthorizationInfo.GetCertificateUserIds()) > 0 {
@@ -2460,7 +2498,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		tfStateUser.EmployeeLeaveDateTime = types.StringNull()
 	}
 	if responseUser.GetEmployeeOrgData() != nil {
-		tfStateEmployeeOrgData := userEmployeeOrgDataModel{}
+		tfStateEmployeeOrgData := sharedmodels.EmployeeOrgDataModel{}
 		responseEmployeeOrgData := responseUser.GetEmployeeOrgData()
 
 		if responseEmployeeOrgData.GetCostCenter() != nil {
@@ -2514,7 +2552,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseUser.GetIdentities()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseObjectIdentity := range responseUser.GetIdentities() {
-			tfStateObjectIdentity := userObjectIdentityModel{}
+			tfStateObjectIdentity := sharedmodels.ObjectIdentityModel{}
 
 			if responseObjectIdentity.GetIssuer() != nil {
 				tfStateObjectIdentity.Issuer = types.StringValue(*responseObjectIdentity.GetIssuer())
@@ -2584,7 +2622,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseUser.GetLicenseAssignmentStates()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseLicenseAssignmentState := range responseUser.GetLicenseAssignmentStates() {
-			tfStateLicenseAssignmentState := userLicenseAssignmentStateModel{}
+			tfStateLicenseAssignmentState := sharedmodels.LicenseAssignmentStateModel{}
 
 			if responseLicenseAssignmentState.GetAssignedByGroup() != nil {
 				tfStateLicenseAssignmentState.AssignedByGroup = types.StringValue(*responseLicenseAssignmentState.GetAssignedByGroup())
@@ -2661,7 +2699,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		tfStateUser.OnPremisesDomainName = types.StringNull()
 	}
 	if responseUser.GetOnPremisesExtensionAttributes() != nil {
-		tfStateOnPremisesExtensionAttributes := userOnPremisesExtensionAttributesModel{}
+		tfStateOnPremisesExtensionAttributes := sharedmodels.OnPremisesExtensionAttributesModel{}
 		responseOnPremisesExtensionAttributes := responseUser.GetOnPremisesExtensionAttributes()
 
 		if responseOnPremisesExtensionAttributes.GetExtensionAttribute1() != nil {
@@ -2755,7 +2793,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseUser.GetOnPremisesProvisioningErrors()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseOnPremisesProvisioningError := range responseUser.GetOnPremisesProvisioningErrors() {
-			tfStateOnPremisesProvisioningError := userOnPremisesProvisioningErrorModel{}
+			tfStateOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
 
 			if responseOnPremisesProvisioningError.GetCategory() != nil {
 				tfStateOnPremisesProvisioningError.Category = types.StringValue(*responseOnPremisesProvisioningError.GetCategory())
@@ -2818,7 +2856,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		tfStateUser.PasswordPolicies = types.StringNull()
 	}
 	if responseUser.GetPasswordProfile() != nil {
-		tfStatePasswordProfile := userPasswordProfileModel{}
+		tfStatePasswordProfile := sharedmodels.PasswordProfileModel{}
 		responsePasswordProfile := responseUser.GetPasswordProfile()
 
 		if responsePasswordProfile.GetForceChangePasswordNextSignIn() != nil {
@@ -2872,7 +2910,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseUser.GetProvisionedPlans()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseProvisionedPlan := range responseUser.GetProvisionedPlans() {
-			tfStateProvisionedPlan := userProvisionedPlanModel{}
+			tfStateProvisionedPlan := sharedmodels.ProvisionedPlanModel{}
 
 			if responseProvisionedPlan.GetCapabilityStatus() != nil {
 				tfStateProvisionedPlan.CapabilityStatus = types.StringValue(*responseProvisionedPlan.GetCapabilityStatus())
@@ -2932,7 +2970,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if len(responseUser.GetServiceProvisioningErrors()) > 0 {
 		objectValues := []basetypes.ObjectValue{}
 		for _, responseServiceProvisioningError := range responseUser.GetServiceProvisioningErrors() {
-			tfStateServiceProvisioningError := userServiceProvisioningErrorModel{}
+			tfStateServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
 
 			if responseServiceProvisioningError.GetCreatedDateTime() != nil {
 				tfStateServiceProvisioningError.CreatedDateTime = types.StringValue(responseServiceProvisioningError.GetCreatedDateTime().String())
@@ -2960,7 +2998,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		tfStateUser.ShowInAddressList = types.BoolNull()
 	}
 	if responseUser.GetSignInActivity() != nil {
-		tfStateSignInActivity := userSignInActivityModel{}
+		tfStateSignInActivity := sharedmodels.SignInActivityModel{}
 		responseSignInActivity := responseUser.GetSignInActivity()
 
 		if responseSignInActivity.GetLastNonInteractiveSignInDateTime() != nil {
@@ -3042,6 +3080,14 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		tfStateUser.UserType = types.StringNull()
 	}
 
+	// Not set when the resource is imported
+	if tfStateUser.PreventDeletion.IsNull() {
+		tfStateUser.PreventDeletion = types.BoolValue(false)
+	}
+	if tfStateUser.PermanentlyDelete.IsNull() {
+		tfStateUser.PermanentlyDelete = types.BoolValue(false)
+	}
+
 	// Overwrite items with refreshed state
 	diags := resp.State.Set(ctx, &tfStateUser)
 	resp.Diagnostics.Append(diags...)
@@ -3054,7 +3100,7 @@ func (d *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from Terraform plan
-	var tfPlanUser userModel
+	var tfPlanUser userResourceModel
 	diags := req.Plan.Get(ctx, &tfPlanUser)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -3062,7 +3108,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Get current Terraform state
-	var tfStateUser userModel
+	var tfStateUser userResourceModel
 	diags = req.State.Get(ctx, &tfStateUser)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -3088,77 +3134,114 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if !tfPlanUser.AssignedLicenses.Equal(tfStateUser.AssignedLicenses) {
-		var tfPlanAssignedLicenses []models.AssignedLicenseable
-		for k, i := range tfPlanUser.AssignedLicenses.Elements() {
+		var objectArrayAssignedLicenses []models.AssignedLicenseable
+		objectValuesAssignedLicenses := []basetypes.ObjectValue{}
+		tfStateElementsAssignedLicenses := tfStateUser.AssignedLicenses.Elements()
+		for n, i := range tfPlanUser.AssignedLicenses.Elements() {
 			requestBodyAssignedLicense := models.NewAssignedLicense()
-			tfPlanAssignedLicense := userAssignedLicenseModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAssignedLicense)
-			tfStateAssignedLicense := userAssignedLicenseModel{}
-			types.ListValueFrom(ctx, tfStateUser.AssignedLicenses.Elements()[k].Type(ctx), &tfPlanAssignedLicense)
+			tfPlanAssignedLicense := sharedmodels.AssignedLicenseModel{}
+			i.(types.Object).As(ctx, &tfPlanAssignedLicense, basetypes.ObjectAsOptions{})
+			tfStateAssignedLicense := sharedmodels.AssignedLicenseModel{}
+			if n < len(tfStateElementsAssignedLicenses) {
+				tfStateElementsAssignedLicenses[n].(types.Object).As(ctx, &tfStateAssignedLicense, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanAssignedLicense.DisabledPlans.Equal(tfStateAssignedLicense.DisabledPlans) {
-				var DisabledPlans []uuid.UUID
+			if tfPlanAssignedLicense.DisabledPlans.IsUnknown() {
+				tfPlanAssignedLicense.DisabledPlans = tfStateAssignedLicense.DisabledPlans
+			}
+			if !tfPlanAssignedLicense.DisabledPlans.IsNull() {
+				var uuidArrayDisabledPlans []uuid.UUID
 				for _, i := range tfPlanAssignedLicense.DisabledPlans.Elements() {
-					u, _ := uuid.Parse(i.String())
-					DisabledPlans = append(DisabledPlans, u)
+					u, _ := uuid.Parse(i.(types.String).ValueString())
+					uuidArrayDisabledPlans = append(uuidArrayDisabledPlans, u)
 				}
-				requestBodyAssignedLicense.SetDisabledPlans(DisabledPlans)
+				requestBodyAssignedLicense.SetDisabledPlans(uuidArrayDisabledPlans)
 			}
 
-			if !tfPlanAssignedLicense.SkuId.Equal(tfStateAssignedLicense.SkuId) {
+			if tfPlanAssignedLicense.SkuId.IsUnknown() {
+				tfPlanAssignedLicense.SkuId = tfStateAssignedLicense.SkuId
+			}
+			if !tfPlanAssignedLicense.SkuId.IsNull() {
 				tfPlanSkuId := tfPlanAssignedLicense.SkuId.ValueString()
 				u, _ := uuid.Parse(tfPlanSkuId)
 				requestBodyAssignedLicense.SetSkuId(&u)
 			}
+			objectArrayAssignedLicenses = append(objectArrayAssignedLicenses, requestBodyAssignedLicense)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanAssignedLicense.AttributeTypes(), tfPlanAssignedLicense)
+			objectValuesAssignedLicenses = append(objectValuesAssignedLicenses, objectValue)
 		}
-		requestBodyUser.SetAssignedLicenses(tfPlanAssignedLicenses)
+		requestBodyUser.SetAssignedLicenses(objectArrayAssignedLicenses)
+		tfPlanUser.AssignedLicenses, _ = types.ListValueFrom(ctx, tfPlanUser.AssignedLicenses.ElementType(ctx), objectValuesAssignedLicenses)
 	}
 
 	if !tfPlanUser.AssignedPlans.Equal(tfStateUser.AssignedPlans) {
-		var tfPlanAssignedPlans []models.AssignedPlanable
-		for k, i := range tfPlanUser.AssignedPlans.Elements() {
+		var objectArrayAssignedPlans []models.AssignedPlanable
+		objectValuesAssignedPlans := []basetypes.ObjectValue{}
+		tfStateElementsAssignedPlans := tfStateUser.AssignedPlans.Elements()
+		for n, i := range tfPlanUser.AssignedPlans.Elements() {
 			requestBodyAssignedPlan := models.NewAssignedPlan()
-			tfPlanAssignedPlan := userAssignedPlanModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanAssignedPlan)
-			tfStateAssignedPlan := userAssignedPlanModel{}
-			types.ListValueFrom(ctx, tfStateUser.AssignedPlans.Elements()[k].Type(ctx), &tfPlanAssignedPlan)
+			tfPlanAssignedPlan := sharedmodels.AssignedPlanModel{}
+			i.(types.Object).As(ctx, &tfPlanAssignedPlan, basetypes.ObjectAsOptions{})
+			tfStateAssignedPlan := sharedmodels.AssignedPlanModel{}
+			if n < len(tfStateElementsAssignedPlans) {
+				tfStateElementsAssignedPlans[n].(types.Object).As(ctx, &tfStateAssignedPlan, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanAssignedPlan.AssignedDateTime.Equal(tfStateAssignedPlan.AssignedDateTime) {
+			if tfPlanAssignedPlan.AssignedDateTime.IsUnknown() {
+				tfPlanAssignedPlan.AssignedDateTime = tfStateAssignedPlan.AssignedDateTime
+			}
+			if !tfPlanAssignedPlan.AssignedDateTime.IsNull() {
 				tfPlanAssignedDateTime := tfPlanAssignedPlan.AssignedDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanAssignedDateTime)
 				requestBodyAssignedPlan.SetAssignedDateTime(&t)
 			}
 
-			if !tfPlanAssignedPlan.CapabilityStatus.Equal(tfStateAssignedPlan.CapabilityStatus) {
+			if tfPlanAssignedPlan.CapabilityStatus.IsUnknown() {
+				tfPlanAssignedPlan.CapabilityStatus = tfStateAssignedPlan.CapabilityStatus
+			}
+			if !tfPlanAssignedPlan.CapabilityStatus.IsNull() {
 				tfPlanCapabilityStatus := tfPlanAssignedPlan.CapabilityStatus.ValueString()
 				requestBodyAssignedPlan.SetCapabilityStatus(&tfPlanCapabilityStatus)
 			}
 
-			if !tfPlanAssignedPlan.Service.Equal(tfStateAssignedPlan.Service) {
+			if tfPlanAssignedPlan.Service.IsUnknown() {
+				tfPlanAssignedPlan.Service = tfStateAssignedPlan.Service
+			}
+			if !tfPlanAssignedPlan.Service.IsNull() {
 				tfPlanService := tfPlanAssignedPlan.Service.ValueString()
 				requestBodyAssignedPlan.SetService(&tfPlanService)
 			}
 
-			if !tfPlanAssignedPlan.ServicePlanId.Equal(tfStateAssignedPlan.ServicePlanId) {
+			if tfPlanAssignedPlan.ServicePlanId.IsUnknown() {
+				tfPlanAssignedPlan.ServicePlanId = tfStateAssignedPlan.ServicePlanId
+			}
+			if !tfPlanAssignedPlan.ServicePlanId.IsNull() {
 				tfPlanServicePlanId := tfPlanAssignedPlan.ServicePlanId.ValueString()
 				u, _ := uuid.Parse(tfPlanServicePlanId)
 				requestBodyAssignedPlan.SetServicePlanId(&u)
 			}
+			objectArrayAssignedPlans = append(objectArrayAssignedPlans, requestBodyAssignedPlan)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanAssignedPlan.AttributeTypes(), tfPlanAssignedPlan)
+			objectValuesAssignedPlans = append(objectValuesAssignedPlans, objectValue)
 		}
-		requestBodyUser.SetAssignedPlans(tfPlanAssignedPlans)
+		requestBodyUser.SetAssignedPlans(objectArrayAssignedPlans)
+		tfPlanUser.AssignedPlans, _ = types.ListValueFrom(ctx, tfPlanUser.AssignedPlans.ElementType(ctx), objectValuesAssignedPlans)
 	}
 
 	if !tfPlanUser.AuthorizationInfo.Equal(tfStateUser.AuthorizationInfo) {
 		requestBodyAuthorizationInfo := models.NewAuthorizationInfo()
-		tfPlanAuthorizationInfo := userAuthorizationInfoModel{}
+		tfPlanAuthorizationInfo := sharedmodels.AuthorizationInfoModel{}
 		tfPlanUser.AuthorizationInfo.As(ctx, &tfPlanAuthorizationInfo, basetypes.ObjectAsOptions{})
-		tfStateAuthorizationInfo := userAuthorizationInfoModel{}
+		tfStateAuthorizationInfo := sharedmodels.AuthorizationInfoModel{}
 		tfStateUser.AuthorizationInfo.As(ctx, &tfStateAuthorizationInfo, basetypes.ObjectAsOptions{})
 
-		if !tfPlanAuthorizationInfo.CertificateUserIds.Equal(tfStateAuthorizationInfo.CertificateUserIds) {
+		if tfPlanAuthorizationInfo.CertificateUserIds.IsUnknown() {
+			tfPlanAuthorizationInfo.CertificateUserIds = tfStateAuthorizationInfo.CertificateUserIds
+		}
+		if !tfPlanAuthorizationInfo.CertificateUserIds.IsNull() {
 			var stringArrayCertificateUserIds []string
 			for _, i := range tfPlanAuthorizationInfo.CertificateUserIds.Elements() {
-				stringArrayCertificateUserIds = append(stringArrayCertificateUserIds, i.String())
+				stringArrayCertificateUserIds = append(stringArrayCertificateUserIds, i.(types.String).ValueString())
 			}
 			requestBodyAuthorizationInfo.SetCertificateUserIds(stringArrayCertificateUserIds)
 		}
@@ -3175,7 +3258,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !tfPlanUser.BusinessPhones.Equal(tfStateUser.BusinessPhones) {
 		var stringArrayBusinessPhones []string
 		for _, i := range tfPlanUser.BusinessPhones.Elements() {
-			stringArrayBusinessPhones = append(stringArrayBusinessPhones, i.String())
+			stringArrayBusinessPhones = append(stringArrayBusinessPhones, i.(types.String).ValueString())
 		}
 		requestBodyUser.SetBusinessPhones(stringArrayBusinessPhones)
 	}
@@ -3246,17 +3329,23 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	if !tfPlanUser.EmployeeOrgData.Equal(tfStateUser.EmployeeOrgData) {
 		requestBodyEmployeeOrgData := models.NewEmployeeOrgData()
-		tfPlanEmployeeOrgData := userEmployeeOrgDataModel{}
+		tfPlanEmployeeOrgData := sharedmodels.EmployeeOrgDataModel{}
 		tfPlanUser.EmployeeOrgData.As(ctx, &tfPlanEmployeeOrgData, basetypes.ObjectAsOptions{})
-		tfStateEmployeeOrgData := userEmployeeOrgDataModel{}
+		tfStateEmployeeOrgData := sharedmodels.EmployeeOrgDataModel{}
 		tfStateUser.EmployeeOrgData.As(ctx, &tfStateEmployeeOrgData, basetypes.ObjectAsOptions{})
 
-		if !tfPlanEmployeeOrgData.CostCenter.Equal(tfStateEmployeeOrgData.CostCenter) {
+		if tfPlanEmployeeOrgData.CostCenter.IsUnknown() {
+			tfPlanEmployeeOrgData.CostCenter = tfStateEmployeeOrgData.CostCenter
+		}
+		if !tfPlanEmployeeOrgData.CostCenter.IsNull() {
 			tfPlanCostCenter := tfPlanEmployeeOrgData.CostCenter.ValueString()
 			requestBodyEmployeeOrgData.SetCostCenter(&tfPlanCostCenter)
 		}
 
-		if !tfPlanEmployeeOrgData.Division.Equal(tfStateEmployeeOrgData.Division) {
+		if tfPlanEmployeeOrgData.Division.IsUnknown() {
+			tfPlanEmployeeOrgData.Division = tfStateEmployeeOrgData.Division
+		}
+		if !tfPlanEmployeeOrgData.Division.IsNull() {
 			tfPlanDivision := tfPlanEmployeeOrgData.Division.ValueString()
 			requestBodyEmployeeOrgData.SetDivision(&tfPlanDivision)
 		}
@@ -3302,36 +3391,53 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if !tfPlanUser.Identities.Equal(tfStateUser.Identities) {
-		var tfPlanIdentities []models.ObjectIdentityable
-		for k, i := range tfPlanUser.Identities.Elements() {
+		var objectArrayIdentities []models.ObjectIdentityable
+		objectValuesIdentities := []basetypes.ObjectValue{}
+		tfStateElementsIdentities := tfStateUser.Identities.Elements()
+		for n, i := range tfPlanUser.Identities.Elements() {
 			requestBodyObjectIdentity := models.NewObjectIdentity()
-			tfPlanObjectIdentity := userObjectIdentityModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanObjectIdentity)
-			tfStateObjectIdentity := userObjectIdentityModel{}
-			types.ListValueFrom(ctx, tfStateUser.Identities.Elements()[k].Type(ctx), &tfPlanObjectIdentity)
+			tfPlanObjectIdentity := sharedmodels.ObjectIdentityModel{}
+			i.(types.Object).As(ctx, &tfPlanObjectIdentity, basetypes.ObjectAsOptions{})
+			tfStateObjectIdentity := sharedmodels.ObjectIdentityModel{}
+			if n < len(tfStateElementsIdentities) {
+				tfStateElementsIdentities[n].(types.Object).As(ctx, &tfStateObjectIdentity, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanObjectIdentity.Issuer.Equal(tfStateObjectIdentity.Issuer) {
+			if tfPlanObjectIdentity.Issuer.IsUnknown() {
+				tfPlanObjectIdentity.Issuer = tfStateObjectIdentity.Issuer
+			}
+			if !tfPlanObjectIdentity.Issuer.IsNull() {
 				tfPlanIssuer := tfPlanObjectIdentity.Issuer.ValueString()
 				requestBodyObjectIdentity.SetIssuer(&tfPlanIssuer)
 			}
 
-			if !tfPlanObjectIdentity.IssuerAssignedId.Equal(tfStateObjectIdentity.IssuerAssignedId) {
+			if tfPlanObjectIdentity.IssuerAssignedId.IsUnknown() {
+				tfPlanObjectIdentity.IssuerAssignedId = tfStateObjectIdentity.IssuerAssignedId
+			}
+			if !tfPlanObjectIdentity.IssuerAssignedId.IsNull() {
 				tfPlanIssuerAssignedId := tfPlanObjectIdentity.IssuerAssignedId.ValueString()
 				requestBodyObjectIdentity.SetIssuerAssignedId(&tfPlanIssuerAssignedId)
 			}
 
-			if !tfPlanObjectIdentity.SignInType.Equal(tfStateObjectIdentity.SignInType) {
+			if tfPlanObjectIdentity.SignInType.IsUnknown() {
+				tfPlanObjectIdentity.SignInType = tfStateObjectIdentity.SignInType
+			}
+			if !tfPlanObjectIdentity.SignInType.IsNull() {
 				tfPlanSignInType := tfPlanObjectIdentity.SignInType.ValueString()
 				requestBodyObjectIdentity.SetSignInType(&tfPlanSignInType)
 			}
+			objectArrayIdentities = append(objectArrayIdentities, requestBodyObjectIdentity)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanObjectIdentity.AttributeTypes(), tfPlanObjectIdentity)
+			objectValuesIdentities = append(objectValuesIdentities, objectValue)
 		}
-		requestBodyUser.SetIdentities(tfPlanIdentities)
+		requestBodyUser.SetIdentities(objectArrayIdentities)
+		tfPlanUser.Identities, _ = types.ListValueFrom(ctx, tfPlanUser.Identities.ElementType(ctx), objectValuesIdentities)
 	}
 
 	if !tfPlanUser.ImAddresses.Equal(tfStateUser.ImAddresses) {
 		var stringArrayImAddresses []string
 		for _, i := range tfPlanUser.ImAddresses.Elements() {
-			stringArrayImAddresses = append(stringArrayImAddresses, i.String())
+			stringArrayImAddresses = append(stringArrayImAddresses, i.(types.String).ValueString())
 		}
 		requestBodyUser.SetImAddresses(stringArrayImAddresses)
 	}
@@ -3339,7 +3445,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !tfPlanUser.Interests.Equal(tfStateUser.Interests) {
 		var stringArrayInterests []string
 		for _, i := range tfPlanUser.Interests.Elements() {
-			stringArrayInterests = append(stringArrayInterests, i.String())
+			stringArrayInterests = append(stringArrayInterests, i.(types.String).ValueString())
 		}
 		requestBodyUser.SetInterests(stringArrayInterests)
 	}
@@ -3371,51 +3477,77 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if !tfPlanUser.LicenseAssignmentStates.Equal(tfStateUser.LicenseAssignmentStates) {
-		var tfPlanLicenseAssignmentStates []models.LicenseAssignmentStateable
-		for k, i := range tfPlanUser.LicenseAssignmentStates.Elements() {
+		var objectArrayLicenseAssignmentStates []models.LicenseAssignmentStateable
+		objectValuesLicenseAssignmentStates := []basetypes.ObjectValue{}
+		tfStateElementsLicenseAssignmentStates := tfStateUser.LicenseAssignmentStates.Elements()
+		for n, i := range tfPlanUser.LicenseAssignmentStates.Elements() {
 			requestBodyLicenseAssignmentState := models.NewLicenseAssignmentState()
-			tfPlanLicenseAssignmentState := userLicenseAssignmentStateModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanLicenseAssignmentState)
-			tfStateLicenseAssignmentState := userLicenseAssignmentStateModel{}
-			types.ListValueFrom(ctx, tfStateUser.LicenseAssignmentStates.Elements()[k].Type(ctx), &tfPlanLicenseAssignmentState)
+			tfPlanLicenseAssignmentState := sharedmodels.LicenseAssignmentStateModel{}
+			i.(types.Object).As(ctx, &tfPlanLicenseAssignmentState, basetypes.ObjectAsOptions{})
+			tfStateLicenseAssignmentState := sharedmodels.LicenseAssignmentStateModel{}
+			if n < len(tfStateElementsLicenseAssignmentStates) {
+				tfStateElementsLicenseAssignmentStates[n].(types.Object).As(ctx, &tfStateLicenseAssignmentState, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanLicenseAssignmentState.AssignedByGroup.Equal(tfStateLicenseAssignmentState.AssignedByGroup) {
+			if tfPlanLicenseAssignmentState.AssignedByGroup.IsUnknown() {
+				tfPlanLicenseAssignmentState.AssignedByGroup = tfStateLicenseAssignmentState.AssignedByGroup
+			}
+			if !tfPlanLicenseAssignmentState.AssignedByGroup.IsNull() {
 				tfPlanAssignedByGroup := tfPlanLicenseAssignmentState.AssignedByGroup.ValueString()
 				requestBodyLicenseAssignmentState.SetAssignedByGroup(&tfPlanAssignedByGroup)
 			}
 
-			if !tfPlanLicenseAssignmentState.DisabledPlans.Equal(tfStateLicenseAssignmentState.DisabledPlans) {
-				var DisabledPlans []uuid.UUID
+			if tfPlanLicenseAssignmentState.DisabledPlans.IsUnknown() {
+				tfPlanLicenseAssignmentState.DisabledPlans = tfStateLicenseAssignmentState.DisabledPlans
+			}
+			if !tfPlanLicenseAssignmentState.DisabledPlans.IsNull() {
+				var uuidArrayDisabledPlans []uuid.UUID
 				for _, i := range tfPlanLicenseAssignmentState.DisabledPlans.Elements() {
-					u, _ := uuid.Parse(i.String())
-					DisabledPlans = append(DisabledPlans, u)
+					u, _ := uuid.Parse(i.(types.String).ValueString())
+					uuidArrayDisabledPlans = append(uuidArrayDisabledPlans, u)
 				}
-				requestBodyLicenseAssignmentState.SetDisabledPlans(DisabledPlans)
+				requestBodyLicenseAssignmentState.SetDisabledPlans(uuidArrayDisabledPlans)
 			}
 
-			if !tfPlanLicenseAssignmentState.Error.Equal(tfStateLicenseAssignmentState.Error) {
+			if tfPlanLicenseAssignmentState.Error.IsUnknown() {
+				tfPlanLicenseAssignmentState.Error = tfStateLicenseAssignmentState.Error
+			}
+			if !tfPlanLicenseAssignmentState.Error.IsNull() {
 				tfPlanError := tfPlanLicenseAssignmentState.Error.ValueString()
 				requestBodyLicenseAssignmentState.SetError(&tfPlanError)
 			}
 
-			if !tfPlanLicenseAssignmentState.LastUpdatedDateTime.Equal(tfStateLicenseAssignmentState.LastUpdatedDateTime) {
+			if tfPlanLicenseAssignmentState.LastUpdatedDateTime.IsUnknown() {
+				tfPlanLicenseAssignmentState.LastUpdatedDateTime = tfStateLicenseAssignmentState.LastUpdatedDateTime
+			}
+			if !tfPlanLicenseAssignmentState.LastUpdatedDateTime.IsNull() {
 				tfPlanLastUpdatedDateTime := tfPlanLicenseAssignmentState.LastUpdatedDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanLastUpdatedDateTime)
 				requestBodyLicenseAssignmentState.SetLastUpdatedDateTime(&t)
 			}
 
-			if !tfPlanLicenseAssignmentState.SkuId.Equal(tfStateLicenseAssignmentState.SkuId) {
+			if tfPlanLicenseAssignmentState.SkuId.IsUnknown() {
+				tfPlanLicenseAssignmentState.SkuId = tfStateLicenseAssignmentState.SkuId
+			}
+			if !tfPlanLicenseAssignmentState.SkuId.IsNull() {
 				tfPlanSkuId := tfPlanLicenseAssignmentState.SkuId.ValueString()
 				u, _ := uuid.Parse(tfPlanSkuId)
 				requestBodyLicenseAssignmentState.SetSkuId(&u)
 			}
 
-			if !tfPlanLicenseAssignmentState.State.Equal(tfStateLicenseAssignmentState.State) {
+			if tfPlanLicenseAssignmentState.State.IsUnknown() {
+				tfPlanLicenseAssignmentState.State = tfStateLicenseAssignmentState.State
+			}
+			if !tfPlanLicenseAssignmentState.State.IsNull() {
 				tfPlanState := tfPlanLicenseAssignmentState.State.ValueString()
 				requestBodyLicenseAssignmentState.SetState(&tfPlanState)
 			}
+			objectArrayLicenseAssignmentStates = append(objectArrayLicenseAssignmentStates, requestBodyLicenseAssignmentState)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanLicenseAssignmentState.AttributeTypes(), tfPlanLicenseAssignmentState)
+			objectValuesLicenseAssignmentStates = append(objectValuesLicenseAssignmentStates, objectValue)
 		}
-		requestBodyUser.SetLicenseAssignmentStates(tfPlanLicenseAssignmentStates)
+		requestBodyUser.SetLicenseAssignmentStates(objectArrayLicenseAssignmentStates)
+		tfPlanUser.LicenseAssignmentStates, _ = types.ListValueFrom(ctx, tfPlanUser.LicenseAssignmentStates.ElementType(ctx), objectValuesLicenseAssignmentStates)
 	}
 
 	if !tfPlanUser.Mail.Equal(tfStateUser.Mail) {
@@ -3455,82 +3587,127 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	if !tfPlanUser.OnPremisesExtensionAttributes.Equal(tfStateUser.OnPremisesExtensionAttributes) {
 		requestBodyOnPremisesExtensionAttributes := models.NewOnPremisesExtensionAttributes()
-		tfPlanOnPremisesExtensionAttributes := userOnPremisesExtensionAttributesModel{}
+		tfPlanOnPremisesExtensionAttributes := sharedmodels.OnPremisesExtensionAttributesModel{}
 		tfPlanUser.OnPremisesExtensionAttributes.As(ctx, &tfPlanOnPremisesExtensionAttributes, basetypes.ObjectAsOptions{})
-		tfStateOnPremisesExtensionAttributes := userOnPremisesExtensionAttributesModel{}
+		tfStateOnPremisesExtensionAttributes := sharedmodels.OnPremisesExtensionAttributesModel{}
 		tfStateUser.OnPremisesExtensionAttributes.As(ctx, &tfStateOnPremisesExtensionAttributes, basetypes.ObjectAsOptions{})
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute1.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute1) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute1.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute1 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute1
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute1.IsNull() {
 			tfPlanExtensionAttribute1 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute1.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute1(&tfPlanExtensionAttribute1)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute10.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute10) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute10.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute10 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute10
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute10.IsNull() {
 			tfPlanExtensionAttribute10 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute10.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute10(&tfPlanExtensionAttribute10)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute11.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute11) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute11.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute11 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute11
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute11.IsNull() {
 			tfPlanExtensionAttribute11 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute11.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute11(&tfPlanExtensionAttribute11)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute12.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute12) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute12.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute12 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute12
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute12.IsNull() {
 			tfPlanExtensionAttribute12 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute12.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute12(&tfPlanExtensionAttribute12)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute13.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute13) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute13.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute13 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute13
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute13.IsNull() {
 			tfPlanExtensionAttribute13 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute13.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute13(&tfPlanExtensionAttribute13)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute14.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute14) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute14.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute14 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute14
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute14.IsNull() {
 			tfPlanExtensionAttribute14 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute14.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute14(&tfPlanExtensionAttribute14)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute15.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute15) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute15.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute15 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute15
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute15.IsNull() {
 			tfPlanExtensionAttribute15 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute15.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute15(&tfPlanExtensionAttribute15)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute2.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute2) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute2.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute2 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute2
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute2.IsNull() {
 			tfPlanExtensionAttribute2 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute2.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute2(&tfPlanExtensionAttribute2)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute3.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute3) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute3.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute3 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute3
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute3.IsNull() {
 			tfPlanExtensionAttribute3 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute3.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute3(&tfPlanExtensionAttribute3)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute4.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute4) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute4.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute4 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute4
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute4.IsNull() {
 			tfPlanExtensionAttribute4 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute4.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute4(&tfPlanExtensionAttribute4)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute5.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute5) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute5.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute5 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute5
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute5.IsNull() {
 			tfPlanExtensionAttribute5 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute5.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute5(&tfPlanExtensionAttribute5)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute6.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute6) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute6.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute6 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute6
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute6.IsNull() {
 			tfPlanExtensionAttribute6 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute6.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute6(&tfPlanExtensionAttribute6)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute7.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute7) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute7.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute7 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute7
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute7.IsNull() {
 			tfPlanExtensionAttribute7 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute7.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute7(&tfPlanExtensionAttribute7)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute8.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute8) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute8.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute8 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute8
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute8.IsNull() {
 			tfPlanExtensionAttribute8 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute8.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute8(&tfPlanExtensionAttribute8)
 		}
 
-		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute9.Equal(tfStateOnPremisesExtensionAttributes.ExtensionAttribute9) {
+		if tfPlanOnPremisesExtensionAttributes.ExtensionAttribute9.IsUnknown() {
+			tfPlanOnPremisesExtensionAttributes.ExtensionAttribute9 = tfStateOnPremisesExtensionAttributes.ExtensionAttribute9
+		}
+		if !tfPlanOnPremisesExtensionAttributes.ExtensionAttribute9.IsNull() {
 			tfPlanExtensionAttribute9 := tfPlanOnPremisesExtensionAttributes.ExtensionAttribute9.ValueString()
 			requestBodyOnPremisesExtensionAttributes.SetExtensionAttribute9(&tfPlanExtensionAttribute9)
 		}
@@ -3550,36 +3727,56 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if !tfPlanUser.OnPremisesProvisioningErrors.Equal(tfStateUser.OnPremisesProvisioningErrors) {
-		var tfPlanOnPremisesProvisioningErrors []models.OnPremisesProvisioningErrorable
-		for k, i := range tfPlanUser.OnPremisesProvisioningErrors.Elements() {
+		var objectArrayOnPremisesProvisioningErrors []models.OnPremisesProvisioningErrorable
+		objectValuesOnPremisesProvisioningErrors := []basetypes.ObjectValue{}
+		tfStateElementsOnPremisesProvisioningErrors := tfStateUser.OnPremisesProvisioningErrors.Elements()
+		for n, i := range tfPlanUser.OnPremisesProvisioningErrors.Elements() {
 			requestBodyOnPremisesProvisioningError := models.NewOnPremisesProvisioningError()
-			tfPlanOnPremisesProvisioningError := userOnPremisesProvisioningErrorModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanOnPremisesProvisioningError)
-			tfStateOnPremisesProvisioningError := userOnPremisesProvisioningErrorModel{}
-			types.ListValueFrom(ctx, tfStateUser.OnPremisesProvisioningErrors.Elements()[k].Type(ctx), &tfPlanOnPremisesProvisioningError)
+			tfPlanOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
+			i.(types.Object).As(ctx, &tfPlanOnPremisesProvisioningError, basetypes.ObjectAsOptions{})
+			tfStateOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
+			if n < len(tfStateElementsOnPremisesProvisioningErrors) {
+				tfStateElementsOnPremisesProvisioningErrors[n].(types.Object).As(ctx, &tfStateOnPremisesProvisioningError, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanOnPremisesProvisioningError.Category.Equal(tfStateOnPremisesProvisioningError.Category) {
+			if tfPlanOnPremisesProvisioningError.Category.IsUnknown() {
+				tfPlanOnPremisesProvisioningError.Category = tfStateOnPremisesProvisioningError.Category
+			}
+			if !tfPlanOnPremisesProvisioningError.Category.IsNull() {
 				tfPlanCategory := tfPlanOnPremisesProvisioningError.Category.ValueString()
 				requestBodyOnPremisesProvisioningError.SetCategory(&tfPlanCategory)
 			}
 
-			if !tfPlanOnPremisesProvisioningError.OccurredDateTime.Equal(tfStateOnPremisesProvisioningError.OccurredDateTime) {
+			if tfPlanOnPremisesProvisioningError.OccurredDateTime.IsUnknown() {
+				tfPlanOnPremisesProvisioningError.OccurredDateTime = tfStateOnPremisesProvisioningError.OccurredDateTime
+			}
+			if !tfPlanOnPremisesProvisioningError.OccurredDateTime.IsNull() {
 				tfPlanOccurredDateTime := tfPlanOnPremisesProvisioningError.OccurredDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanOccurredDateTime)
 				requestBodyOnPremisesProvisioningError.SetOccurredDateTime(&t)
 			}
 
-			if !tfPlanOnPremisesProvisioningError.PropertyCausingError.Equal(tfStateOnPremisesProvisioningError.PropertyCausingError) {
+			if tfPlanOnPremisesProvisioningError.PropertyCausingError.IsUnknown() {
+				tfPlanOnPremisesProvisioningError.PropertyCausingError = tfStateOnPremisesProvisioningError.PropertyCausingError
+			}
+			if !tfPlanOnPremisesProvisioningError.PropertyCausingError.IsNull() {
 				tfPlanPropertyCausingError := tfPlanOnPremisesProvisioningError.PropertyCausingError.ValueString()
 				requestBodyOnPremisesProvisioningError.SetPropertyCausingError(&tfPlanPropertyCausingError)
 			}
 
-			if !tfPlanOnPremisesProvisioningError.Value.Equal(tfStateOnPremisesProvisioningError.Value) {
+			if tfPlanOnPremisesProvisioningError.Value.IsUnknown() {
+				tfPlanOnPremisesProvisioningError.Value = tfStateOnPremisesProvisioningError.Value
+			}
+			if !tfPlanOnPremisesProvisioningError.Value.IsNull() {
 				tfPlanValue := tfPlanOnPremisesProvisioningError.Value.ValueString()
 				requestBodyOnPremisesProvisioningError.SetValue(&tfPlanValue)
 			}
+			objectArrayOnPremisesProvisioningErrors = append(objectArrayOnPremisesProvisioningErrors, requestBodyOnPremisesProvisioningError)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanOnPremisesProvisioningError.AttributeTypes(), tfPlanOnPremisesProvisioningError)
+			objectValuesOnPremisesProvisioningErrors = append(objectValuesOnPremisesProvisioningErrors, objectValue)
 		}
-		requestBodyUser.SetOnPremisesProvisioningErrors(tfPlanOnPremisesProvisioningErrors)
+		requestBodyUser.SetOnPremisesProvisioningErrors(objectArrayOnPremisesProvisioningErrors)
+		tfPlanUser.OnPremisesProvisioningErrors, _ = types.ListValueFrom(ctx, tfPlanUser.OnPremisesProvisioningErrors.ElementType(ctx), objectValuesOnPremisesProvisioningErrors)
 	}
 
 	if !tfPlanUser.OnPremisesSamAccountName.Equal(tfStateUser.OnPremisesSamAccountName) {
@@ -3605,7 +3802,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !tfPlanUser.OtherMails.Equal(tfStateUser.OtherMails) {
 		var stringArrayOtherMails []string
 		for _, i := range tfPlanUser.OtherMails.Elements() {
-			stringArrayOtherMails = append(stringArrayOtherMails, i.String())
+			stringArrayOtherMails = append(stringArrayOtherMails, i.(types.String).ValueString())
 		}
 		requestBodyUser.SetOtherMails(stringArrayOtherMails)
 	}
@@ -3617,22 +3814,31 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	if !tfPlanUser.PasswordProfile.Equal(tfStateUser.PasswordProfile) {
 		requestBodyPasswordProfile := models.NewPasswordProfile()
-		tfPlanPasswordProfile := userPasswordProfileModel{}
+		tfPlanPasswordProfile := sharedmodels.PasswordProfileModel{}
 		tfPlanUser.PasswordProfile.As(ctx, &tfPlanPasswordProfile, basetypes.ObjectAsOptions{})
-		tfStatePasswordProfile := userPasswordProfileModel{}
+		tfStatePasswordProfile := sharedmodels.PasswordProfileModel{}
 		tfStateUser.PasswordProfile.As(ctx, &tfStatePasswordProfile, basetypes.ObjectAsOptions{})
 
-		if !tfPlanPasswordProfile.ForceChangePasswordNextSignIn.Equal(tfStatePasswordProfile.ForceChangePasswordNextSignIn) {
+		if tfPlanPasswordProfile.ForceChangePasswordNextSignIn.IsUnknown() {
+			tfPlanPasswordProfile.ForceChangePasswordNextSignIn = tfStatePasswordProfile.ForceChangePasswordNextSignIn
+		}
+		if !tfPlanPasswordProfile.ForceChangePasswordNextSignIn.IsNull() {
 			tfPlanForceChangePasswordNextSignIn := tfPlanPasswordProfile.ForceChangePasswordNextSignIn.ValueBool()
 			requestBodyPasswordProfile.SetForceChangePasswordNextSignIn(&tfPlanForceChangePasswordNextSignIn)
 		}
 
-		if !tfPlanPasswordProfile.ForceChangePasswordNextSignInWithMfa.Equal(tfStatePasswordProfile.ForceChangePasswordNextSignInWithMfa) {
+		if tfPlanPasswordProfile.ForceChangePasswordNextSignInWithMfa.IsUnknown() {
+			tfPlanPasswordProfile.ForceChangePasswordNextSignInWithMfa = tfStatePasswordProfile.ForceChangePasswordNextSignInWithMfa
+		}
+		if !tfPlanPasswordProfile.ForceChangePasswordNextSignInWithMfa.IsNull() {
 			tfPlanForceChangePasswordNextSignInWithMfa := tfPlanPasswordProfile.ForceChangePasswordNextSignInWithMfa.ValueBool()
 			requestBodyPasswordProfile.SetForceChangePasswordNextSignInWithMfa(&tfPlanForceChangePasswordNextSignInWithMfa)
 		}
 
-		if !tfPlanPasswordProfile.Password.Equal(tfStatePasswordProfile.Password) {
+		if tfPlanPasswordProfile.Password.IsUnknown() {
+			tfPlanPasswordProfile.Password = tfStatePasswordProfile.Password
+		}
+		if !tfPlanPasswordProfile.Password.IsNull() {
 			tfPlanPassword := tfPlanPasswordProfile.Password.ValueString()
 			requestBodyPasswordProfile.SetPassword(&tfPlanPassword)
 		}
@@ -3643,7 +3849,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !tfPlanUser.PastProjects.Equal(tfStateUser.PastProjects) {
 		var stringArrayPastProjects []string
 		for _, i := range tfPlanUser.PastProjects.Elements() {
-			stringArrayPastProjects = append(stringArrayPastProjects, i.String())
+			stringArrayPastProjects = append(stringArrayPastProjects, i.(types.String).ValueString())
 		}
 		requestBodyUser.SetPastProjects(stringArrayPastProjects)
 	}
@@ -3669,36 +3875,53 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if !tfPlanUser.ProvisionedPlans.Equal(tfStateUser.ProvisionedPlans) {
-		var tfPlanProvisionedPlans []models.ProvisionedPlanable
-		for k, i := range tfPlanUser.ProvisionedPlans.Elements() {
+		var objectArrayProvisionedPlans []models.ProvisionedPlanable
+		objectValuesProvisionedPlans := []basetypes.ObjectValue{}
+		tfStateElementsProvisionedPlans := tfStateUser.ProvisionedPlans.Elements()
+		for n, i := range tfPlanUser.ProvisionedPlans.Elements() {
 			requestBodyProvisionedPlan := models.NewProvisionedPlan()
-			tfPlanProvisionedPlan := userProvisionedPlanModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanProvisionedPlan)
-			tfStateProvisionedPlan := userProvisionedPlanModel{}
-			types.ListValueFrom(ctx, tfStateUser.ProvisionedPlans.Elements()[k].Type(ctx), &tfPlanProvisionedPlan)
+			tfPlanProvisionedPlan := sharedmodels.ProvisionedPlanModel{}
+			i.(types.Object).As(ctx, &tfPlanProvisionedPlan, basetypes.ObjectAsOptions{})
+			tfStateProvisionedPlan := sharedmodels.ProvisionedPlanModel{}
+			if n < len(tfStateElementsProvisionedPlans) {
+				tfStateElementsProvisionedPlans[n].(types.Object).As(ctx, &tfStateProvisionedPlan, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanProvisionedPlan.CapabilityStatus.Equal(tfStateProvisionedPlan.CapabilityStatus) {
+			if tfPlanProvisionedPlan.CapabilityStatus.IsUnknown() {
+				tfPlanProvisionedPlan.CapabilityStatus = tfStateProvisionedPlan.CapabilityStatus
+			}
+			if !tfPlanProvisionedPlan.CapabilityStatus.IsNull() {
 				tfPlanCapabilityStatus := tfPlanProvisionedPlan.CapabilityStatus.ValueString()
 				requestBodyProvisionedPlan.SetCapabilityStatus(&tfPlanCapabilityStatus)
 			}
 
-			if !tfPlanProvisionedPlan.ProvisioningStatus.Equal(tfStateProvisionedPlan.ProvisioningStatus) {
+			if tfPlanProvisionedPlan.ProvisioningStatus.IsUnknown() {
+				tfPlanProvisionedPlan.ProvisioningStatus = tfStateProvisionedPlan.ProvisioningStatus
+			}
+			if !tfPlanProvisionedPlan.ProvisioningStatus.IsNull() {
 				tfPlanProvisioningStatus := tfPlanProvisionedPlan.ProvisioningStatus.ValueString()
 				requestBodyProvisionedPlan.SetProvisioningStatus(&tfPlanProvisioningStatus)
 			}
 
-			if !tfPlanProvisionedPlan.Service.Equal(tfStateProvisionedPlan.Service) {
+			if tfPlanProvisionedPlan.Service.IsUnknown() {
+				tfPlanProvisionedPlan.Service = tfStateProvisionedPlan.Service
+			}
+			if !tfPlanProvisionedPlan.Service.IsNull() {
 				tfPlanService := tfPlanProvisionedPlan.Service.ValueString()
 				requestBodyProvisionedPlan.SetService(&tfPlanService)
 			}
+			objectArrayProvisionedPlans = append(objectArrayProvisionedPlans, requestBodyProvisionedPlan)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanProvisionedPlan.AttributeTypes(), tfPlanProvisionedPlan)
+			objectValuesProvisionedPlans = append(objectValuesProvisionedPlans, objectValue)
 		}
-		requestBodyUser.SetProvisionedPlans(tfPlanProvisionedPlans)
+		requestBodyUser.SetProvisionedPlans(objectArrayProvisionedPlans)
+		tfPlanUser.ProvisionedPlans, _ = types.ListValueFrom(ctx, tfPlanUser.ProvisionedPlans.ElementType(ctx), objectValuesProvisionedPlans)
 	}
 
 	if !tfPlanUser.ProxyAddresses.Equal(tfStateUser.ProxyAddresses) {
 		var stringArrayProxyAddresses []string
 		for _, i := range tfPlanUser.ProxyAddresses.Elements() {
-			stringArrayProxyAddresses = append(stringArrayProxyAddresses, i.String())
+			stringArrayProxyAddresses = append(stringArrayProxyAddresses, i.(types.String).ValueString())
 		}
 		requestBodyUser.SetProxyAddresses(stringArrayProxyAddresses)
 	}
@@ -3706,7 +3929,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !tfPlanUser.Responsibilities.Equal(tfStateUser.Responsibilities) {
 		var stringArrayResponsibilities []string
 		for _, i := range tfPlanUser.Responsibilities.Elements() {
-			stringArrayResponsibilities = append(stringArrayResponsibilities, i.String())
+			stringArrayResponsibilities = append(stringArrayResponsibilities, i.(types.String).ValueString())
 		}
 		requestBodyUser.SetResponsibilities(stringArrayResponsibilities)
 	}
@@ -3714,7 +3937,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !tfPlanUser.Schools.Equal(tfStateUser.Schools) {
 		var stringArraySchools []string
 		for _, i := range tfPlanUser.Schools.Elements() {
-			stringArraySchools = append(stringArraySchools, i.String())
+			stringArraySchools = append(stringArraySchools, i.(types.String).ValueString())
 		}
 		requestBodyUser.SetSchools(stringArraySchools)
 	}
@@ -3725,31 +3948,48 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if !tfPlanUser.ServiceProvisioningErrors.Equal(tfStateUser.ServiceProvisioningErrors) {
-		var tfPlanServiceProvisioningErrors []models.ServiceProvisioningErrorable
-		for k, i := range tfPlanUser.ServiceProvisioningErrors.Elements() {
+		var objectArrayServiceProvisioningErrors []models.ServiceProvisioningErrorable
+		objectValuesServiceProvisioningErrors := []basetypes.ObjectValue{}
+		tfStateElementsServiceProvisioningErrors := tfStateUser.ServiceProvisioningErrors.Elements()
+		for n, i := range tfPlanUser.ServiceProvisioningErrors.Elements() {
 			requestBodyServiceProvisioningError := models.NewServiceProvisioningError()
-			tfPlanServiceProvisioningError := userServiceProvisioningErrorModel{}
-			types.ListValueFrom(ctx, i.Type(ctx), &tfPlanServiceProvisioningError)
-			tfStateServiceProvisioningError := userServiceProvisioningErrorModel{}
-			types.ListValueFrom(ctx, tfStateUser.ServiceProvisioningErrors.Elements()[k].Type(ctx), &tfPlanServiceProvisioningError)
+			tfPlanServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
+			i.(types.Object).As(ctx, &tfPlanServiceProvisioningError, basetypes.ObjectAsOptions{})
+			tfStateServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
+			if n < len(tfStateElementsServiceProvisioningErrors) {
+				tfStateElementsServiceProvisioningErrors[n].(types.Object).As(ctx, &tfStateServiceProvisioningError, basetypes.ObjectAsOptions{})
+			}
 
-			if !tfPlanServiceProvisioningError.CreatedDateTime.Equal(tfStateServiceProvisioningError.CreatedDateTime) {
+			if tfPlanServiceProvisioningError.CreatedDateTime.IsUnknown() {
+				tfPlanServiceProvisioningError.CreatedDateTime = tfStateServiceProvisioningError.CreatedDateTime
+			}
+			if !tfPlanServiceProvisioningError.CreatedDateTime.IsNull() {
 				tfPlanCreatedDateTime := tfPlanServiceProvisioningError.CreatedDateTime.ValueString()
 				t, _ := time.Parse(time.RFC3339, tfPlanCreatedDateTime)
 				requestBodyServiceProvisioningError.SetCreatedDateTime(&t)
 			}
 
-			if !tfPlanServiceProvisioningError.IsResolved.Equal(tfStateServiceProvisioningError.IsResolved) {
+			if tfPlanServiceProvisioningError.IsResolved.IsUnknown() {
+				tfPlanServiceProvisioningError.IsResolved = tfStateServiceProvisioningError.IsResolved
+			}
+			if !tfPlanServiceProvisioningError.IsResolved.IsNull() {
 				tfPlanIsResolved := tfPlanServiceProvisioningError.IsResolved.ValueBool()
 				requestBodyServiceProvisioningError.SetIsResolved(&tfPlanIsResolved)
 			}
 
-			if !tfPlanServiceProvisioningError.ServiceInstance.Equal(tfStateServiceProvisioningError.ServiceInstance) {
+			if tfPlanServiceProvisioningError.ServiceInstance.IsUnknown() {
+				tfPlanServiceProvisioningError.ServiceInstance = tfStateServiceProvisioningError.ServiceInstance
+			}
+			if !tfPlanServiceProvisioningError.ServiceInstance.IsNull() {
 				tfPlanServiceInstance := tfPlanServiceProvisioningError.ServiceInstance.ValueString()
 				requestBodyServiceProvisioningError.SetServiceInstance(&tfPlanServiceInstance)
 			}
+			objectArrayServiceProvisioningErrors = append(objectArrayServiceProvisioningErrors, requestBodyServiceProvisioningError)
+			objectValue, _ := types.ObjectValueFrom(ctx, tfPlanServiceProvisioningError.AttributeTypes(), tfPlanServiceProvisioningError)
+			objectValuesServiceProvisioningErrors = append(objectValuesServiceProvisioningErrors, objectValue)
 		}
-		requestBodyUser.SetServiceProvisioningErrors(tfPlanServiceProvisioningErrors)
+		requestBodyUser.SetServiceProvisioningErrors(objectArrayServiceProvisioningErrors)
+		tfPlanUser.ServiceProvisioningErrors, _ = types.ListValueFrom(ctx, tfPlanUser.ServiceProvisioningErrors.ElementType(ctx), objectValuesServiceProvisioningErrors)
 	}
 
 	if !tfPlanUser.ShowInAddressList.Equal(tfStateUser.ShowInAddressList) {
@@ -3759,40 +3999,58 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	if !tfPlanUser.SignInActivity.Equal(tfStateUser.SignInActivity) {
 		requestBodySignInActivity := models.NewSignInActivity()
-		tfPlanSignInActivity := userSignInActivityModel{}
+		tfPlanSignInActivity := sharedmodels.SignInActivityModel{}
 		tfPlanUser.SignInActivity.As(ctx, &tfPlanSignInActivity, basetypes.ObjectAsOptions{})
-		tfStateSignInActivity := userSignInActivityModel{}
+		tfStateSignInActivity := sharedmodels.SignInActivityModel{}
 		tfStateUser.SignInActivity.As(ctx, &tfStateSignInActivity, basetypes.ObjectAsOptions{})
 
-		if !tfPlanSignInActivity.LastNonInteractiveSignInDateTime.Equal(tfStateSignInActivity.LastNonInteractiveSignInDateTime) {
+		if tfPlanSignInActivity.LastNonInteractiveSignInDateTime.IsUnknown() {
+			tfPlanSignInActivity.LastNonInteractiveSignInDateTime = tfStateSignInActivity.LastNonInteractiveSignInDateTime
+		}
+		if !tfPlanSignInActivity.LastNonInteractiveSignInDateTime.IsNull() {
 			tfPlanLastNonInteractiveSignInDateTime := tfPlanSignInActivity.LastNonInteractiveSignInDateTime.ValueString()
 			t, _ := time.Parse(time.RFC3339, tfPlanLastNonInteractiveSignInDateTime)
 			requestBodySignInActivity.SetLastNonInteractiveSignInDateTime(&t)
 		}
 
-		if !tfPlanSignInActivity.LastNonInteractiveSignInRequestId.Equal(tfStateSignInActivity.LastNonInteractiveSignInRequestId) {
+		if tfPlanSignInActivity.LastNonInteractiveSignInRequestId.IsUnknown() {
+			tfPlanSignInActivity.LastNonInteractiveSignInRequestId = tfStateSignInActivity.LastNonInteractiveSignInRequestId
+		}
+		if !tfPlanSignInActivity.LastNonInteractiveSignInRequestId.IsNull() {
 			tfPlanLastNonInteractiveSignInRequestId := tfPlanSignInActivity.LastNonInteractiveSignInRequestId.ValueString()
 			requestBodySignInActivity.SetLastNonInteractiveSignInRequestId(&tfPlanLastNonInteractiveSignInRequestId)
 		}
 
-		if !tfPlanSignInActivity.LastSignInDateTime.Equal(tfStateSignInActivity.LastSignInDateTime) {
+		if tfPlanSignInActivity.LastSignInDateTime.IsUnknown() {
+			tfPlanSignInActivity.LastSignInDateTime = tfStateSignInActivity.LastSignInDateTime
+		}
+		if !tfPlanSignInActivity.LastSignInDateTime.IsNull() {
 			tfPlanLastSignInDateTime := tfPlanSignInActivity.LastSignInDateTime.ValueString()
 			t, _ := time.Parse(time.RFC3339, tfPlanLastSignInDateTime)
 			requestBodySignInActivity.SetLastSignInDateTime(&t)
 		}
 
-		if !tfPlanSignInActivity.LastSignInRequestId.Equal(tfStateSignInActivity.LastSignInRequestId) {
+		if tfPlanSignInActivity.LastSignInRequestId.IsUnknown() {
+			tfPlanSignInActivity.LastSignInRequestId = tfStateSignInActivity.LastSignInRequestId
+		}
+		if !tfPlanSignInActivity.LastSignInRequestId.IsNull() {
 			tfPlanLastSignInRequestId := tfPlanSignInActivity.LastSignInRequestId.ValueString()
 			requestBodySignInActivity.SetLastSignInRequestId(&tfPlanLastSignInRequestId)
 		}
 
-		if !tfPlanSignInActivity.LastSuccessfulSignInDateTime.Equal(tfStateSignInActivity.LastSuccessfulSignInDateTime) {
+		if tfPlanSignInActivity.LastSuccessfulSignInDateTime.IsUnknown() {
+			tfPlanSignInActivity.LastSuccessfulSignInDateTime = tfStateSignInActivity.LastSuccessfulSignInDateTime
+		}
+		if !tfPlanSignInActivity.LastSuccessfulSignInDateTime.IsNull() {
 			tfPlanLastSuccessfulSignInDateTime := tfPlanSignInActivity.LastSuccessfulSignInDateTime.ValueString()
 			t, _ := time.Parse(time.RFC3339, tfPlanLastSuccessfulSignInDateTime)
 			requestBodySignInActivity.SetLastSuccessfulSignInDateTime(&t)
 		}
 
-		if !tfPlanSignInActivity.LastSuccessfulSignInRequestId.Equal(tfStateSignInActivity.LastSuccessfulSignInRequestId) {
+		if tfPlanSignInActivity.LastSuccessfulSignInRequestId.IsUnknown() {
+			tfPlanSignInActivity.LastSuccessfulSignInRequestId = tfStateSignInActivity.LastSuccessfulSignInRequestId
+		}
+		if !tfPlanSignInActivity.LastSuccessfulSignInRequestId.IsNull() {
 			tfPlanLastSuccessfulSignInRequestId := tfPlanSignInActivity.LastSuccessfulSignInRequestId.ValueString()
 			requestBodySignInActivity.SetLastSuccessfulSignInRequestId(&tfPlanLastSuccessfulSignInRequestId)
 		}
@@ -3809,7 +4067,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !tfPlanUser.Skills.Equal(tfStateUser.Skills) {
 		var stringArraySkills []string
 		for _, i := range tfPlanUser.Skills.Elements() {
-			stringArraySkills = append(stringArraySkills, i.String())
+			stringArraySkills = append(stringArraySkills, i.(types.String).ValueString())
 		}
 		requestBodyUser.SetSkills(stringArraySkills)
 	}
@@ -3866,15 +4124,22 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from Terraform state
-	var tfStateUser userModel
+	var tfStateUser userResourceModel
 	diags := req.State.Get(ctx, &tfStateUser)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// TODO: Delete user
-	err := r.client.Users().ByUserId(tfStateUser.Id.ValueString()).Delete(context.Background(), nil)
+	if tfStateUser.PreventDeletion.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion of user prevented",
+			fmt.Sprintf("The user %s can't be deleted while prevent_deletion is true. Set it to false, and apply, before deleting it.", tfStateUser.Id.ValueString()),
+		)
+		return
+	}
+
+	err := r.client.Users().ByUserId(tfStateUser.Id.ValueString()).Delete(ctx, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting user",
@@ -3883,8 +4148,254 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	// The object is kept in the deleted items of the directory, from which it can be restored, unless it's purged.
+	// It can take a few seconds to show up there. The object is deleted either way, so failing to purge it is only a warning,
+	// and the resource is still removed from the state
+	if tfStateUser.PermanentlyDelete.ValueBool() {
+		for attempt := 1; ; attempt++ {
+			err = r.client.Directory().DeletedItems().ByDirectoryObjectId(tfStateUser.Id.ValueString()).Delete(ctx, nil)
+			var odataErr *odataerrors.ODataError
+			if err == nil || !errors.As(err, &odataErr) || odataErr.ResponseStatusCode != 404 || attempt == 10 {
+				break
+			}
+			// Stop waiting when Terraform is interrupted
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+			case <-time.After(3 * time.Second):
+				continue
+			}
+			break
+		}
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Couldn't permanently delete user",
+				fmt.Sprintf("The user %s was deleted, but couldn't be purged from the deleted items of the directory, where it's kept for 30 days: %s", tfStateUser.Id.ValueString(), err.Error()),
+			)
+		}
+	}
+
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Besides its ID, the object can be imported by 'userPrincipalName:<user_principal_name>', which is looked up to find its ID
+	var filter string
+	prefix, value, _ := strings.Cut(req.ID, ":")
+	switch prefix {
+	case "userPrincipalName":
+		filter = fmt.Sprintf("userPrincipalName eq '%s'", strings.ReplaceAll(value, "'", "''"))
+	default:
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	qparams := users.UsersRequestBuilderGetRequestConfiguration{
+		QueryParameters: &users.UsersRequestBuilderGetQueryParameters{
+			Filter: &filter,
+			Select: []string{"id"},
+		},
+	}
+	result, err := r.client.Users().Get(ctx, &qparams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing user",
+			err.Error(),
+		)
+		return
+	}
+	if len(result.GetValue()) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier %q to match a single user. Matched %d", req.ID, len(result.GetValue())),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *result.GetValue()[0].GetId())...)
+}
+
+// MoveState moves the state of resources of other providers to the resource, with a 'moved' block.
+// Only some of their attributes are moved. The others are set when the resource is next read,
+// as are empty strings and lists, which other providers store for optional attributes that aren't set
+func (r *userResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "azuread_user" || !strings.HasSuffix(req.SourceProviderAddress, "/hashicorp/azuread") {
+					return
+				}
+
+				var source map[string]any
+				if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving state of azuread_user",
+						err.Error(),
+					)
+					return
+				}
+				if v, ok := source["account_enabled"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("account_enabled"), v)...)
+				}
+				if v, ok := source["age_group"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("age_group"), v)...)
+				}
+				if v, ok := source["business_phones"].([]any); ok && len(v) > 0 {
+					var values []string
+					for _, i := range v {
+						if s, ok := i.(string); ok {
+							values = append(values, s)
+						}
+					}
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("business_phones"), values)...)
+				}
+				if v, ok := source["city"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("city"), v)...)
+				}
+				if v, ok := source["company_name"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("company_name"), v)...)
+				}
+				if v, ok := source["consent_provided_for_minor"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("consent_provided_for_minor"), v)...)
+				}
+				if v, ok := source["country"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("country"), v)...)
+				}
+				if v, ok := source["department"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("department"), v)...)
+				}
+				if v, ok := source["display_name"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("display_name"), v)...)
+				}
+				if v, ok := source["employee_hire_date"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("employee_hire_date"), v)...)
+				}
+				if v, ok := source["employee_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("employee_id"), v)...)
+				}
+				if v, ok := source["employee_type"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("employee_type"), v)...)
+				}
+				if v, ok := source["fax_number"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("fax_number"), v)...)
+				}
+				if v, ok := source["given_name"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("given_name"), v)...)
+				}
+				if v, ok := source["job_title"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("job_title"), v)...)
+				}
+				if v, ok := source["mail"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("mail"), v)...)
+				}
+				if v, ok := source["mail_nickname"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("mail_nickname"), v)...)
+				}
+				if v, ok := source["mobile_phone"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("mobile_phone"), v)...)
+				}
+				if v, ok := source["object_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), v)...)
+				}
+				if v, ok := source["office_location"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("office_location"), v)...)
+				}
+				if v, ok := source["onpremises_immutable_id"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("on_premises_immutable_id"), v)...)
+				}
+				if v, ok := source["other_mails"].([]any); ok && len(v) > 0 {
+					var values []string
+					for _, i := range v {
+						if s, ok := i.(string); ok {
+							values = append(values, s)
+						}
+					}
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("other_mails"), values)...)
+				}
+				if v, ok := source["postal_code"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("postal_code"), v)...)
+				}
+				if v, ok := source["preferred_language"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("preferred_language"), v)...)
+				}
+				if v, ok := source["show_in_address_list"].(bool); ok {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("show_in_address_list"), v)...)
+				}
+				if v, ok := source["state"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("state"), v)...)
+				}
+				if v, ok := source["street_address"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("street_address"), v)...)
+				}
+				if v, ok := source["surname"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("surname"), v)...)
+				}
+				if v, ok := source["usage_location"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("usage_location"), v)...)
+				}
+				if v, ok := source["user_principal_name"].(string); ok && v != "" {
+					resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("user_principal_name"), v)...)
+				}
+			},
+		},
+	}
+}
+
+// ListIds returns the IDs of the objects matching filter, an OData $filter expression, or of every object when it's empty.
+// It's used to export the existing objects of a tenant
+func (r *userResource) ListIds(ctx context.Context, filter string) ([]string, error) {
+
+	qparams := users.UsersRequestBuilderGetRequestConfiguration{
+		QueryParameters: &users.UsersRequestBuilderGetQueryParameters{
+			Select: []string{"id"},
+		},
+	}
+	if filter != "" {
+		qparams.QueryParameters.Filter = &filter
+	}
+
+	result, err := r.client.Users().Get(ctx, &qparams)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for {
+		for _, object := range result.GetValue() {
+			ids = append(ids, *object.GetId())
+		}
+		nextLink := result.GetOdataNextLink()
+		if nextLink == nil {
+			return ids, nil
+		}
+		result, err = r.client.Users().WithUrl(*nextLink).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+}
+
+// ReadOnlyAttributes returns the paths of the attributes the API sets, which exports leave out of the configuration they write
+func (r *userResource) ReadOnlyAttributes() []string {
+	return []string{
+		"assigned_plans",
+		"business_phones",
+		"created_date_time",
+		"creation_type",
+		"id",
+		"im_addresses",
+		"license_assignment_states",
+		"mobile_phone",
+		"on_premises_distinguished_name",
+		"on_premises_domain_name",
+		"on_premises_last_sync_date_time",
+		"on_premises_sam_account_name",
+		"on_premises_security_identifier",
+		"on_premises_sync_enabled",
+		"on_premises_user_principal_name",
+		"provisioned_plans",
+		"proxy_addresses",
+		"security_identifier",
+		"sign_in_activity",
+		"sign_in_sessions_valid_from_date_time",
+	}
 }
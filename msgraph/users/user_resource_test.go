@@ -0,0 +1,77 @@
+package users_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-msgraph/msgraph/acctest"
+)
+
+const userResourceConfig = `
+resource "msgraph_user" "test" {
+  display_name  = "Example"
+  mail_nickname = "example"
+  password_profile = {
+    password = "Acceptance-Test-Passw0rd!"
+  }
+  user_principal_name = "example@contoso.onmicrosoft.com"
+}
+`
+
+const userResourceUpdateConfig = `
+resource "msgraph_user" "test" {
+  display_name  = "Example updated"
+  mail_nickname = "example"
+  password_profile = {
+    password = "Acceptance-Test-Passw0rd!"
+  }
+  user_principal_name = "example@contoso.onmicrosoft.com"
+}
+`
+
+func TestAccUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: userResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("msgraph_user.test", "id"),
+					resource.TestCheckResourceAttr("msgraph_user.test", "display_name", "Example"),
+					resource.TestCheckResourceAttr("msgraph_user.test", "mail_nickname", "example"),
+					resource.TestCheckResourceAttr("msgraph_user.test", "user_principal_name", "example@contoso.onmicrosoft.com"),
+				),
+			},
+			// Import
+			{
+				ResourceName:            "msgraph_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_profile"},
+			},
+			// Import by user_principal_name
+			{
+				ResourceName:      "msgraph_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "userPrincipalName:" + s.RootModule().Resources["msgraph_user.test"].Primary.Attributes["user_principal_name"], nil
+				},
+				ImportStateVerifyIgnore: []string{"password_profile"},
+			},
+			// Update and Read
+			{
+				Config: userResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("msgraph_user.test", "display_name", "Example updated"),
+					resource.TestCheckResourceAttr("msgraph_user.test", "mail_nickname", "example"),
+					resource.TestCheckResourceAttr("msgraph_user.test", "user_principal_name", "example@contoso.onmicrosoft.com"),
+				),
+			},
+			// Delete is tested once the last step is done
+		},
+	})
+}
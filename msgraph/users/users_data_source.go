@@ -10,6 +10,9 @@ import (
 
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"terraform-provider-msgraph/msgraph/clients"
+	"terraform-provider-msgraph/msgraph/sharedmodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,12 +37,12 @@ func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	d.client = req.ProviderData.(*msgraphsdk.GraphServiceClient)
+	d.client = req.ProviderData.(*clients.Clients).V1
 }
 
 // Schema defines the schema for the data source.
@@ -620,7 +623,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseUser.GetAssignedLicenses()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseAssignedLicense := range responseUser.GetAssignedLicenses() {
-					tfStateAssignedLicense := usersAssignedLicenseModel{}
+					tfStateAssignedLicense := sharedmodels.AssignedLicenseModel{}
 
 					if len(responseAssignedLicense.GetDisabledPlans()) > 0 {
 						var valueArrayDisabledPlans []attr.Value
@@ -644,7 +647,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseUser.GetAssignedPlans()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseAssignedPlan := range responseUser.GetAssignedPlans() {
-					tfStateAssignedPlan := usersAssignedPlanModel{}
+					tfStateAssignedPlan := sharedmodels.AssignedPlanModel{}
 
 					if responseAssignedPlan.GetAssignedDateTime() != nil {
 						tfStateAssignedPlan.AssignedDateTime = types.StringValue(responseAssignedPlan.GetAssignedDateTime().String())
@@ -672,7 +675,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateUser.AssignedPlans, _ = types.ListValueFrom(ctx, objectValues[0].Type(ctx), objectValues)
 			}
 			if responseUser.GetAuthorizationInfo() != nil {
-				tfStateAuthorizationInfo := usersAuthorizationInfoModel{}
+				tfStateAuthorizationInfo := sharedmodels.AuthorizationInfoModel{}
 				responseAuthorizationInfo := responseUser.GetAuthorizationInfo()
 
 				if len(responseAuthorizationInfo.GetCertificateUserIds()) > 0 {
@@ -759,7 +762,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateUser.EmployeeLeaveDateTime = types.StringNull()
 			}
 			if responseUser.GetEmployeeOrgData() != nil {
-				tfStateEmployeeOrgData := usersEmployeeOrgDataModel{}
+				tfStateEmployeeOrgData := sharedmodels.EmployeeOrgDataModel{}
 				responseEmployeeOrgData := responseUser.GetEmployeeOrgData()
 
 				if responseEmployeeOrgData.GetCostCenter() != nil {
@@ -808,7 +811,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseUser.GetIdentities()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseObjectIdentity := range responseUser.GetIdentities() {
-					tfStateObjectIdentity := usersObjectIdentityModel{}
+					tfStateObjectIdentity := sharedmodels.ObjectIdentityModel{}
 
 					if responseObjectIdentity.GetIssuer() != nil {
 						tfStateObjectIdentity.Issuer = types.StringValue(*responseObjectIdentity.GetIssuer())
@@ -868,7 +871,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseUser.GetLicenseAssignmentStates()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseLicenseAssignmentState := range responseUser.GetLicenseAssignmentStates() {
-					tfStateLicenseAssignmentState := usersLicenseAssignmentStateModel{}
+					tfStateLicenseAssignmentState := sharedmodels.LicenseAssignmentStateModel{}
 
 					if responseLicenseAssignmentState.GetAssignedByGroup() != nil {
 						tfStateLicenseAssignmentState.AssignedByGroup = types.StringValue(*responseLicenseAssignmentState.GetAssignedByGroup())
@@ -940,7 +943,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateUser.OnPremisesDomainName = types.StringNull()
 			}
 			if responseUser.GetOnPremisesExtensionAttributes() != nil {
-				tfStateOnPremisesExtensionAttributes := usersOnPremisesExtensionAttributesModel{}
+				tfStateOnPremisesExtensionAttributes := sharedmodels.OnPremisesExtensionAttributesModel{}
 				responseOnPremisesExtensionAttributes := responseUser.GetOnPremisesExtensionAttributes()
 
 				if responseOnPremisesExtensionAttributes.GetExtensionAttribute1() != nil {
@@ -1034,7 +1037,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseUser.GetOnPremisesProvisioningErrors()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseOnPremisesProvisioningError := range responseUser.GetOnPremisesProvisioningErrors() {
-					tfStateOnPremisesProvisioningError := usersOnPremisesProvisioningErrorModel{}
+					tfStateOnPremisesProvisioningError := sharedmodels.OnPremisesProvisioningErrorModel{}
 
 					if responseOnPremisesProvisioningError.GetCategory() != nil {
 						tfStateOnPremisesProvisioningError.Category = types.StringValue(*responseOnPremisesProvisioningError.GetCategory())
@@ -1097,7 +1100,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateUser.PasswordPolicies = types.StringNull()
 			}
 			if responseUser.GetPasswordProfile() != nil {
-				tfStatePasswordProfile := usersPasswordProfileModel{}
+				tfStatePasswordProfile := sharedmodels.PasswordProfileModel{}
 				responsePasswordProfile := responseUser.GetPasswordProfile()
 
 				if responsePasswordProfile.GetForceChangePasswordNextSignIn() != nil {
@@ -1136,7 +1139,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseUser.GetProvisionedPlans()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseProvisionedPlan := range responseUser.GetProvisionedPlans() {
-					tfStateProvisionedPlan := usersProvisionedPlanModel{}
+					tfStateProvisionedPlan := sharedmodels.ProvisionedPlanModel{}
 
 					if responseProvisionedPlan.GetCapabilityStatus() != nil {
 						tfStateProvisionedPlan.CapabilityStatus = types.StringValue(*responseProvisionedPlan.GetCapabilityStatus())
@@ -1176,7 +1179,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			if len(responseUser.GetServiceProvisioningErrors()) > 0 {
 				objectValues := []basetypes.ObjectValue{}
 				for _, responseServiceProvisioningError := range responseUser.GetServiceProvisioningErrors() {
-					tfStateServiceProvisioningError := usersServiceProvisioningErrorModel{}
+					tfStateServiceProvisioningError := sharedmodels.ServiceProvisioningErrorModel{}
 
 					if responseServiceProvisioningError.GetCreatedDateTime() != nil {
 						tfStateServiceProvisioningError.CreatedDateTime = types.StringValue(responseServiceProvisioningError.GetCreatedDateTime().String())
@@ -1204,7 +1207,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				tfStateUser.ShowInAddressList = types.BoolNull()
 			}
 			if responseUser.GetSignInActivity() != nil {
-				tfStateSignInActivity := usersSignInActivityModel{}
+				tfStateSignInActivity := sharedmodels.SignInActivityModel{}
 				responseSignInActivity := responseUser.GetSignInActivity()
 
 				if responseSignInActivity.GetLastNonInteractiveSignInDateTime() != nil {
@@ -0,0 +1,12 @@
+package boolplanmodifiers_test
+
+import (
+	"testing"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers"
+)
+
+func TestNullIfUnconfigured(t *testing.T) {
+	planmodifiertest.NullIfUnconfigured(t, boolplanmodifiers.NullIfUnconfigured(), values)
+}
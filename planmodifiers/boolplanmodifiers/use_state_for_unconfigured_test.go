@@ -0,0 +1,22 @@
+package boolplanmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/boolplanmodifiers"
+)
+
+// The values the modifiers are tested with
+var values = planmodifiertest.Values[types.Bool]{
+	Null:       types.BoolNull(),
+	Unknown:    types.BoolUnknown(),
+	State:      types.BoolValue(false),
+	Configured: types.BoolValue(true),
+}
+
+func TestUseStateForUnconfigured(t *testing.T) {
+	planmodifiertest.UseStateForUnconfigured(t, boolplanmodifiers.UseStateForUnconfigured(), values)
+}
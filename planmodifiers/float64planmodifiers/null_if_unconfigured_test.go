@@ -0,0 +1,12 @@
+package float64planmodifiers_test
+
+import (
+	"testing"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/float64planmodifiers"
+)
+
+func TestNullIfUnconfigured(t *testing.T) {
+	planmodifiertest.NullIfUnconfigured(t, float64planmodifiers.NullIfUnconfigured(), values)
+}
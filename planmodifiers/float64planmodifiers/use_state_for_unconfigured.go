@@ -0,0 +1,41 @@
+package float64planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+func UseStateForUnconfigured() planmodifier.Float64 {
+	return useStateForUnconfiguredModifier{}
+}
+
+// useStateForUnknownModifier implements the plan modifier.
+type useStateForUnconfiguredModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m useStateForUnconfiguredModifier) Description(_ context.Context) string {
+	return "If unconfigured, this attribute will use the value in state."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useStateForUnconfiguredModifier) MarkdownDescription(_ context.Context) string {
+	return "If unconfigured, this attribute will use the value in state."
+}
+
+// PlanModifyFloat64 implements the plan modification logic.
+func (m useStateForUnconfiguredModifier) PlanModifyFloat64(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+
+	// Do nothing if resource is being created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if configuration is not null
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// If resource is being updated, and config is null, use state value
+	resp.PlanValue = req.StateValue
+}
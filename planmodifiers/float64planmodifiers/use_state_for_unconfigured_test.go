@@ -0,0 +1,22 @@
+package float64planmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/float64planmodifiers"
+)
+
+// The values the modifiers are tested with
+var values = planmodifiertest.Values[types.Float64]{
+	Null:       types.Float64Null(),
+	Unknown:    types.Float64Unknown(),
+	State:      types.Float64Value(2.5),
+	Configured: types.Float64Value(1.5),
+}
+
+func TestUseStateForUnconfigured(t *testing.T) {
+	planmodifiertest.UseStateForUnconfigured(t, float64planmodifiers.UseStateForUnconfigured(), values)
+}
@@ -0,0 +1,12 @@
+package int64planmodifiers_test
+
+import (
+	"testing"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/int64planmodifiers"
+)
+
+func TestNullIfUnconfigured(t *testing.T) {
+	planmodifiertest.NullIfUnconfigured(t, int64planmodifiers.NullIfUnconfigured(), values)
+}
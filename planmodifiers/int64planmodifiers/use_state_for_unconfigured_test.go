@@ -0,0 +1,22 @@
+package int64planmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/int64planmodifiers"
+)
+
+// The values the modifiers are tested with
+var values = planmodifiertest.Values[types.Int64]{
+	Null:       types.Int64Null(),
+	Unknown:    types.Int64Unknown(),
+	State:      types.Int64Value(2),
+	Configured: types.Int64Value(1),
+}
+
+func TestUseStateForUnconfigured(t *testing.T) {
+	planmodifiertest.UseStateForUnconfigured(t, int64planmodifiers.UseStateForUnconfigured(), values)
+}
@@ -0,0 +1,12 @@
+package listplanmodifiers_test
+
+import (
+	"testing"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/listplanmodifiers"
+)
+
+func TestNullIfUnconfigured(t *testing.T) {
+	planmodifiertest.NullIfUnconfigured(t, listplanmodifiers.NullIfUnconfigured(), values)
+}
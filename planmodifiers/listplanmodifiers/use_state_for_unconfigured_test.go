@@ -0,0 +1,23 @@
+package listplanmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/listplanmodifiers"
+)
+
+// The values the modifiers are tested with
+var values = planmodifiertest.Values[types.List]{
+	Null:       types.ListNull(types.StringType),
+	Unknown:    types.ListUnknown(types.StringType),
+	State:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("state")}),
+	Configured: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("configured")}),
+}
+
+func TestUseStateForUnconfigured(t *testing.T) {
+	planmodifiertest.UseStateForUnconfigured(t, listplanmodifiers.UseStateForUnconfigured(), values)
+}
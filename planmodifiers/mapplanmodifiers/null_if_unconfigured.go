@@ -0,0 +1,45 @@
+package mapplanmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NullIfUnconfigured plans an attribute removed from the configuration of an existing resource to be null,
+// so the update clears it, rather than keeping the value in state like UseStateForUnconfigured does.
+// Values the API sets when the resource is created are still planned as unknown
+func NullIfUnconfigured() planmodifier.Map {
+	return nullIfUnconfiguredModifier{}
+}
+
+// nullIfUnconfiguredModifier implements the plan modifier.
+type nullIfUnconfiguredModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m nullIfUnconfiguredModifier) Description(_ context.Context) string {
+	return "If unconfigured, this attribute will be cleared when the resource is updated."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m nullIfUnconfiguredModifier) MarkdownDescription(_ context.Context) string {
+	return "If unconfigured, this attribute will be cleared when the resource is updated."
+}
+
+// PlanModifyMap implements the plan modification logic.
+func (m nullIfUnconfiguredModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+
+	// Do nothing if resource is being created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if configuration is not null
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// If resource is being updated, and config is null, plan to clear the value
+	resp.PlanValue = types.MapNull(req.PlanValue.ElementType(ctx))
+}
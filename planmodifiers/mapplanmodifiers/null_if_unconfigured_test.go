@@ -0,0 +1,12 @@
+package mapplanmodifiers_test
+
+import (
+	"testing"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/mapplanmodifiers"
+)
+
+func TestNullIfUnconfigured(t *testing.T) {
+	planmodifiertest.NullIfUnconfigured(t, mapplanmodifiers.NullIfUnconfigured(), values)
+}
@@ -0,0 +1,23 @@
+package mapplanmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/mapplanmodifiers"
+)
+
+// The values the modifiers are tested with
+var values = planmodifiertest.Values[types.Map]{
+	Null:       types.MapNull(types.StringType),
+	Unknown:    types.MapUnknown(types.StringType),
+	State:      types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("state")}),
+	Configured: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("configured")}),
+}
+
+func TestUseStateForUnconfigured(t *testing.T) {
+	planmodifiertest.UseStateForUnconfigured(t, mapplanmodifiers.UseStateForUnconfigured(), values)
+}
@@ -0,0 +1,26 @@
+package objectplanmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/objectplanmodifiers"
+)
+
+// The attributes of the object the tests plan
+var attributeTypes = map[string]attr.Type{"name": types.StringType}
+
+// The values the modifiers are tested with
+var values = planmodifiertest.Values[types.Object]{
+	Null:       types.ObjectNull(attributeTypes),
+	Unknown:    types.ObjectUnknown(attributeTypes),
+	State:      types.ObjectValueMust(attributeTypes, map[string]attr.Value{"name": types.StringValue("state")}),
+	Configured: types.ObjectValueMust(attributeTypes, map[string]attr.Value{"name": types.StringValue("configured")}),
+}
+
+func TestUseStateForUnconfigured(t *testing.T) {
+	planmodifiertest.UseStateForUnconfigured(t, objectplanmodifiers.UseStateForUnconfigured(), values)
+}
@@ -0,0 +1,23 @@
+package setplanmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/setplanmodifiers"
+)
+
+// The values the modifiers are tested with
+var values = planmodifiertest.Values[types.Set]{
+	Null:       types.SetNull(types.StringType),
+	Unknown:    types.SetUnknown(types.StringType),
+	State:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("state")}),
+	Configured: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("configured")}),
+}
+
+func TestUseStateForUnconfigured(t *testing.T) {
+	planmodifiertest.UseStateForUnconfigured(t, setplanmodifiers.UseStateForUnconfigured(), values)
+}
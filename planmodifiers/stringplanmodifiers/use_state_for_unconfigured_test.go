@@ -0,0 +1,22 @@
+package stringplanmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-msgraph/internal/planmodifiertest"
+	"terraform-provider-msgraph/planmodifiers/stringplanmodifiers"
+)
+
+// The values the modifiers are tested with
+var values = planmodifiertest.Values[types.String]{
+	Null:       types.StringNull(),
+	Unknown:    types.StringUnknown(),
+	State:      types.StringValue("state"),
+	Configured: types.StringValue("configured"),
+}
+
+func TestUseStateForUnconfigured(t *testing.T) {
+	planmodifiertest.UseStateForUnconfigured(t, stringplanmodifiers.UseStateForUnconfigured(), values)
+}